@@ -0,0 +1,54 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/codemaestro64/filament/apps/api/internal/config"
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var calibrateKDFCmd = &cobra.Command{
+	Use:   "calibrate-kdf",
+	Short: "Pick the Argon2id passes for a target unlock latency",
+	Long: "Measures Argon2id with the configured memory and threads on this machine and prints " +
+		"the number of passes to set as kdf.time. It never suggests fewer passes than configured.",
+	RunE: calibrateKDF,
+}
+
+func init() {
+	calibrateKDFCmd.Flags().Int64("kdf-target-latency", 500, "Unlock latency to calibrate for (ms)")
+	_ = viper.BindPFlag(config.KeyKDFTargetLatency, calibrateKDFCmd.Flags().Lookup("kdf-target-latency"))
+
+	rootCmd.AddCommand(calibrateKDFCmd)
+}
+
+func calibrateKDF(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(Environment)
+	if err != nil {
+		return err
+	}
+
+	if cfg.KDF.TargetLatency <= 0 {
+		return errors.New("kdf target latency must be positive")
+	}
+	target := time.Duration(cfg.KDF.TargetLatency) * time.Millisecond
+
+	params, err := wallet.CalibrateKDF(target, wallet.KDFParams{
+		Algorithm: wallet.KDFArgon2id,
+		Time:      cfg.KDF.Time,
+		Memory:    cfg.KDF.Memory * 1024,
+		Threads:   cfg.KDF.Threads,
+	})
+	if err != nil {
+		return fmt.Errorf("calibrate kdf: %w", err)
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Calibrated %s for a target unlock latency of %s.\n", params, target)
+	fmt.Fprintf(cmd.OutOrStdout(), "Set kdf.time (--kdf-time) to %d to use it.\n", params.Time)
+
+	return nil
+}
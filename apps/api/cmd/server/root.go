@@ -28,6 +28,9 @@ func init() {
 	rootCmd.Flags().String("host", "0.0.0.0", "Server host")
 	rootCmd.Flags().String("network", "calibration", "Network")
 	rootCmd.Flags().Int64("session_timeout", 30, "Session Timeout (mins)")
+	rootCmd.Flags().Int64("session_max_lifetime", 480, "Session Max Lifetime (mins)")
+	rootCmd.Flags().Int("max_failed_attempts", 5, "Wrong passwords before a wallet locks out")
+	rootCmd.Flags().Int64("lockout_duration", 30, "Wallet Lockout Duration (mins)")
 
 	// Database flags
 	rootCmd.Flags().String("db-driver", "sqlite", "Database driver")
@@ -37,6 +40,16 @@ func init() {
 	rootCmd.Flags().String("db-user", "root", "Database user")
 	rootCmd.Flags().String("db-password", "", "Database password")
 
+	// Lotus RPC flags
+	rootCmd.Flags().StringSlice("rpc-endpoint", nil, "Lotus RPC endpoint, URL or TOKEN:URL; repeat for failover (defaults to the public endpoint of the network)")
+	rootCmd.Flags().String("rpc-token", "", "Lotus RPC auth token")
+	rootCmd.Flags().String("rpc-token-file", "", "File containing the Lotus RPC auth token")
+
+	// Password hashing flags
+	rootCmd.Flags().Uint32("kdf-time", 3, "Argon2id passes for new wallets")
+	rootCmd.Flags().Uint32("kdf-memory", 64, "Argon2id memory for new wallets (MiB)")
+	rootCmd.Flags().Uint8("kdf-threads", 4, "Argon2id threads for new wallets")
+
 	// Log flags
 	rootCmd.Flags().String("log-level", "info", "Log level")
 	rootCmd.Flags().Int("log-max-size", 500, "Log max size")
@@ -48,6 +61,9 @@ func init() {
 	_ = viper.BindPFlag(config.KeyServerHost, rootCmd.Flags().Lookup("host"))
 	_ = viper.BindPFlag(config.KeyNetwork, rootCmd.Flags().Lookup("network"))
 	_ = viper.BindPFlag(config.KeySessionTimeout, rootCmd.Flags().Lookup("session_timeout"))
+	_ = viper.BindPFlag(config.KeySessionMaxLife, rootCmd.Flags().Lookup("session_max_lifetime"))
+	_ = viper.BindPFlag(config.KeyMaxAttempts, rootCmd.Flags().Lookup("max_failed_attempts"))
+	_ = viper.BindPFlag(config.KeyLockout, rootCmd.Flags().Lookup("lockout_duration"))
 
 	_ = viper.BindPFlag(config.KeyDBDriver, rootCmd.Flags().Lookup("db-driver"))
 	_ = viper.BindPFlag(config.KeyDBHost, rootCmd.Flags().Lookup("db-host"))
@@ -56,6 +72,14 @@ func init() {
 	_ = viper.BindPFlag(config.KeyDBUser, rootCmd.Flags().Lookup("db-user"))
 	_ = viper.BindPFlag(config.KeyDBPassword, rootCmd.Flags().Lookup("db-password"))
 
+	_ = viper.BindPFlag(config.KeyRPCEndpoints, rootCmd.Flags().Lookup("rpc-endpoint"))
+	_ = viper.BindPFlag(config.KeyRPCToken, rootCmd.Flags().Lookup("rpc-token"))
+	_ = viper.BindPFlag(config.KeyRPCTokenFile, rootCmd.Flags().Lookup("rpc-token-file"))
+
+	_ = viper.BindPFlag(config.KeyKDFTime, rootCmd.Flags().Lookup("kdf-time"))
+	_ = viper.BindPFlag(config.KeyKDFMemory, rootCmd.Flags().Lookup("kdf-memory"))
+	_ = viper.BindPFlag(config.KeyKDFThreads, rootCmd.Flags().Lookup("kdf-threads"))
+
 	_ = viper.BindPFlag(config.KeyLogLevel, rootCmd.Flags().Lookup("log-level"))
 	_ = viper.BindPFlag(config.KeyLogMaxSize, rootCmd.Flags().Lookup("log-max-size"))
 	_ = viper.BindPFlag(config.KeyLogMaxBackups, rootCmd.Flags().Lookup("log-max-backups"))
@@ -68,6 +92,9 @@ func initConfig() {
 	viper.SetDefault(config.KeyServerHost, "0.0.0.0")
 	viper.SetDefault(config.KeyNetwork, util.CalibrationNet.String())
 	viper.SetDefault(config.KeySessionTimeout, 30)
+	viper.SetDefault(config.KeySessionMaxLife, 480)
+	viper.SetDefault(config.KeyMaxAttempts, 5)
+	viper.SetDefault(config.KeyLockout, 30)
 
 	// Database
 	viper.SetDefault(config.KeyDBDriver, "sqlite")
@@ -77,6 +104,17 @@ func initConfig() {
 	viper.SetDefault(config.KeyDBUser, "root")
 	viper.SetDefault(config.KeyDBPassword, "")
 
+	// Lotus RPC
+	viper.SetDefault(config.KeyRPCEndpoints, []string{})
+	viper.SetDefault(config.KeyRPCToken, "")
+	viper.SetDefault(config.KeyRPCTokenFile, "")
+
+	// Password hashing
+	viper.SetDefault(config.KeyKDFTime, 3)
+	viper.SetDefault(config.KeyKDFMemory, 64)
+	viper.SetDefault(config.KeyKDFThreads, 4)
+	viper.SetDefault(config.KeyKDFTargetLatency, 500)
+
 	// Logs
 	viper.SetDefault(config.KeyLogLevel, "info")
 	viper.SetDefault(config.KeyLogMaxSize, 500)
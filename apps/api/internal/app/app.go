@@ -21,6 +21,7 @@ import (
 	"github.com/codemaestro64/filament/apps/api/internal/service"
 	"github.com/codemaestro64/filament/apps/api/pkg/util"
 	"github.com/codemaestro64/filament/libs/filwallet"
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
 	"github.com/rs/zerolog/log"
 )
 
@@ -79,31 +80,83 @@ func Run(env config.Env) error {
 		Str("data_dir", dataDir).
 		Msg("application bootstrap complete")
 
-	db, err := database.New(cfg.Database, dataDir, env)
+	components, err := newComponents(ctx, cfg, network, dataDir, cancel)
 	if err != nil {
-		return fmt.Errorf("init database: %w", err)
+		return err
 	}
 
+	return runWithGracefulShutdown(ctx, DefaultShutdownTimeout, components)
+}
+
+// newComponents opens the database, which migrates its schema, and builds the
+// wallet manager and API server on top of it. The database is closed again
+// when a later step fails.
+func newComponents(ctx context.Context, cfg *config.Config, network util.Network, dataDir string, cancel context.CancelFunc) (_ []Runnable, err error) {
+	db, err := database.New(ctx, cfg.Database, dataDir, cfg.Server.Environment)
+	if err != nil {
+		return nil, fmt.Errorf("init database: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			db.Shutdown(ctx)
+		}
+	}()
+
 	repo := repository.New(db.GetClient())
 
+	endpoints, err := cfg.RPC.EndpointsFor(network)
+	if err != nil {
+		return nil, err
+	}
+
+	var rpcEndpoints []filwallet.RPCEndpoint
+	for _, endpoint := range endpoints {
+		log.Info().Str("rpc_endpoint", endpoint.URL).Msg("using lotus rpc endpoint")
+		rpcEndpoints = append(rpcEndpoints, filwallet.RPCEndpoint{
+			URL:   endpoint.URL,
+			Token: endpoint.Token,
+		})
+	}
+
 	// Intialize wallet manager
 	walletMgr, err := filwallet.NewManager(ctx, repo.Wallet, &filwallet.Config{
-		Network:        network,
-		SessionTimeout: cfg.Server.SessionTimeout,
-		RPCEndpoint:    "",
-		RPCToken:       "",
-		DataDir:        dataDir,
+		Network:            network,
+		SessionTimeout:     cfg.Server.SessionTimeout,
+		SessionMaxLifetime: cfg.Server.SessionMaxLifetime,
+		MaxFailedAttempts:  cfg.Server.MaxFailedAttempts,
+		LockoutDuration:    cfg.Server.LockoutDuration,
+		KDF: wallet.KDFParams{
+			Algorithm: wallet.KDFArgon2id,
+			Time:      cfg.KDF.Time,
+			Memory:    cfg.KDF.Memory * 1024,
+			Threads:   cfg.KDF.Threads,
+		},
+		RPCEndpoints: rpcEndpoints,
+		DataDir:      dataDir,
 	})
+	if err != nil {
+		return nil, fmt.Errorf("init wallet manager: %w", err)
+	}
+	log.Info().Stringer("kdf", walletMgr.KDFParams()).Msg("password hashing parameters")
+
+	// A failed cleanup is retried on the next start, it does not stop the
+	// wallet from working.
+	removed, err := walletMgr.RemoveKeystoreFiles()
+	if err != nil {
+		log.Error().Err(err).Msg("error removing leftover keystore files")
+	}
+	if removed > 0 {
+		log.Info().Int("count", removed).Msg("removed leftover keystore files")
+	}
 
 	srvc := service.New(repo, walletMgr)
 
 	srvr, err := server.New(srvc, cfg.Server, cancel)
 	if err != nil {
-		return fmt.Errorf("init server: %w", err)
+		return nil, fmt.Errorf("init server: %w", err)
 	}
 
-	components := []Runnable{db, srvr}
-	return runWithGracefulShutdown(ctx, DefaultShutdownTimeout, components)
+	return []Runnable{db, srvr}, nil
 }
 
 // bootstrap handles first-use by creating settings.json or reading the existing network preference.
@@ -0,0 +1,48 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/codemaestro64/filament/apps/api/internal/config"
+	"github.com/codemaestro64/filament/apps/api/internal/infra/database"
+	"github.com/codemaestro64/filament/apps/api/pkg/util"
+)
+
+func testConfig() *config.Config {
+	return &config.Config{
+		Server: config.ServerConfig{
+			Host:           "127.0.0.1",
+			Environment:    config.Production,
+			SessionTimeout: 30,
+		},
+		Database: config.DatabaseConfig{Driver: "sqlite", Name: "filament"},
+		RPC:      config.RPCConfig{Endpoints: []string{"http://127.0.0.1:1/rpc/v1"}},
+		KDF:      config.KDFConfig{Time: 1, Memory: 8, Threads: 1},
+	}
+}
+
+// TestNewComponentsEmptyDatabase starts twice on a data directory without a
+// database, the wallet manager queries the wallets before any component is
+// started.
+func TestNewComponentsEmptyDatabase(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dataDir := t.TempDir()
+	for run := 1; run <= 2; run++ {
+		components, err := newComponents(ctx, testConfig(), util.CalibrationNet, dataDir, cancel)
+		if err != nil {
+			t.Fatalf("run %d: new components: %v", run, err)
+		}
+
+		db := components[0].(*database.Database)
+		if n, err := db.GetClient().Wallet.Query().Count(ctx); err != nil || n != 0 {
+			t.Errorf("run %d: count wallets: %d, %v", run, n, err)
+		}
+
+		if err := db.Shutdown(ctx); err != nil {
+			t.Fatalf("run %d: close database: %v", run, err)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"errors"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/codemaestro64/filament/apps/api/pkg/util"
@@ -18,6 +19,9 @@ const (
 	KeyServerHost     = "server.host"
 	KeyNetwork        = "server.network"
 	KeySessionTimeout = "server.session_timeout"
+	KeySessionMaxLife = "server.session_max_lifetime"
+	KeyMaxAttempts    = "server.max_failed_attempts"
+	KeyLockout        = "server.lockout_duration"
 
 	// Database
 	KeyDBDriver   = "database.driver"
@@ -27,6 +31,17 @@ const (
 	KeyDBUser     = "database.user"
 	KeyDBPassword = "database.password"
 
+	// Lotus RPC
+	KeyRPCEndpoints = "rpc.endpoints"
+	KeyRPCToken     = "rpc.token"
+	KeyRPCTokenFile = "rpc.token_file"
+
+	// Password hashing
+	KeyKDFTime          = "kdf.time"
+	KeyKDFMemory        = "kdf.memory"
+	KeyKDFThreads       = "kdf.threads"
+	KeyKDFTargetLatency = "kdf.target_latency"
+
 	// Logs
 	KeyLogLevel      = "log.level"
 	KeyLogMaxSize    = "log.max_size"
@@ -41,6 +56,13 @@ type ServerConfig struct {
 	Environment    Env
 	Network        util.Network
 	SessionTimeout int64
+	// SessionMaxLifetime caps how long a wallet stays unlocked, however
+	// often it is used (mins).
+	SessionMaxLifetime int64
+	// MaxFailedAttempts is the number of wrong passwords in a row that lock
+	// a wallet out for LockoutDuration (mins).
+	MaxFailedAttempts int
+	LockoutDuration   int64
 }
 
 type DatabaseConfig struct {
@@ -52,6 +74,27 @@ type DatabaseConfig struct {
 	Password string
 }
 
+type RPCConfig struct {
+	// Endpoints are Lotus JSON-RPC URLs or API multiaddrs in order of
+	// preference, optionally prefixed with their own token as TOKEN:ADDRESS.
+	// When empty the public endpoint of the active network is used.
+	Endpoints []string
+	// Token is used for the endpoints that don't carry their own.
+	Token     string
+	TokenFile string
+}
+
+// KDFConfig holds the Argon2id parameters of new wallets.
+type KDFConfig struct {
+	Time uint32
+	// Memory is in MiB.
+	Memory  uint32
+	Threads uint8
+	// TargetLatency is the unlock latency the calibrate-kdf command picks
+	// Time for (ms). The server itself does not calibrate.
+	TargetLatency int64
+}
+
 type LogConfig struct {
 	Level      string
 	MaxSize    int
@@ -62,6 +105,8 @@ type LogConfig struct {
 type Config struct {
 	Server   ServerConfig
 	Database DatabaseConfig
+	RPC      RPCConfig
+	KDF      KDFConfig
 	Log      LogConfig
 }
 
@@ -69,10 +114,13 @@ type Config struct {
 func Load(env Env) (*Config, error) {
 	cfg := &Config{
 		Server: ServerConfig{
-			Environment:    env,
-			Port:           viper.GetInt(KeyServerPort),
-			Host:           viper.GetString(KeyServerHost),
-			SessionTimeout: viper.GetInt64(KeySessionTimeout),
+			Environment:        env,
+			Port:               viper.GetInt(KeyServerPort),
+			Host:               viper.GetString(KeyServerHost),
+			SessionTimeout:     viper.GetInt64(KeySessionTimeout),
+			SessionMaxLifetime: viper.GetInt64(KeySessionMaxLife),
+			MaxFailedAttempts:  viper.GetInt(KeyMaxAttempts),
+			LockoutDuration:    viper.GetInt64(KeyLockout),
 		},
 		Database: DatabaseConfig{
 			Driver:   viper.GetString(KeyDBDriver),
@@ -82,6 +130,17 @@ func Load(env Env) (*Config, error) {
 			User:     viper.GetString(KeyDBUser),
 			Password: viper.GetString(KeyDBPassword),
 		},
+		RPC: RPCConfig{
+			Endpoints: viper.GetStringSlice(KeyRPCEndpoints),
+			Token:     strings.TrimSpace(viper.GetString(KeyRPCToken)),
+			TokenFile: strings.TrimSpace(viper.GetString(KeyRPCTokenFile)),
+		},
+		KDF: KDFConfig{
+			Time:          viper.GetUint32(KeyKDFTime),
+			Memory:        viper.GetUint32(KeyKDFMemory),
+			Threads:       uint8(viper.GetUint(KeyKDFThreads)),
+			TargetLatency: viper.GetInt64(KeyKDFTargetLatency),
+		},
 		Log: LogConfig{
 			Level:      viper.GetString(KeyLogLevel),
 			MaxSize:    viper.GetInt(KeyLogMaxSize),
@@ -100,6 +159,14 @@ func Load(env Env) (*Config, error) {
 		return nil, fmt.Errorf("invalid configuration: %w", err)
 	}
 
+	if cfg.RPC.TokenFile != "" {
+		token, err := os.ReadFile(cfg.RPC.TokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("read rpc token file: %w", err)
+		}
+		cfg.RPC.Token = strings.TrimSpace(string(token))
+	}
+
 	return cfg, nil
 }
 
@@ -133,6 +200,14 @@ func validate(cfg *Config) error {
 		}
 	}
 
+	if cfg.RPC.Token != "" && cfg.RPC.TokenFile != "" {
+		errs = append(errs, fmt.Errorf("rpc.token and rpc.token_file are mutually exclusive"))
+	}
+
+	if cfg.KDF.TargetLatency < 0 {
+		errs = append(errs, fmt.Errorf("kdf.target_latency must not be negative"))
+	}
+
 	if len(errs) > 0 {
 		return errors.Join(errs...)
 	}
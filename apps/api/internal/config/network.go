@@ -1 +1,133 @@
 package config
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/codemaestro64/filament/apps/api/pkg/util"
+)
+
+// Public Lotus gateways used when no RPC endpoint is configured.
+const (
+	MainnetRPCEndpoint        = "https://api.node.glif.io/rpc/v1"
+	CalibrationNetRPCEndpoint = "https://api.calibration.node.glif.io/rpc/v1"
+)
+
+// DefaultRPCEndpoint returns the public Lotus gateway of network.
+func DefaultRPCEndpoint(network util.Network) string {
+	if network.IsMainnet() {
+		return MainnetRPCEndpoint
+	}
+
+	return CalibrationNetRPCEndpoint
+}
+
+// RPCEndpoint is a parsed Lotus endpoint.
+type RPCEndpoint struct {
+	URL   string
+	Token string
+}
+
+// EndpointsFor returns the configured endpoints, or the default of network.
+func (c RPCConfig) EndpointsFor(network util.Network) ([]RPCEndpoint, error) {
+	var endpoints []RPCEndpoint
+	for _, raw := range c.Endpoints {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+
+		endpoint, err := parseRPCEndpoint(raw)
+		if err != nil {
+			return nil, err
+		}
+		if endpoint.Token == "" {
+			endpoint.Token = c.Token
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+
+	if len(endpoints) == 0 {
+		endpoints = append(endpoints, RPCEndpoint{URL: DefaultRPCEndpoint(network), Token: c.Token})
+	}
+
+	return endpoints, nil
+}
+
+var rpcSchemes = []string{"http://", "https://", "ws://", "wss://"}
+
+// parseRPCEndpoint accepts a URL or a multiaddr, optionally in the Lotus API
+// info form TOKEN:ADDRESS. The part before the first colon is a token only
+// when a URL or multiaddr follows it, so host:port URLs are kept whole.
+func parseRPCEndpoint(raw string) (RPCEndpoint, error) {
+	var endpoint RPCEndpoint
+	endpoint.URL = raw
+
+	token, rest, found := strings.Cut(raw, ":")
+	if found && !hasRPCScheme(raw) && (hasRPCScheme(rest) || strings.HasPrefix(rest, "/")) {
+		endpoint.Token, endpoint.URL = token, rest
+	}
+
+	if strings.HasPrefix(endpoint.URL, "/") {
+		url, err := multiaddrURL(endpoint.URL)
+		if err != nil {
+			return RPCEndpoint{}, err
+		}
+		endpoint.URL = url
+	}
+
+	return endpoint, nil
+}
+
+func hasRPCScheme(raw string) bool {
+	for _, scheme := range rpcSchemes {
+		if strings.HasPrefix(raw, scheme) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// multiaddrURL converts a Lotus API multiaddr such as
+// /ip4/127.0.0.1/tcp/1234/http to the URL of its v1 JSON-RPC API. Without a
+// trailing protocol the API is reached over HTTP.
+func multiaddrURL(ma string) (string, error) {
+	parts := strings.Split(strings.TrimPrefix(ma, "/"), "/")
+	if len(parts) < 4 || parts[2] != "tcp" {
+		return "", fmt.Errorf("rpc endpoint %s: want /ip4|ip6|dns/<host>/tcp/<port>[/http|/https|/ws|/wss]", ma)
+	}
+
+	host, port := parts[1], parts[3]
+	switch parts[0] {
+	case "ip4", "ip6":
+		if net.ParseIP(host) == nil {
+			return "", fmt.Errorf("rpc endpoint %s: invalid ip %q", ma, host)
+		}
+	case "dns", "dns4", "dns6":
+	default:
+		return "", fmt.Errorf("rpc endpoint %s: unsupported protocol %q", ma, parts[0])
+	}
+
+	if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+		return "", fmt.Errorf("rpc endpoint %s: invalid port %q", ma, port)
+	}
+
+	var scheme string
+	switch strings.Join(parts[4:], "/") {
+	case "", "http":
+		scheme = "http"
+	case "https", "tls/http":
+		scheme = "https"
+	case "ws":
+		scheme = "ws"
+	case "wss", "tls/ws":
+		scheme = "wss"
+	default:
+		return "", fmt.Errorf("rpc endpoint %s: unsupported transport %q", ma, strings.Join(parts[4:], "/"))
+	}
+
+	return scheme + "://" + net.JoinHostPort(host, port) + "/rpc/v1", nil
+}
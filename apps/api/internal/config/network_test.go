@@ -0,0 +1,87 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/codemaestro64/filament/apps/api/pkg/util"
+)
+
+func TestParseRPCEndpoint(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    RPCEndpoint
+		wantErr bool
+	}{
+		{name: "https url", raw: "https://api.node.glif.io/rpc/v1", want: RPCEndpoint{URL: "https://api.node.glif.io/rpc/v1"}},
+		{name: "http url with port", raw: "http://127.0.0.1:1234/rpc/v1", want: RPCEndpoint{URL: "http://127.0.0.1:1234/rpc/v1"}},
+		{name: "ws url", raw: "ws://127.0.0.1:1234/rpc/v1", want: RPCEndpoint{URL: "ws://127.0.0.1:1234/rpc/v1"}},
+		{name: "host and port", raw: "localhost:1234/rpc/v1", want: RPCEndpoint{URL: "localhost:1234/rpc/v1"}},
+		{name: "token and url", raw: "eyJhbGc.eyJBbGxvdyI6.sig:https://node.example/rpc/v1", want: RPCEndpoint{URL: "https://node.example/rpc/v1", Token: "eyJhbGc.eyJBbGxvdyI6.sig"}},
+		{name: "token and url with port", raw: "tok:http://127.0.0.1:1234/rpc/v1", want: RPCEndpoint{URL: "http://127.0.0.1:1234/rpc/v1", Token: "tok"}},
+		{name: "multiaddr http", raw: "/ip4/127.0.0.1/tcp/1234/http", want: RPCEndpoint{URL: "http://127.0.0.1:1234/rpc/v1"}},
+		{name: "multiaddr without transport", raw: "/ip4/127.0.0.1/tcp/1234", want: RPCEndpoint{URL: "http://127.0.0.1:1234/rpc/v1"}},
+		{name: "multiaddr https", raw: "/dns/node.example/tcp/443/https", want: RPCEndpoint{URL: "https://node.example:443/rpc/v1"}},
+		{name: "multiaddr tls http", raw: "/dns4/node.example/tcp/443/tls/http", want: RPCEndpoint{URL: "https://node.example:443/rpc/v1"}},
+		{name: "multiaddr ws", raw: "/ip4/10.0.0.2/tcp/1234/ws", want: RPCEndpoint{URL: "ws://10.0.0.2:1234/rpc/v1"}},
+		{name: "multiaddr ip6", raw: "/ip6/::1/tcp/1234/http", want: RPCEndpoint{URL: "http://[::1]:1234/rpc/v1"}},
+		{name: "token and multiaddr", raw: "tok:/ip4/127.0.0.1/tcp/1234/http", want: RPCEndpoint{URL: "http://127.0.0.1:1234/rpc/v1", Token: "tok"}},
+
+		{name: "multiaddr without port", raw: "/ip4/127.0.0.1", wantErr: true},
+		{name: "multiaddr udp", raw: "/ip4/127.0.0.1/udp/1234/http", wantErr: true},
+		{name: "multiaddr bad ip", raw: "/ip4/localhost/tcp/1234/http", wantErr: true},
+		{name: "multiaddr bad port", raw: "/ip4/127.0.0.1/tcp/99999/http", wantErr: true},
+		{name: "multiaddr unknown transport", raw: "tok:/ip4/127.0.0.1/tcp/1234/quic", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseRPCEndpoint(tt.raw)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseRPCEndpoint(%q) = %+v, want error", tt.raw, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseRPCEndpoint(%q): %v", tt.raw, err)
+			}
+			if got != tt.want {
+				t.Errorf("parseRPCEndpoint(%q) = %+v, want %+v", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEndpointsFor(t *testing.T) {
+	cfg := RPCConfig{
+		Endpoints: []string{" own:/ip4/127.0.0.1/tcp/1234/http ", "", "https://api.node.glif.io/rpc/v1"},
+		Token:     "shared",
+	}
+
+	got, err := cfg.EndpointsFor(util.Mainnet)
+	if err != nil {
+		t.Fatalf("endpoints: %v", err)
+	}
+
+	want := []RPCEndpoint{
+		{URL: "http://127.0.0.1:1234/rpc/v1", Token: "own"},
+		{URL: "https://api.node.glif.io/rpc/v1", Token: "shared"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("endpoints = %+v, want %+v", got, want)
+	}
+
+	got, err = RPCConfig{Token: "shared"}.EndpointsFor(util.CalibrationNet)
+	if err != nil {
+		t.Fatalf("default endpoints: %v", err)
+	}
+	if want := []RPCEndpoint{{URL: CalibrationNetRPCEndpoint, Token: "shared"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("default endpoints = %+v, want %+v", got, want)
+	}
+
+	if _, err := (RPCConfig{Endpoints: []string{"/ip4/127.0.0.1"}}).EndpointsFor(util.Mainnet); err == nil {
+		t.Error("invalid multiaddr accepted")
+	}
+}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/codemaestro64/filament/libs/filwallet/address"
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
+)
+
+type UnsignedMessage struct {
+	From       string
+	To         string
+	Value      Amount
+	Nonce      uint64
+	GasLimit   int64
+	GasFeeCap  string
+	GasPremium string
+}
+
+type SignedMessage struct {
+	Message   UnsignedMessage
+	Cid       string
+	Signature []byte
+}
+
+type MigrateWalletRequest struct {
+	WalletID   int
+	Password   string
+	Commit     bool
+	BuildSweep bool
+}
+
+type MigrateWalletResponse struct {
+	WalletID         int
+	DerivationScheme wallet.DerivationScheme
+	LegacyAddresses  []address.Address
+	NewAddresses     []address.Address
+	Sweeps           []SignedMessage
+}
+
+type EndpointHealth struct {
+	URL                 string
+	Healthy             bool
+	InSync              bool
+	HeadHeight          int64
+	Latency             time.Duration
+	ConsecutiveFailures int
+	LastError           string
+	CheckedAt           time.Time
+	RetryAt             time.Time
+}
+
+type GetEndpointHealthRequest struct{}
+type GetEndpointHealthResponse struct {
+	Endpoints []EndpointHealth
+}
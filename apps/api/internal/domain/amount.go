@@ -0,0 +1,7 @@
+package domain
+
+type Amount struct {
+	Value    string
+	Ticker   string
+	Decimals uint32
+}
@@ -1,7 +1,76 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+	"strings"
+	"time"
+)
 
 var (
-	ErrInternalServer = errors.New("internal server error")
+	ErrInternalServer     = errors.New("internal server error")
+	ErrNotFound           = errors.New("not found")
+	ErrAlreadyExists      = errors.New("already exists")
+	ErrInvalidArgument    = errors.New("invalid argument")
+	ErrWrongPassword      = errors.New("wrong password")
+	ErrWalletLocked       = errors.New("wallet is locked")
+	ErrInsufficientFunds  = errors.New("insufficient funds")
+	ErrFailedPrecondition = errors.New("failed precondition")
+	ErrRateLimited        = errors.New("rate limited")
+	ErrTooManyAttempts    = errors.New("too many failed attempts")
 )
+
+// FieldViolation describes why a single request field is invalid.
+type FieldViolation struct {
+	Field   string
+	Message string
+}
+
+// ValidationError reports every invalid field of a request. It matches
+// ErrInvalidArgument with errors.Is.
+type ValidationError struct {
+	Violations []FieldViolation
+}
+
+// Add records a violation of field.
+func (e *ValidationError) Add(field, message string) {
+	e.Violations = append(e.Violations, FieldViolation{Field: field, Message: message})
+}
+
+// ErrOrNil returns e when it holds violations, nil otherwise.
+func (e *ValidationError) ErrOrNil() error {
+	if len(e.Violations) == 0 {
+		return nil
+	}
+
+	return e
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		msgs = append(msgs, v.Field+": "+v.Message)
+	}
+
+	return "invalid argument: " + strings.Join(msgs, "; ")
+}
+
+func (e *ValidationError) Unwrap() error {
+	return ErrInvalidArgument
+}
+
+// AttemptError reports a failed or refused password check together with how
+// many attempts are left before the wallet locks out.
+type AttemptError struct {
+	Err               error
+	RemainingAttempts int
+	// RetryAfter is zero when the next attempt is allowed right away.
+	RetryAfter time.Time
+}
+
+func (e *AttemptError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *AttemptError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/codemaestro64/filament/libs/filwallet/address"
+)
+
+type TransactionType int
+
+const (
+	TransactionTypeUnknown TransactionType = iota
+	TransactionTypeSend
+	TransactionTypeReceive
+	TransactionTypeFee
+	TransactionTypeInternal
+)
+
+type TransactionStatus int
+
+const (
+	TransactionStatusUnknown TransactionStatus = iota
+	TransactionStatusPending
+	TransactionStatusConfirmed
+	TransactionStatusFailed
+	TransactionStatusCanceled
+)
+
+type Transaction struct {
+	ID                 string
+	Type               TransactionType
+	Status             TransactionStatus
+	Amount             Amount
+	SourceAddress      address.Address
+	DestinationAddress address.Address
+	Fee                Amount
+	CreatedAt          time.Time
+}
+
+type SendTransactionRequest struct {
+	SourceWalletID     int
+	DestinationAddress string
+	Amount             Amount
+	MaxFee             *Amount
+	Note               string
+}
+
+type SendTransactionResponse struct {
+	Transaction Transaction
+}
@@ -0,0 +1,231 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/codemaestro64/filament/libs/filwallet/address"
+)
+
+type Wallet struct {
+	ID        int
+	IsDefault bool
+	Name      string
+	ActorID   string
+	Addresses []address.Address
+	// Balance is nil when the chain could not be queried.
+	Balance   *Amount
+	CreatedAt time.Time
+	// BackupVerifiedAt is zero until the seed phrase backup is verified.
+	BackupVerifiedAt time.Time
+}
+
+type GetWalletRequest struct {
+	WalletID int
+}
+
+type GetWalletResponse struct {
+	Wallet Wallet
+}
+
+type GetWalletsRequest struct{}
+type GetWalletsResponse struct {
+	Wallets []Wallet
+}
+
+type UnlockWalletsRequest struct {
+	Password string
+}
+
+type UnlockStatus int
+
+const (
+	UnlockStatusUnlocked UnlockStatus = iota
+	UnlockStatusWrongPassword
+	UnlockStatusCorrupted
+	UnlockStatusLockedOut
+)
+
+type WalletUnlockResult struct {
+	WalletID   int
+	Status     UnlockStatus
+	RetryAfter time.Time
+}
+
+type UnlockWalletsResponse struct {
+	Results []WalletUnlockResult
+}
+
+type LockWalletRequest struct {
+	WalletID int
+}
+
+type WalletSession struct {
+	WalletID   int
+	UnlockedAt time.Time
+	ExpiresAt  time.Time
+	Deadline   time.Time
+}
+
+type GetSessionStatusResponse struct {
+	Sessions []WalletSession
+}
+
+type CreateWalletRequest struct {
+	Name            string
+	Password        string
+	ConfirmPassword string
+	// Passphrase is the optional BIP39 passphrase of the new seed.
+	Passphrase string
+	// WordCount is 12 or 24, 12 when zero.
+	WordCount int
+	// Language is a BIP39 wordlist, English when empty.
+	Language string
+}
+
+type CreateWalletResponse struct {
+	WalletID   int
+	SeedPhrase string
+	Addresses  []address.Address
+}
+
+type RecoverWalletRequest struct {
+	Name            string
+	SeedPhrase      string
+	Password        string
+	ConfirmPassword string
+	Passphrase      string
+	// Language is detected when empty.
+	Language string
+}
+
+type RecoverWalletResponse struct {
+	WalletID int
+}
+
+type CreateShareBackupRequest struct {
+	WalletID  int
+	Password  string
+	Threshold int
+	Count     int
+}
+
+type CreateShareBackupResponse struct {
+	Shares []string
+	// Language is the wordlist of the seed, needed to recover it.
+	Language      string
+	HasPassphrase bool
+}
+
+type RecoverWalletFromSharesRequest struct {
+	Name            string
+	Shares          []string
+	Password        string
+	ConfirmPassword string
+	Passphrase      string
+	// Language is English when empty.
+	Language string
+}
+
+type RecoverWalletFromSharesResponse struct {
+	WalletID int
+}
+
+type RevealSeedPhraseRequest struct {
+	WalletID int
+	Password string
+}
+
+type RevealSeedPhraseResponse struct {
+	Words         []string
+	Language      string
+	HasPassphrase bool
+}
+
+type StartBackupVerificationRequest struct {
+	WalletID int
+	Password string
+}
+
+type StartBackupVerificationResponse struct {
+	Positions []int
+	ExpiresAt time.Time
+}
+
+type VerifyBackupRequest struct {
+	WalletID int
+	Words    []string
+}
+
+type VerifyBackupResponse struct {
+	BackupVerifiedAt time.Time
+}
+
+type ImportKeyRequest struct {
+	Name            string
+	PrivateKey      string
+	Password        string
+	ConfirmPassword string
+}
+
+type ImportKeyResponse struct {
+	WalletID  int
+	Addresses []address.Address
+}
+
+type ImportKeystoreRequest struct {
+	Name             string
+	KeystoreJSON     string
+	KeystorePassword string
+	Password         string
+	ConfirmPassword  string
+}
+
+type ImportKeystoreResponse struct {
+	WalletID  int
+	Addresses []address.Address
+}
+
+type ChangePasswordRequest struct {
+	WalletID        int
+	OldPassword     string
+	NewPassword     string
+	ConfirmPassword string
+	AllWallets      bool
+}
+
+type ChangePasswordResponse struct {
+	WalletIDs []int
+}
+
+type KeyExportFormat int
+
+const (
+	KeyExportFormatKeyInfo KeyExportFormat = iota
+	KeyExportFormatKeystore
+)
+
+type ExportKeyRequest struct {
+	WalletID       int
+	AccountIndex   uint32
+	Password       string
+	Format         KeyExportFormat
+	ExportPassword string
+}
+
+type ExportKeyResponse struct {
+	Key string
+}
+
+type ConvertAddressRequest struct {
+	Address string
+}
+
+// ConvertAddressResponse holds the equivalent forms of an address. Forms that
+// are unknown or do not exist are empty.
+type ConvertAddressResponse struct {
+	ID        string
+	Robust    string
+	Delegated string
+	Eth       string
+	MaskedID  string
+}
@@ -19,7 +19,9 @@ type Database struct {
 	client *orm.Client
 }
 
-func New(cfg config.DatabaseConfig, dataDir string, env config.Env) (*Database, error) {
+// New opens the database and migrates its schema, so that it can be queried
+// before the component is started.
+func New(ctx context.Context, cfg config.DatabaseConfig, dataDir string, env config.Env) (*Database, error) {
 	var dsn string
 	driver := cfg.Driver
 
@@ -30,8 +32,9 @@ func New(cfg config.DatabaseConfig, dataDir string, env config.Env) (*Database,
 		if env == config.Development {
 			dbPath += "_dev"
 		}
-		// PRAGMAs are part of the DSN; Ent handles this during Open.
-		dsn = fmt.Sprintf("file:%s.db?_pragma=foreign_keys(1)&_pragma=journal_mode(WAL)", dbPath)
+		// PRAGMAs are part of the DSN, in the parameters of the mattn
+		// driver; Ent refuses to migrate without foreign keys.
+		dsn = fmt.Sprintf("file:%s.db?_fk=1&_journal_mode=WAL", dbPath)
 
 	case "postgres", "postgresql":
 		driver = dialect.Postgres
@@ -53,6 +56,11 @@ func New(cfg config.DatabaseConfig, dataDir string, env config.Env) (*Database,
 		return nil, fmt.Errorf("orm open: %w", err)
 	}
 
+	if err := client.Schema.Create(ctx); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("migrate schema: %w", err)
+	}
+
 	return &Database{client: client}, nil
 }
 
@@ -9,8 +9,9 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	ormaddress "github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/address"
-	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/wallet"
+	ormwallet "github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/wallet"
 	"github.com/codemaestro64/filament/libs/filwallet/address"
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
 )
 
 // Address is the model entity for the Address schema.
@@ -22,6 +23,14 @@ type Address struct {
 	Type address.Type `json:"type,omitempty"`
 	// Address holds the value of the "address" field.
 	Address string `json:"address,omitempty"`
+	// AccountIndex holds the value of the "account_index" field.
+	AccountIndex uint32 `json:"account_index,omitempty"`
+	// DerivationPath holds the value of the "derivation_path" field.
+	DerivationPath string `json:"derivation_path,omitempty"`
+	// KeyType holds the value of the "key_type" field.
+	KeyType wallet.KeyType `json:"key_type,omitempty"`
+	// ActorID holds the value of the "actor_id" field.
+	ActorID string `json:"actor_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the AddressQuery when eager-loading is set.
 	Edges            AddressEdges `json:"edges"`
@@ -44,7 +53,7 @@ func (e AddressEdges) WalletOrErr() (*Wallet, error) {
 	if e.Wallet != nil {
 		return e.Wallet, nil
 	} else if e.loadedTypes[0] {
-		return nil, &NotFoundError{label: wallet.Label}
+		return nil, &NotFoundError{label: ormwallet.Label}
 	}
 	return nil, &NotLoadedError{edge: "wallet"}
 }
@@ -54,9 +63,9 @@ func (*Address) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case ormaddress.FieldID, ormaddress.FieldType:
+		case ormaddress.FieldID, ormaddress.FieldType, ormaddress.FieldAccountIndex:
 			values[i] = new(sql.NullInt64)
-		case ormaddress.FieldAddress:
+		case ormaddress.FieldAddress, ormaddress.FieldDerivationPath, ormaddress.FieldKeyType, ormaddress.FieldActorID:
 			values[i] = new(sql.NullString)
 		case ormaddress.ForeignKeys[0]: // wallet_addresses
 			values[i] = new(sql.NullInt64)
@@ -93,6 +102,30 @@ func (_m *Address) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Address = value.String
 			}
+		case ormaddress.FieldAccountIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field account_index", values[i])
+			} else if value.Valid {
+				_m.AccountIndex = uint32(value.Int64)
+			}
+		case ormaddress.FieldDerivationPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field derivation_path", values[i])
+			} else if value.Valid {
+				_m.DerivationPath = value.String
+			}
+		case ormaddress.FieldKeyType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_type", values[i])
+			} else if value.Valid {
+				_m.KeyType = wallet.KeyType(value.String)
+			}
+		case ormaddress.FieldActorID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_id", values[i])
+			} else if value.Valid {
+				_m.ActorID = value.String
+			}
 		case ormaddress.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field wallet_addresses", value)
@@ -146,6 +179,18 @@ func (_m *Address) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("address=")
 	builder.WriteString(_m.Address)
+	builder.WriteString(", ")
+	builder.WriteString("account_index=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccountIndex))
+	builder.WriteString(", ")
+	builder.WriteString("derivation_path=")
+	builder.WriteString(_m.DerivationPath)
+	builder.WriteString(", ")
+	builder.WriteString("key_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.KeyType))
+	builder.WriteString(", ")
+	builder.WriteString("actor_id=")
+	builder.WriteString(_m.ActorID)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,8 +3,11 @@
 package ormaddress
 
 import (
+	"fmt"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
 )
 
 const (
@@ -16,6 +19,14 @@ const (
 	FieldType = "type"
 	// FieldAddress holds the string denoting the address field in the database.
 	FieldAddress = "address"
+	// FieldAccountIndex holds the string denoting the account_index field in the database.
+	FieldAccountIndex = "account_index"
+	// FieldDerivationPath holds the string denoting the derivation_path field in the database.
+	FieldDerivationPath = "derivation_path"
+	// FieldKeyType holds the string denoting the key_type field in the database.
+	FieldKeyType = "key_type"
+	// FieldActorID holds the string denoting the actor_id field in the database.
+	FieldActorID = "actor_id"
 	// EdgeWallet holds the string denoting the wallet edge name in mutations.
 	EdgeWallet = "wallet"
 	// Table holds the table name of the address in the database.
@@ -23,7 +34,7 @@ const (
 	// WalletTable is the table that holds the wallet relation/edge.
 	WalletTable = "addresses"
 	// WalletInverseTable is the table name for the Wallet entity.
-	// It exists in this package in order to avoid circular dependency with the "wallet" package.
+	// It exists in this package in order to avoid circular dependency with the "ormwallet" package.
 	WalletInverseTable = "wallets"
 	// WalletColumn is the table column denoting the wallet relation/edge.
 	WalletColumn = "wallet_addresses"
@@ -34,6 +45,10 @@ var Columns = []string{
 	FieldID,
 	FieldType,
 	FieldAddress,
+	FieldAccountIndex,
+	FieldDerivationPath,
+	FieldKeyType,
+	FieldActorID,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "addresses"
@@ -60,8 +75,22 @@ func ValidColumn(column string) bool {
 var (
 	// AddressValidator is a validator for the "address" field. It is called by the builders before save.
 	AddressValidator func(string) error
+	// DefaultAccountIndex holds the default value on creation for the "account_index" field.
+	DefaultAccountIndex uint32
 )
 
+const DefaultKeyType wallet.KeyType = "secp256k1"
+
+// KeyTypeValidator is a validator for the "key_type" field enum values. It is called by the builders before save.
+func KeyTypeValidator(kt wallet.KeyType) error {
+	switch kt.String() {
+	case "secp256k1", "bls":
+		return nil
+	default:
+		return fmt.Errorf("ormaddress: invalid enum value for key_type field: %q", kt)
+	}
+}
+
 // OrderOption defines the ordering options for the Address queries.
 type OrderOption func(*sql.Selector)
 
@@ -80,6 +109,26 @@ func ByAddress(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAddress, opts...).ToFunc()
 }
 
+// ByAccountIndex orders the results by the account_index field.
+func ByAccountIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountIndex, opts...).ToFunc()
+}
+
+// ByDerivationPath orders the results by the derivation_path field.
+func ByDerivationPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDerivationPath, opts...).ToFunc()
+}
+
+// ByKeyType orders the results by the key_type field.
+func ByKeyType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeyType, opts...).ToFunc()
+}
+
+// ByActorID orders the results by the actor_id field.
+func ByActorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActorID, opts...).ToFunc()
+}
+
 // ByWalletField orders the results by wallet field.
 func ByWalletField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -7,6 +7,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/predicate"
 	"github.com/codemaestro64/filament/libs/filwallet/address"
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
 )
 
 // ID filters vertices based on their ID field.
@@ -65,6 +66,21 @@ func Address(v string) predicate.Address {
 	return predicate.Address(sql.FieldEQ(FieldAddress, v))
 }
 
+// AccountIndex applies equality check predicate on the "account_index" field. It's identical to AccountIndexEQ.
+func AccountIndex(v uint32) predicate.Address {
+	return predicate.Address(sql.FieldEQ(FieldAccountIndex, v))
+}
+
+// DerivationPath applies equality check predicate on the "derivation_path" field. It's identical to DerivationPathEQ.
+func DerivationPath(v string) predicate.Address {
+	return predicate.Address(sql.FieldEQ(FieldDerivationPath, v))
+}
+
+// ActorID applies equality check predicate on the "actor_id" field. It's identical to ActorIDEQ.
+func ActorID(v string) predicate.Address {
+	return predicate.Address(sql.FieldEQ(FieldActorID, v))
+}
+
 // TypeEQ applies the EQ predicate on the "type" field.
 func TypeEQ(v address.Type) predicate.Address {
 	vc := int32(v)
@@ -184,6 +200,226 @@ func AddressContainsFold(v string) predicate.Address {
 	return predicate.Address(sql.FieldContainsFold(FieldAddress, v))
 }
 
+// AccountIndexEQ applies the EQ predicate on the "account_index" field.
+func AccountIndexEQ(v uint32) predicate.Address {
+	return predicate.Address(sql.FieldEQ(FieldAccountIndex, v))
+}
+
+// AccountIndexNEQ applies the NEQ predicate on the "account_index" field.
+func AccountIndexNEQ(v uint32) predicate.Address {
+	return predicate.Address(sql.FieldNEQ(FieldAccountIndex, v))
+}
+
+// AccountIndexIn applies the In predicate on the "account_index" field.
+func AccountIndexIn(vs ...uint32) predicate.Address {
+	return predicate.Address(sql.FieldIn(FieldAccountIndex, vs...))
+}
+
+// AccountIndexNotIn applies the NotIn predicate on the "account_index" field.
+func AccountIndexNotIn(vs ...uint32) predicate.Address {
+	return predicate.Address(sql.FieldNotIn(FieldAccountIndex, vs...))
+}
+
+// AccountIndexGT applies the GT predicate on the "account_index" field.
+func AccountIndexGT(v uint32) predicate.Address {
+	return predicate.Address(sql.FieldGT(FieldAccountIndex, v))
+}
+
+// AccountIndexGTE applies the GTE predicate on the "account_index" field.
+func AccountIndexGTE(v uint32) predicate.Address {
+	return predicate.Address(sql.FieldGTE(FieldAccountIndex, v))
+}
+
+// AccountIndexLT applies the LT predicate on the "account_index" field.
+func AccountIndexLT(v uint32) predicate.Address {
+	return predicate.Address(sql.FieldLT(FieldAccountIndex, v))
+}
+
+// AccountIndexLTE applies the LTE predicate on the "account_index" field.
+func AccountIndexLTE(v uint32) predicate.Address {
+	return predicate.Address(sql.FieldLTE(FieldAccountIndex, v))
+}
+
+// DerivationPathEQ applies the EQ predicate on the "derivation_path" field.
+func DerivationPathEQ(v string) predicate.Address {
+	return predicate.Address(sql.FieldEQ(FieldDerivationPath, v))
+}
+
+// DerivationPathNEQ applies the NEQ predicate on the "derivation_path" field.
+func DerivationPathNEQ(v string) predicate.Address {
+	return predicate.Address(sql.FieldNEQ(FieldDerivationPath, v))
+}
+
+// DerivationPathIn applies the In predicate on the "derivation_path" field.
+func DerivationPathIn(vs ...string) predicate.Address {
+	return predicate.Address(sql.FieldIn(FieldDerivationPath, vs...))
+}
+
+// DerivationPathNotIn applies the NotIn predicate on the "derivation_path" field.
+func DerivationPathNotIn(vs ...string) predicate.Address {
+	return predicate.Address(sql.FieldNotIn(FieldDerivationPath, vs...))
+}
+
+// DerivationPathGT applies the GT predicate on the "derivation_path" field.
+func DerivationPathGT(v string) predicate.Address {
+	return predicate.Address(sql.FieldGT(FieldDerivationPath, v))
+}
+
+// DerivationPathGTE applies the GTE predicate on the "derivation_path" field.
+func DerivationPathGTE(v string) predicate.Address {
+	return predicate.Address(sql.FieldGTE(FieldDerivationPath, v))
+}
+
+// DerivationPathLT applies the LT predicate on the "derivation_path" field.
+func DerivationPathLT(v string) predicate.Address {
+	return predicate.Address(sql.FieldLT(FieldDerivationPath, v))
+}
+
+// DerivationPathLTE applies the LTE predicate on the "derivation_path" field.
+func DerivationPathLTE(v string) predicate.Address {
+	return predicate.Address(sql.FieldLTE(FieldDerivationPath, v))
+}
+
+// DerivationPathContains applies the Contains predicate on the "derivation_path" field.
+func DerivationPathContains(v string) predicate.Address {
+	return predicate.Address(sql.FieldContains(FieldDerivationPath, v))
+}
+
+// DerivationPathHasPrefix applies the HasPrefix predicate on the "derivation_path" field.
+func DerivationPathHasPrefix(v string) predicate.Address {
+	return predicate.Address(sql.FieldHasPrefix(FieldDerivationPath, v))
+}
+
+// DerivationPathHasSuffix applies the HasSuffix predicate on the "derivation_path" field.
+func DerivationPathHasSuffix(v string) predicate.Address {
+	return predicate.Address(sql.FieldHasSuffix(FieldDerivationPath, v))
+}
+
+// DerivationPathIsNil applies the IsNil predicate on the "derivation_path" field.
+func DerivationPathIsNil() predicate.Address {
+	return predicate.Address(sql.FieldIsNull(FieldDerivationPath))
+}
+
+// DerivationPathNotNil applies the NotNil predicate on the "derivation_path" field.
+func DerivationPathNotNil() predicate.Address {
+	return predicate.Address(sql.FieldNotNull(FieldDerivationPath))
+}
+
+// DerivationPathEqualFold applies the EqualFold predicate on the "derivation_path" field.
+func DerivationPathEqualFold(v string) predicate.Address {
+	return predicate.Address(sql.FieldEqualFold(FieldDerivationPath, v))
+}
+
+// DerivationPathContainsFold applies the ContainsFold predicate on the "derivation_path" field.
+func DerivationPathContainsFold(v string) predicate.Address {
+	return predicate.Address(sql.FieldContainsFold(FieldDerivationPath, v))
+}
+
+// KeyTypeEQ applies the EQ predicate on the "key_type" field.
+func KeyTypeEQ(v wallet.KeyType) predicate.Address {
+	vc := v
+	return predicate.Address(sql.FieldEQ(FieldKeyType, vc))
+}
+
+// KeyTypeNEQ applies the NEQ predicate on the "key_type" field.
+func KeyTypeNEQ(v wallet.KeyType) predicate.Address {
+	vc := v
+	return predicate.Address(sql.FieldNEQ(FieldKeyType, vc))
+}
+
+// KeyTypeIn applies the In predicate on the "key_type" field.
+func KeyTypeIn(vs ...wallet.KeyType) predicate.Address {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Address(sql.FieldIn(FieldKeyType, v...))
+}
+
+// KeyTypeNotIn applies the NotIn predicate on the "key_type" field.
+func KeyTypeNotIn(vs ...wallet.KeyType) predicate.Address {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Address(sql.FieldNotIn(FieldKeyType, v...))
+}
+
+// ActorIDEQ applies the EQ predicate on the "actor_id" field.
+func ActorIDEQ(v string) predicate.Address {
+	return predicate.Address(sql.FieldEQ(FieldActorID, v))
+}
+
+// ActorIDNEQ applies the NEQ predicate on the "actor_id" field.
+func ActorIDNEQ(v string) predicate.Address {
+	return predicate.Address(sql.FieldNEQ(FieldActorID, v))
+}
+
+// ActorIDIn applies the In predicate on the "actor_id" field.
+func ActorIDIn(vs ...string) predicate.Address {
+	return predicate.Address(sql.FieldIn(FieldActorID, vs...))
+}
+
+// ActorIDNotIn applies the NotIn predicate on the "actor_id" field.
+func ActorIDNotIn(vs ...string) predicate.Address {
+	return predicate.Address(sql.FieldNotIn(FieldActorID, vs...))
+}
+
+// ActorIDGT applies the GT predicate on the "actor_id" field.
+func ActorIDGT(v string) predicate.Address {
+	return predicate.Address(sql.FieldGT(FieldActorID, v))
+}
+
+// ActorIDGTE applies the GTE predicate on the "actor_id" field.
+func ActorIDGTE(v string) predicate.Address {
+	return predicate.Address(sql.FieldGTE(FieldActorID, v))
+}
+
+// ActorIDLT applies the LT predicate on the "actor_id" field.
+func ActorIDLT(v string) predicate.Address {
+	return predicate.Address(sql.FieldLT(FieldActorID, v))
+}
+
+// ActorIDLTE applies the LTE predicate on the "actor_id" field.
+func ActorIDLTE(v string) predicate.Address {
+	return predicate.Address(sql.FieldLTE(FieldActorID, v))
+}
+
+// ActorIDContains applies the Contains predicate on the "actor_id" field.
+func ActorIDContains(v string) predicate.Address {
+	return predicate.Address(sql.FieldContains(FieldActorID, v))
+}
+
+// ActorIDHasPrefix applies the HasPrefix predicate on the "actor_id" field.
+func ActorIDHasPrefix(v string) predicate.Address {
+	return predicate.Address(sql.FieldHasPrefix(FieldActorID, v))
+}
+
+// ActorIDHasSuffix applies the HasSuffix predicate on the "actor_id" field.
+func ActorIDHasSuffix(v string) predicate.Address {
+	return predicate.Address(sql.FieldHasSuffix(FieldActorID, v))
+}
+
+// ActorIDIsNil applies the IsNil predicate on the "actor_id" field.
+func ActorIDIsNil() predicate.Address {
+	return predicate.Address(sql.FieldIsNull(FieldActorID))
+}
+
+// ActorIDNotNil applies the NotNil predicate on the "actor_id" field.
+func ActorIDNotNil() predicate.Address {
+	return predicate.Address(sql.FieldNotNull(FieldActorID))
+}
+
+// ActorIDEqualFold applies the EqualFold predicate on the "actor_id" field.
+func ActorIDEqualFold(v string) predicate.Address {
+	return predicate.Address(sql.FieldEqualFold(FieldActorID, v))
+}
+
+// ActorIDContainsFold applies the ContainsFold predicate on the "actor_id" field.
+func ActorIDContainsFold(v string) predicate.Address {
+	return predicate.Address(sql.FieldContainsFold(FieldActorID, v))
+}
+
 // HasWallet applies the HasEdge predicate on the "wallet" edge.
 func HasWallet() predicate.Address {
 	return predicate.Address(func(s *sql.Selector) {
@@ -10,8 +10,9 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	ormaddress "github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/address"
-	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/wallet"
+	ormwallet "github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/wallet"
 	"github.com/codemaestro64/filament/libs/filwallet/address"
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
 )
 
 // AddressCreate is the builder for creating a Address entity.
@@ -33,6 +34,62 @@ func (_c *AddressCreate) SetAddress(v string) *AddressCreate {
 	return _c
 }
 
+// SetAccountIndex sets the "account_index" field.
+func (_c *AddressCreate) SetAccountIndex(v uint32) *AddressCreate {
+	_c.mutation.SetAccountIndex(v)
+	return _c
+}
+
+// SetNillableAccountIndex sets the "account_index" field if the given value is not nil.
+func (_c *AddressCreate) SetNillableAccountIndex(v *uint32) *AddressCreate {
+	if v != nil {
+		_c.SetAccountIndex(*v)
+	}
+	return _c
+}
+
+// SetDerivationPath sets the "derivation_path" field.
+func (_c *AddressCreate) SetDerivationPath(v string) *AddressCreate {
+	_c.mutation.SetDerivationPath(v)
+	return _c
+}
+
+// SetNillableDerivationPath sets the "derivation_path" field if the given value is not nil.
+func (_c *AddressCreate) SetNillableDerivationPath(v *string) *AddressCreate {
+	if v != nil {
+		_c.SetDerivationPath(*v)
+	}
+	return _c
+}
+
+// SetKeyType sets the "key_type" field.
+func (_c *AddressCreate) SetKeyType(v wallet.KeyType) *AddressCreate {
+	_c.mutation.SetKeyType(v)
+	return _c
+}
+
+// SetNillableKeyType sets the "key_type" field if the given value is not nil.
+func (_c *AddressCreate) SetNillableKeyType(v *wallet.KeyType) *AddressCreate {
+	if v != nil {
+		_c.SetKeyType(*v)
+	}
+	return _c
+}
+
+// SetActorID sets the "actor_id" field.
+func (_c *AddressCreate) SetActorID(v string) *AddressCreate {
+	_c.mutation.SetActorID(v)
+	return _c
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_c *AddressCreate) SetNillableActorID(v *string) *AddressCreate {
+	if v != nil {
+		_c.SetActorID(*v)
+	}
+	return _c
+}
+
 // SetWalletID sets the "wallet" edge to the Wallet entity by ID.
 func (_c *AddressCreate) SetWalletID(id int) *AddressCreate {
 	_c.mutation.SetWalletID(id)
@@ -51,6 +108,7 @@ func (_c *AddressCreate) Mutation() *AddressMutation {
 
 // Save creates the Address in the database.
 func (_c *AddressCreate) Save(ctx context.Context) (*Address, error) {
+	_c.defaults()
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -76,6 +134,18 @@ func (_c *AddressCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_c *AddressCreate) defaults() {
+	if _, ok := _c.mutation.AccountIndex(); !ok {
+		v := ormaddress.DefaultAccountIndex
+		_c.mutation.SetAccountIndex(v)
+	}
+	if _, ok := _c.mutation.KeyType(); !ok {
+		v := ormaddress.DefaultKeyType
+		_c.mutation.SetKeyType(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_c *AddressCreate) check() error {
 	if _, ok := _c.mutation.GetType(); !ok {
@@ -89,6 +159,17 @@ func (_c *AddressCreate) check() error {
 			return &ValidationError{Name: "address", err: fmt.Errorf(`orm: validator failed for field "Address.address": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.AccountIndex(); !ok {
+		return &ValidationError{Name: "account_index", err: errors.New(`orm: missing required field "Address.account_index"`)}
+	}
+	if _, ok := _c.mutation.KeyType(); !ok {
+		return &ValidationError{Name: "key_type", err: errors.New(`orm: missing required field "Address.key_type"`)}
+	}
+	if v, ok := _c.mutation.KeyType(); ok {
+		if err := ormaddress.KeyTypeValidator(v); err != nil {
+			return &ValidationError{Name: "key_type", err: fmt.Errorf(`orm: validator failed for field "Address.key_type": %w`, err)}
+		}
+	}
 	if len(_c.mutation.WalletIDs()) == 0 {
 		return &ValidationError{Name: "wallet", err: errors.New(`orm: missing required edge "Address.wallet"`)}
 	}
@@ -126,6 +207,22 @@ func (_c *AddressCreate) createSpec() (*Address, *sqlgraph.CreateSpec) {
 		_spec.SetField(ormaddress.FieldAddress, field.TypeString, value)
 		_node.Address = value
 	}
+	if value, ok := _c.mutation.AccountIndex(); ok {
+		_spec.SetField(ormaddress.FieldAccountIndex, field.TypeUint32, value)
+		_node.AccountIndex = value
+	}
+	if value, ok := _c.mutation.DerivationPath(); ok {
+		_spec.SetField(ormaddress.FieldDerivationPath, field.TypeString, value)
+		_node.DerivationPath = value
+	}
+	if value, ok := _c.mutation.KeyType(); ok {
+		_spec.SetField(ormaddress.FieldKeyType, field.TypeEnum, value)
+		_node.KeyType = value
+	}
+	if value, ok := _c.mutation.ActorID(); ok {
+		_spec.SetField(ormaddress.FieldActorID, field.TypeString, value)
+		_node.ActorID = value
+	}
 	if nodes := _c.mutation.WalletIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -134,7 +231,7 @@ func (_c *AddressCreate) createSpec() (*Address, *sqlgraph.CreateSpec) {
 			Columns: []string{ormaddress.WalletColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(ormwallet.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -164,6 +261,7 @@ func (_c *AddressCreateBulk) Save(ctx context.Context) ([]*Address, error) {
 	for i := range _c.builders {
 		func(i int, root context.Context) {
 			builder := _c.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*AddressMutation)
 				if !ok {
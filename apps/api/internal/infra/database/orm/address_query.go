@@ -13,7 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	ormaddress "github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/address"
 	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/predicate"
-	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/wallet"
+	ormwallet "github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/wallet"
 )
 
 // AddressQuery is the builder for querying Address entities.
@@ -74,7 +74,7 @@ func (_q *AddressQuery) QueryWallet() *WalletQuery {
 		}
 		step := sqlgraph.NewStep(
 			sqlgraph.From(ormaddress.Table, ormaddress.FieldID, selector),
-			sqlgraph.To(wallet.Table, wallet.FieldID),
+			sqlgraph.To(ormwallet.Table, ormwallet.FieldID),
 			sqlgraph.Edge(sqlgraph.M2O, true, ormaddress.WalletTable, ormaddress.WalletColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
@@ -425,7 +425,7 @@ func (_q *AddressQuery) loadWallet(ctx context.Context, query *WalletQuery, node
 	if len(ids) == 0 {
 		return nil
 	}
-	query.Where(wallet.IDIn(ids...))
+	query.Where(ormwallet.IDIn(ids...))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
@@ -12,8 +12,9 @@ import (
 	"entgo.io/ent/schema/field"
 	ormaddress "github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/address"
 	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/predicate"
-	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/wallet"
+	ormwallet "github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/wallet"
 	"github.com/codemaestro64/filament/libs/filwallet/address"
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
 )
 
 // AddressUpdate is the builder for updating Address entities.
@@ -64,6 +65,81 @@ func (_u *AddressUpdate) SetNillableAddress(v *string) *AddressUpdate {
 	return _u
 }
 
+// SetAccountIndex sets the "account_index" field.
+func (_u *AddressUpdate) SetAccountIndex(v uint32) *AddressUpdate {
+	_u.mutation.ResetAccountIndex()
+	_u.mutation.SetAccountIndex(v)
+	return _u
+}
+
+// SetNillableAccountIndex sets the "account_index" field if the given value is not nil.
+func (_u *AddressUpdate) SetNillableAccountIndex(v *uint32) *AddressUpdate {
+	if v != nil {
+		_u.SetAccountIndex(*v)
+	}
+	return _u
+}
+
+// AddAccountIndex adds value to the "account_index" field.
+func (_u *AddressUpdate) AddAccountIndex(v int32) *AddressUpdate {
+	_u.mutation.AddAccountIndex(v)
+	return _u
+}
+
+// SetDerivationPath sets the "derivation_path" field.
+func (_u *AddressUpdate) SetDerivationPath(v string) *AddressUpdate {
+	_u.mutation.SetDerivationPath(v)
+	return _u
+}
+
+// SetNillableDerivationPath sets the "derivation_path" field if the given value is not nil.
+func (_u *AddressUpdate) SetNillableDerivationPath(v *string) *AddressUpdate {
+	if v != nil {
+		_u.SetDerivationPath(*v)
+	}
+	return _u
+}
+
+// ClearDerivationPath clears the value of the "derivation_path" field.
+func (_u *AddressUpdate) ClearDerivationPath() *AddressUpdate {
+	_u.mutation.ClearDerivationPath()
+	return _u
+}
+
+// SetKeyType sets the "key_type" field.
+func (_u *AddressUpdate) SetKeyType(v wallet.KeyType) *AddressUpdate {
+	_u.mutation.SetKeyType(v)
+	return _u
+}
+
+// SetNillableKeyType sets the "key_type" field if the given value is not nil.
+func (_u *AddressUpdate) SetNillableKeyType(v *wallet.KeyType) *AddressUpdate {
+	if v != nil {
+		_u.SetKeyType(*v)
+	}
+	return _u
+}
+
+// SetActorID sets the "actor_id" field.
+func (_u *AddressUpdate) SetActorID(v string) *AddressUpdate {
+	_u.mutation.SetActorID(v)
+	return _u
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_u *AddressUpdate) SetNillableActorID(v *string) *AddressUpdate {
+	if v != nil {
+		_u.SetActorID(*v)
+	}
+	return _u
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (_u *AddressUpdate) ClearActorID() *AddressUpdate {
+	_u.mutation.ClearActorID()
+	return _u
+}
+
 // SetWalletID sets the "wallet" edge to the Wallet entity by ID.
 func (_u *AddressUpdate) SetWalletID(id int) *AddressUpdate {
 	_u.mutation.SetWalletID(id)
@@ -120,6 +196,11 @@ func (_u *AddressUpdate) check() error {
 			return &ValidationError{Name: "address", err: fmt.Errorf(`orm: validator failed for field "Address.address": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.KeyType(); ok {
+		if err := ormaddress.KeyTypeValidator(v); err != nil {
+			return &ValidationError{Name: "key_type", err: fmt.Errorf(`orm: validator failed for field "Address.key_type": %w`, err)}
+		}
+	}
 	if _u.mutation.WalletCleared() && len(_u.mutation.WalletIDs()) > 0 {
 		return errors.New(`orm: clearing a required unique edge "Address.wallet"`)
 	}
@@ -147,6 +228,27 @@ func (_u *AddressUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Address(); ok {
 		_spec.SetField(ormaddress.FieldAddress, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.AccountIndex(); ok {
+		_spec.SetField(ormaddress.FieldAccountIndex, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedAccountIndex(); ok {
+		_spec.AddField(ormaddress.FieldAccountIndex, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.DerivationPath(); ok {
+		_spec.SetField(ormaddress.FieldDerivationPath, field.TypeString, value)
+	}
+	if _u.mutation.DerivationPathCleared() {
+		_spec.ClearField(ormaddress.FieldDerivationPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.KeyType(); ok {
+		_spec.SetField(ormaddress.FieldKeyType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ActorID(); ok {
+		_spec.SetField(ormaddress.FieldActorID, field.TypeString, value)
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(ormaddress.FieldActorID, field.TypeString)
+	}
 	if _u.mutation.WalletCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -155,7 +257,7 @@ func (_u *AddressUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			Columns: []string{ormaddress.WalletColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(ormwallet.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
@@ -168,7 +270,7 @@ func (_u *AddressUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			Columns: []string{ormaddress.WalletColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(ormwallet.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -231,6 +333,81 @@ func (_u *AddressUpdateOne) SetNillableAddress(v *string) *AddressUpdateOne {
 	return _u
 }
 
+// SetAccountIndex sets the "account_index" field.
+func (_u *AddressUpdateOne) SetAccountIndex(v uint32) *AddressUpdateOne {
+	_u.mutation.ResetAccountIndex()
+	_u.mutation.SetAccountIndex(v)
+	return _u
+}
+
+// SetNillableAccountIndex sets the "account_index" field if the given value is not nil.
+func (_u *AddressUpdateOne) SetNillableAccountIndex(v *uint32) *AddressUpdateOne {
+	if v != nil {
+		_u.SetAccountIndex(*v)
+	}
+	return _u
+}
+
+// AddAccountIndex adds value to the "account_index" field.
+func (_u *AddressUpdateOne) AddAccountIndex(v int32) *AddressUpdateOne {
+	_u.mutation.AddAccountIndex(v)
+	return _u
+}
+
+// SetDerivationPath sets the "derivation_path" field.
+func (_u *AddressUpdateOne) SetDerivationPath(v string) *AddressUpdateOne {
+	_u.mutation.SetDerivationPath(v)
+	return _u
+}
+
+// SetNillableDerivationPath sets the "derivation_path" field if the given value is not nil.
+func (_u *AddressUpdateOne) SetNillableDerivationPath(v *string) *AddressUpdateOne {
+	if v != nil {
+		_u.SetDerivationPath(*v)
+	}
+	return _u
+}
+
+// ClearDerivationPath clears the value of the "derivation_path" field.
+func (_u *AddressUpdateOne) ClearDerivationPath() *AddressUpdateOne {
+	_u.mutation.ClearDerivationPath()
+	return _u
+}
+
+// SetKeyType sets the "key_type" field.
+func (_u *AddressUpdateOne) SetKeyType(v wallet.KeyType) *AddressUpdateOne {
+	_u.mutation.SetKeyType(v)
+	return _u
+}
+
+// SetNillableKeyType sets the "key_type" field if the given value is not nil.
+func (_u *AddressUpdateOne) SetNillableKeyType(v *wallet.KeyType) *AddressUpdateOne {
+	if v != nil {
+		_u.SetKeyType(*v)
+	}
+	return _u
+}
+
+// SetActorID sets the "actor_id" field.
+func (_u *AddressUpdateOne) SetActorID(v string) *AddressUpdateOne {
+	_u.mutation.SetActorID(v)
+	return _u
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_u *AddressUpdateOne) SetNillableActorID(v *string) *AddressUpdateOne {
+	if v != nil {
+		_u.SetActorID(*v)
+	}
+	return _u
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (_u *AddressUpdateOne) ClearActorID() *AddressUpdateOne {
+	_u.mutation.ClearActorID()
+	return _u
+}
+
 // SetWalletID sets the "wallet" edge to the Wallet entity by ID.
 func (_u *AddressUpdateOne) SetWalletID(id int) *AddressUpdateOne {
 	_u.mutation.SetWalletID(id)
@@ -300,6 +477,11 @@ func (_u *AddressUpdateOne) check() error {
 			return &ValidationError{Name: "address", err: fmt.Errorf(`orm: validator failed for field "Address.address": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.KeyType(); ok {
+		if err := ormaddress.KeyTypeValidator(v); err != nil {
+			return &ValidationError{Name: "key_type", err: fmt.Errorf(`orm: validator failed for field "Address.key_type": %w`, err)}
+		}
+	}
 	if _u.mutation.WalletCleared() && len(_u.mutation.WalletIDs()) > 0 {
 		return errors.New(`orm: clearing a required unique edge "Address.wallet"`)
 	}
@@ -344,6 +526,27 @@ func (_u *AddressUpdateOne) sqlSave(ctx context.Context) (_node *Address, err er
 	if value, ok := _u.mutation.Address(); ok {
 		_spec.SetField(ormaddress.FieldAddress, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.AccountIndex(); ok {
+		_spec.SetField(ormaddress.FieldAccountIndex, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedAccountIndex(); ok {
+		_spec.AddField(ormaddress.FieldAccountIndex, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.DerivationPath(); ok {
+		_spec.SetField(ormaddress.FieldDerivationPath, field.TypeString, value)
+	}
+	if _u.mutation.DerivationPathCleared() {
+		_spec.ClearField(ormaddress.FieldDerivationPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.KeyType(); ok {
+		_spec.SetField(ormaddress.FieldKeyType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ActorID(); ok {
+		_spec.SetField(ormaddress.FieldActorID, field.TypeString, value)
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(ormaddress.FieldActorID, field.TypeString)
+	}
 	if _u.mutation.WalletCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -352,7 +555,7 @@ func (_u *AddressUpdateOne) sqlSave(ctx context.Context) (_node *Address, err er
 			Columns: []string{ormaddress.WalletColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(ormwallet.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
@@ -365,7 +568,7 @@ func (_u *AddressUpdateOne) sqlSave(ctx context.Context) (_node *Address, err er
 			Columns: []string{ormaddress.WalletColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(ormwallet.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -0,0 +1,153 @@
+// Code generated by ent, DO NOT EDIT.
+
+package orm
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/auditevent"
+	"github.com/codemaestro64/filament/libs/filwallet"
+)
+
+// AuditEvent is the model entity for the AuditEvent schema.
+type AuditEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// WalletID holds the value of the "wallet_id" field.
+	WalletID int `json:"wallet_id,omitempty"`
+	// Action holds the value of the "action" field.
+	Action filwallet.AuditAction `json:"action,omitempty"`
+	// Detail holds the value of the "detail" field.
+	Detail string `json:"detail,omitempty"`
+	// Success holds the value of the "success" field.
+	Success bool `json:"success,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditevent.FieldSuccess:
+			values[i] = new(sql.NullBool)
+		case auditevent.FieldID, auditevent.FieldWalletID:
+			values[i] = new(sql.NullInt64)
+		case auditevent.FieldAction, auditevent.FieldDetail:
+			values[i] = new(sql.NullString)
+		case auditevent.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditEvent fields.
+func (_m *AuditEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case auditevent.FieldWalletID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field wallet_id", values[i])
+			} else if value.Valid {
+				_m.WalletID = int(value.Int64)
+			}
+		case auditevent.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = filwallet.AuditAction(value.String)
+			}
+		case auditevent.FieldDetail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field detail", values[i])
+			} else if value.Valid {
+				_m.Detail = value.String
+			}
+		case auditevent.FieldSuccess:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field success", values[i])
+			} else if value.Valid {
+				_m.Success = value.Bool
+			}
+		case auditevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AuditEvent.
+// This includes values selected through modifiers, order, etc.
+func (_m *AuditEvent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AuditEvent.
+// Note that you need to call AuditEvent.Unwrap() before calling this method if this AuditEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AuditEvent) Update() *AuditEventUpdateOne {
+	return NewAuditEventClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AuditEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AuditEvent) Unwrap() *AuditEvent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("orm: AuditEvent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AuditEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("wallet_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WalletID))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Action))
+	builder.WriteString(", ")
+	builder.WriteString("detail=")
+	builder.WriteString(_m.Detail)
+	builder.WriteString(", ")
+	builder.WriteString("success=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Success))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditEvents is a parsable slice of AuditEvent.
+type AuditEvents []*AuditEvent
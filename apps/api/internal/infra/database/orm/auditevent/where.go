@@ -0,0 +1,346 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auditevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/predicate"
+	"github.com/codemaestro64/filament/libs/filwallet"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldLTE(FieldID, id))
+}
+
+// WalletID applies equality check predicate on the "wallet_id" field. It's identical to WalletIDEQ.
+func WalletID(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEQ(FieldWalletID, v))
+}
+
+// Action applies equality check predicate on the "action" field. It's identical to ActionEQ.
+func Action(v filwallet.AuditAction) predicate.AuditEvent {
+	vc := string(v)
+	return predicate.AuditEvent(sql.FieldEQ(FieldAction, vc))
+}
+
+// Detail applies equality check predicate on the "detail" field. It's identical to DetailEQ.
+func Detail(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEQ(FieldDetail, v))
+}
+
+// Success applies equality check predicate on the "success" field. It's identical to SuccessEQ.
+func Success(v bool) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEQ(FieldSuccess, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// WalletIDEQ applies the EQ predicate on the "wallet_id" field.
+func WalletIDEQ(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEQ(FieldWalletID, v))
+}
+
+// WalletIDNEQ applies the NEQ predicate on the "wallet_id" field.
+func WalletIDNEQ(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldNEQ(FieldWalletID, v))
+}
+
+// WalletIDIn applies the In predicate on the "wallet_id" field.
+func WalletIDIn(vs ...int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldIn(FieldWalletID, vs...))
+}
+
+// WalletIDNotIn applies the NotIn predicate on the "wallet_id" field.
+func WalletIDNotIn(vs ...int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldNotIn(FieldWalletID, vs...))
+}
+
+// WalletIDGT applies the GT predicate on the "wallet_id" field.
+func WalletIDGT(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldGT(FieldWalletID, v))
+}
+
+// WalletIDGTE applies the GTE predicate on the "wallet_id" field.
+func WalletIDGTE(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldGTE(FieldWalletID, v))
+}
+
+// WalletIDLT applies the LT predicate on the "wallet_id" field.
+func WalletIDLT(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldLT(FieldWalletID, v))
+}
+
+// WalletIDLTE applies the LTE predicate on the "wallet_id" field.
+func WalletIDLTE(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldLTE(FieldWalletID, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v filwallet.AuditAction) predicate.AuditEvent {
+	vc := string(v)
+	return predicate.AuditEvent(sql.FieldEQ(FieldAction, vc))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v filwallet.AuditAction) predicate.AuditEvent {
+	vc := string(v)
+	return predicate.AuditEvent(sql.FieldNEQ(FieldAction, vc))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...filwallet.AuditAction) predicate.AuditEvent {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.AuditEvent(sql.FieldIn(FieldAction, v...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...filwallet.AuditAction) predicate.AuditEvent {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.AuditEvent(sql.FieldNotIn(FieldAction, v...))
+}
+
+// ActionGT applies the GT predicate on the "action" field.
+func ActionGT(v filwallet.AuditAction) predicate.AuditEvent {
+	vc := string(v)
+	return predicate.AuditEvent(sql.FieldGT(FieldAction, vc))
+}
+
+// ActionGTE applies the GTE predicate on the "action" field.
+func ActionGTE(v filwallet.AuditAction) predicate.AuditEvent {
+	vc := string(v)
+	return predicate.AuditEvent(sql.FieldGTE(FieldAction, vc))
+}
+
+// ActionLT applies the LT predicate on the "action" field.
+func ActionLT(v filwallet.AuditAction) predicate.AuditEvent {
+	vc := string(v)
+	return predicate.AuditEvent(sql.FieldLT(FieldAction, vc))
+}
+
+// ActionLTE applies the LTE predicate on the "action" field.
+func ActionLTE(v filwallet.AuditAction) predicate.AuditEvent {
+	vc := string(v)
+	return predicate.AuditEvent(sql.FieldLTE(FieldAction, vc))
+}
+
+// ActionContains applies the Contains predicate on the "action" field.
+func ActionContains(v filwallet.AuditAction) predicate.AuditEvent {
+	vc := string(v)
+	return predicate.AuditEvent(sql.FieldContains(FieldAction, vc))
+}
+
+// ActionHasPrefix applies the HasPrefix predicate on the "action" field.
+func ActionHasPrefix(v filwallet.AuditAction) predicate.AuditEvent {
+	vc := string(v)
+	return predicate.AuditEvent(sql.FieldHasPrefix(FieldAction, vc))
+}
+
+// ActionHasSuffix applies the HasSuffix predicate on the "action" field.
+func ActionHasSuffix(v filwallet.AuditAction) predicate.AuditEvent {
+	vc := string(v)
+	return predicate.AuditEvent(sql.FieldHasSuffix(FieldAction, vc))
+}
+
+// ActionEqualFold applies the EqualFold predicate on the "action" field.
+func ActionEqualFold(v filwallet.AuditAction) predicate.AuditEvent {
+	vc := string(v)
+	return predicate.AuditEvent(sql.FieldEqualFold(FieldAction, vc))
+}
+
+// ActionContainsFold applies the ContainsFold predicate on the "action" field.
+func ActionContainsFold(v filwallet.AuditAction) predicate.AuditEvent {
+	vc := string(v)
+	return predicate.AuditEvent(sql.FieldContainsFold(FieldAction, vc))
+}
+
+// DetailEQ applies the EQ predicate on the "detail" field.
+func DetailEQ(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEQ(FieldDetail, v))
+}
+
+// DetailNEQ applies the NEQ predicate on the "detail" field.
+func DetailNEQ(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldNEQ(FieldDetail, v))
+}
+
+// DetailIn applies the In predicate on the "detail" field.
+func DetailIn(vs ...string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldIn(FieldDetail, vs...))
+}
+
+// DetailNotIn applies the NotIn predicate on the "detail" field.
+func DetailNotIn(vs ...string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldNotIn(FieldDetail, vs...))
+}
+
+// DetailGT applies the GT predicate on the "detail" field.
+func DetailGT(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldGT(FieldDetail, v))
+}
+
+// DetailGTE applies the GTE predicate on the "detail" field.
+func DetailGTE(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldGTE(FieldDetail, v))
+}
+
+// DetailLT applies the LT predicate on the "detail" field.
+func DetailLT(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldLT(FieldDetail, v))
+}
+
+// DetailLTE applies the LTE predicate on the "detail" field.
+func DetailLTE(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldLTE(FieldDetail, v))
+}
+
+// DetailContains applies the Contains predicate on the "detail" field.
+func DetailContains(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldContains(FieldDetail, v))
+}
+
+// DetailHasPrefix applies the HasPrefix predicate on the "detail" field.
+func DetailHasPrefix(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldHasPrefix(FieldDetail, v))
+}
+
+// DetailHasSuffix applies the HasSuffix predicate on the "detail" field.
+func DetailHasSuffix(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldHasSuffix(FieldDetail, v))
+}
+
+// DetailIsNil applies the IsNil predicate on the "detail" field.
+func DetailIsNil() predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldIsNull(FieldDetail))
+}
+
+// DetailNotNil applies the NotNil predicate on the "detail" field.
+func DetailNotNil() predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldNotNull(FieldDetail))
+}
+
+// DetailEqualFold applies the EqualFold predicate on the "detail" field.
+func DetailEqualFold(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEqualFold(FieldDetail, v))
+}
+
+// DetailContainsFold applies the ContainsFold predicate on the "detail" field.
+func DetailContainsFold(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldContainsFold(FieldDetail, v))
+}
+
+// SuccessEQ applies the EQ predicate on the "success" field.
+func SuccessEQ(v bool) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEQ(FieldSuccess, v))
+}
+
+// SuccessNEQ applies the NEQ predicate on the "success" field.
+func SuccessNEQ(v bool) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldNEQ(FieldSuccess, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AuditEvent) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AuditEvent) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AuditEvent) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.NotPredicates(p))
+}
@@ -0,0 +1,376 @@
+// Code generated by ent, DO NOT EDIT.
+
+package orm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/auditevent"
+	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/predicate"
+	"github.com/codemaestro64/filament/libs/filwallet"
+)
+
+// AuditEventUpdate is the builder for updating AuditEvent entities.
+type AuditEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuditEventMutation
+}
+
+// Where appends a list predicates to the AuditEventUpdate builder.
+func (_u *AuditEventUpdate) Where(ps ...predicate.AuditEvent) *AuditEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (_u *AuditEventUpdate) SetWalletID(v int) *AuditEventUpdate {
+	_u.mutation.ResetWalletID()
+	_u.mutation.SetWalletID(v)
+	return _u
+}
+
+// SetNillableWalletID sets the "wallet_id" field if the given value is not nil.
+func (_u *AuditEventUpdate) SetNillableWalletID(v *int) *AuditEventUpdate {
+	if v != nil {
+		_u.SetWalletID(*v)
+	}
+	return _u
+}
+
+// AddWalletID adds value to the "wallet_id" field.
+func (_u *AuditEventUpdate) AddWalletID(v int) *AuditEventUpdate {
+	_u.mutation.AddWalletID(v)
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *AuditEventUpdate) SetAction(v filwallet.AuditAction) *AuditEventUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *AuditEventUpdate) SetNillableAction(v *filwallet.AuditAction) *AuditEventUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetDetail sets the "detail" field.
+func (_u *AuditEventUpdate) SetDetail(v string) *AuditEventUpdate {
+	_u.mutation.SetDetail(v)
+	return _u
+}
+
+// SetNillableDetail sets the "detail" field if the given value is not nil.
+func (_u *AuditEventUpdate) SetNillableDetail(v *string) *AuditEventUpdate {
+	if v != nil {
+		_u.SetDetail(*v)
+	}
+	return _u
+}
+
+// ClearDetail clears the value of the "detail" field.
+func (_u *AuditEventUpdate) ClearDetail() *AuditEventUpdate {
+	_u.mutation.ClearDetail()
+	return _u
+}
+
+// SetSuccess sets the "success" field.
+func (_u *AuditEventUpdate) SetSuccess(v bool) *AuditEventUpdate {
+	_u.mutation.SetSuccess(v)
+	return _u
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (_u *AuditEventUpdate) SetNillableSuccess(v *bool) *AuditEventUpdate {
+	if v != nil {
+		_u.SetSuccess(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AuditEventMutation object of the builder.
+func (_u *AuditEventUpdate) Mutation() *AuditEventMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AuditEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuditEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AuditEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuditEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AuditEventUpdate) check() error {
+	if v, ok := _u.mutation.Action(); ok {
+		if err := auditevent.ActionValidator(string(v)); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`orm: validator failed for field "AuditEvent.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AuditEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auditevent.Table, auditevent.Columns, sqlgraph.NewFieldSpec(auditevent.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WalletID(); ok {
+		_spec.SetField(auditevent.FieldWalletID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWalletID(); ok {
+		_spec.AddField(auditevent.FieldWalletID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(auditevent.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Detail(); ok {
+		_spec.SetField(auditevent.FieldDetail, field.TypeString, value)
+	}
+	if _u.mutation.DetailCleared() {
+		_spec.ClearField(auditevent.FieldDetail, field.TypeString)
+	}
+	if value, ok := _u.mutation.Success(); ok {
+		_spec.SetField(auditevent.FieldSuccess, field.TypeBool, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AuditEventUpdateOne is the builder for updating a single AuditEvent entity.
+type AuditEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuditEventMutation
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (_u *AuditEventUpdateOne) SetWalletID(v int) *AuditEventUpdateOne {
+	_u.mutation.ResetWalletID()
+	_u.mutation.SetWalletID(v)
+	return _u
+}
+
+// SetNillableWalletID sets the "wallet_id" field if the given value is not nil.
+func (_u *AuditEventUpdateOne) SetNillableWalletID(v *int) *AuditEventUpdateOne {
+	if v != nil {
+		_u.SetWalletID(*v)
+	}
+	return _u
+}
+
+// AddWalletID adds value to the "wallet_id" field.
+func (_u *AuditEventUpdateOne) AddWalletID(v int) *AuditEventUpdateOne {
+	_u.mutation.AddWalletID(v)
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *AuditEventUpdateOne) SetAction(v filwallet.AuditAction) *AuditEventUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *AuditEventUpdateOne) SetNillableAction(v *filwallet.AuditAction) *AuditEventUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetDetail sets the "detail" field.
+func (_u *AuditEventUpdateOne) SetDetail(v string) *AuditEventUpdateOne {
+	_u.mutation.SetDetail(v)
+	return _u
+}
+
+// SetNillableDetail sets the "detail" field if the given value is not nil.
+func (_u *AuditEventUpdateOne) SetNillableDetail(v *string) *AuditEventUpdateOne {
+	if v != nil {
+		_u.SetDetail(*v)
+	}
+	return _u
+}
+
+// ClearDetail clears the value of the "detail" field.
+func (_u *AuditEventUpdateOne) ClearDetail() *AuditEventUpdateOne {
+	_u.mutation.ClearDetail()
+	return _u
+}
+
+// SetSuccess sets the "success" field.
+func (_u *AuditEventUpdateOne) SetSuccess(v bool) *AuditEventUpdateOne {
+	_u.mutation.SetSuccess(v)
+	return _u
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (_u *AuditEventUpdateOne) SetNillableSuccess(v *bool) *AuditEventUpdateOne {
+	if v != nil {
+		_u.SetSuccess(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AuditEventMutation object of the builder.
+func (_u *AuditEventUpdateOne) Mutation() *AuditEventMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AuditEventUpdate builder.
+func (_u *AuditEventUpdateOne) Where(ps ...predicate.AuditEvent) *AuditEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AuditEventUpdateOne) Select(field string, fields ...string) *AuditEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AuditEvent entity.
+func (_u *AuditEventUpdateOne) Save(ctx context.Context) (*AuditEvent, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuditEventUpdateOne) SaveX(ctx context.Context) *AuditEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AuditEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuditEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AuditEventUpdateOne) check() error {
+	if v, ok := _u.mutation.Action(); ok {
+		if err := auditevent.ActionValidator(string(v)); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`orm: validator failed for field "AuditEvent.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AuditEventUpdateOne) sqlSave(ctx context.Context) (_node *AuditEvent, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auditevent.Table, auditevent.Columns, sqlgraph.NewFieldSpec(auditevent.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`orm: missing "AuditEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditevent.FieldID)
+		for _, f := range fields {
+			if !auditevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("orm: invalid field %q for query", f)}
+			}
+			if f != auditevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WalletID(); ok {
+		_spec.SetField(auditevent.FieldWalletID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWalletID(); ok {
+		_spec.AddField(auditevent.FieldWalletID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(auditevent.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Detail(); ok {
+		_spec.SetField(auditevent.FieldDetail, field.TypeString, value)
+	}
+	if _u.mutation.DetailCleared() {
+		_spec.ClearField(auditevent.FieldDetail, field.TypeString)
+	}
+	if value, ok := _u.mutation.Success(); ok {
+		_spec.SetField(auditevent.FieldSuccess, field.TypeBool, value)
+	}
+	_node = &AuditEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
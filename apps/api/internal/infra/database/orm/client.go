@@ -16,8 +16,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	ormaddress "github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/address"
+	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/auditevent"
 	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/setting"
-	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/wallet"
+	ormwallet "github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/wallet"
 )
 
 // Client is the client that holds all ent builders.
@@ -27,6 +28,8 @@ type Client struct {
 	Schema *migrate.Schema
 	// Address is the client for interacting with the Address builders.
 	Address *AddressClient
+	// AuditEvent is the client for interacting with the AuditEvent builders.
+	AuditEvent *AuditEventClient
 	// Setting is the client for interacting with the Setting builders.
 	Setting *SettingClient
 	// Wallet is the client for interacting with the Wallet builders.
@@ -43,6 +46,7 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Address = NewAddressClient(c.config)
+	c.AuditEvent = NewAuditEventClient(c.config)
 	c.Setting = NewSettingClient(c.config)
 	c.Wallet = NewWalletClient(c.config)
 }
@@ -135,11 +139,12 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Address: NewAddressClient(cfg),
-		Setting: NewSettingClient(cfg),
-		Wallet:  NewWalletClient(cfg),
+		ctx:        ctx,
+		config:     cfg,
+		Address:    NewAddressClient(cfg),
+		AuditEvent: NewAuditEventClient(cfg),
+		Setting:    NewSettingClient(cfg),
+		Wallet:     NewWalletClient(cfg),
 	}, nil
 }
 
@@ -157,11 +162,12 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Address: NewAddressClient(cfg),
-		Setting: NewSettingClient(cfg),
-		Wallet:  NewWalletClient(cfg),
+		ctx:        ctx,
+		config:     cfg,
+		Address:    NewAddressClient(cfg),
+		AuditEvent: NewAuditEventClient(cfg),
+		Setting:    NewSettingClient(cfg),
+		Wallet:     NewWalletClient(cfg),
 	}, nil
 }
 
@@ -191,6 +197,7 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	c.Address.Use(hooks...)
+	c.AuditEvent.Use(hooks...)
 	c.Setting.Use(hooks...)
 	c.Wallet.Use(hooks...)
 }
@@ -199,6 +206,7 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	c.Address.Intercept(interceptors...)
+	c.AuditEvent.Intercept(interceptors...)
 	c.Setting.Intercept(interceptors...)
 	c.Wallet.Intercept(interceptors...)
 }
@@ -208,6 +216,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *AddressMutation:
 		return c.Address.mutate(ctx, m)
+	case *AuditEventMutation:
+		return c.AuditEvent.mutate(ctx, m)
 	case *SettingMutation:
 		return c.Setting.mutate(ctx, m)
 	case *WalletMutation:
@@ -332,7 +342,7 @@ func (c *AddressClient) QueryWallet(_m *Address) *WalletQuery {
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(ormaddress.Table, ormaddress.FieldID, id),
-			sqlgraph.To(wallet.Table, wallet.FieldID),
+			sqlgraph.To(ormwallet.Table, ormwallet.FieldID),
 			sqlgraph.Edge(sqlgraph.M2O, true, ormaddress.WalletTable, ormaddress.WalletColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
@@ -366,6 +376,139 @@ func (c *AddressClient) mutate(ctx context.Context, m *AddressMutation) (Value,
 	}
 }
 
+// AuditEventClient is a client for the AuditEvent schema.
+type AuditEventClient struct {
+	config
+}
+
+// NewAuditEventClient returns a client for the AuditEvent from the given config.
+func NewAuditEventClient(c config) *AuditEventClient {
+	return &AuditEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditevent.Hooks(f(g(h())))`.
+func (c *AuditEventClient) Use(hooks ...Hook) {
+	c.hooks.AuditEvent = append(c.hooks.AuditEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditevent.Intercept(f(g(h())))`.
+func (c *AuditEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditEvent = append(c.inters.AuditEvent, interceptors...)
+}
+
+// Create returns a builder for creating a AuditEvent entity.
+func (c *AuditEventClient) Create() *AuditEventCreate {
+	mutation := newAuditEventMutation(c.config, OpCreate)
+	return &AuditEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditEvent entities.
+func (c *AuditEventClient) CreateBulk(builders ...*AuditEventCreate) *AuditEventCreateBulk {
+	return &AuditEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditEventClient) MapCreateBulk(slice any, setFunc func(*AuditEventCreate, int)) *AuditEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditEventCreateBulk{err: fmt.Errorf("calling to AuditEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditEvent.
+func (c *AuditEventClient) Update() *AuditEventUpdate {
+	mutation := newAuditEventMutation(c.config, OpUpdate)
+	return &AuditEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditEventClient) UpdateOne(_m *AuditEvent) *AuditEventUpdateOne {
+	mutation := newAuditEventMutation(c.config, OpUpdateOne, withAuditEvent(_m))
+	return &AuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditEventClient) UpdateOneID(id int) *AuditEventUpdateOne {
+	mutation := newAuditEventMutation(c.config, OpUpdateOne, withAuditEventID(id))
+	return &AuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditEvent.
+func (c *AuditEventClient) Delete() *AuditEventDelete {
+	mutation := newAuditEventMutation(c.config, OpDelete)
+	return &AuditEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditEventClient) DeleteOne(_m *AuditEvent) *AuditEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditEventClient) DeleteOneID(id int) *AuditEventDeleteOne {
+	builder := c.Delete().Where(auditevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditEventDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditEvent.
+func (c *AuditEventClient) Query() *AuditEventQuery {
+	return &AuditEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditEvent entity by its id.
+func (c *AuditEventClient) Get(ctx context.Context, id int) (*AuditEvent, error) {
+	return c.Query().Where(auditevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditEventClient) GetX(ctx context.Context, id int) *AuditEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditEventClient) Hooks() []Hook {
+	return c.hooks.AuditEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditEventClient) Interceptors() []Interceptor {
+	return c.inters.AuditEvent
+}
+
+func (c *AuditEventClient) mutate(ctx context.Context, m *AuditEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("orm: unknown AuditEvent mutation op: %q", m.Op())
+	}
+}
+
 // SettingClient is a client for the Setting schema.
 type SettingClient struct {
 	config
@@ -510,13 +653,13 @@ func NewWalletClient(c config) *WalletClient {
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `wallet.Hooks(f(g(h())))`.
+// A call to `Use(f, g, h)` equals to `ormwallet.Hooks(f(g(h())))`.
 func (c *WalletClient) Use(hooks ...Hook) {
 	c.hooks.Wallet = append(c.hooks.Wallet, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `wallet.Intercept(f(g(h())))`.
+// A call to `Intercept(f, g, h)` equals to `ormwallet.Intercept(f(g(h())))`.
 func (c *WalletClient) Intercept(interceptors ...Interceptor) {
 	c.inters.Wallet = append(c.inters.Wallet, interceptors...)
 }
@@ -578,7 +721,7 @@ func (c *WalletClient) DeleteOne(_m *Wallet) *WalletDeleteOne {
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
 func (c *WalletClient) DeleteOneID(id int) *WalletDeleteOne {
-	builder := c.Delete().Where(wallet.ID(id))
+	builder := c.Delete().Where(ormwallet.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
 	return &WalletDeleteOne{builder}
@@ -595,7 +738,7 @@ func (c *WalletClient) Query() *WalletQuery {
 
 // Get returns a Wallet entity by its id.
 func (c *WalletClient) Get(ctx context.Context, id int) (*Wallet, error) {
-	return c.Query().Where(wallet.ID(id)).Only(ctx)
+	return c.Query().Where(ormwallet.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
@@ -613,9 +756,9 @@ func (c *WalletClient) QueryAddresses(_m *Wallet) *AddressQuery {
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(wallet.Table, wallet.FieldID, id),
+			sqlgraph.From(ormwallet.Table, ormwallet.FieldID, id),
 			sqlgraph.To(ormaddress.Table, ormaddress.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, wallet.AddressesTable, wallet.AddressesColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, ormwallet.AddressesTable, ormwallet.AddressesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -651,9 +794,9 @@ func (c *WalletClient) mutate(ctx context.Context, m *WalletMutation) (Value, er
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Address, Setting, Wallet []ent.Hook
+		Address, AuditEvent, Setting, Wallet []ent.Hook
 	}
 	inters struct {
-		Address, Setting, Wallet []ent.Interceptor
+		Address, AuditEvent, Setting, Wallet []ent.Interceptor
 	}
 )
@@ -14,8 +14,10 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 
 	ormaddress "github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/address"
+	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/auditevent"
 	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/setting"
-	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/wallet"
+
+	ormwallet "github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/wallet"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -77,8 +79,9 @@ func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			ormaddress.Table: ormaddress.ValidColumn,
+			auditevent.Table: auditevent.ValidColumn,
 			setting.Table:    setting.ValidColumn,
-			wallet.Table:     wallet.ValidColumn,
+			ormwallet.Table:  ormwallet.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -21,6 +21,18 @@ func (f AddressFunc) Mutate(ctx context.Context, m orm.Mutation) (orm.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *orm.AddressMutation", m)
 }
 
+// The AuditEventFunc type is an adapter to allow the use of ordinary
+// function as AuditEvent mutator.
+type AuditEventFunc func(context.Context, *orm.AuditEventMutation) (orm.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditEventFunc) Mutate(ctx context.Context, m orm.Mutation) (orm.Value, error) {
+	if mv, ok := m.(*orm.AuditEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *orm.AuditEventMutation", m)
+}
+
 // The SettingFunc type is an adapter to allow the use of ordinary
 // function as Setting mutator.
 type SettingFunc func(context.Context, *orm.SettingMutation) (orm.Value, error)
@@ -13,6 +13,10 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "type", Type: field.TypeInt32},
 		{Name: "address", Type: field.TypeString, Unique: true},
+		{Name: "account_index", Type: field.TypeUint32, Default: 0},
+		{Name: "derivation_path", Type: field.TypeString, Nullable: true},
+		{Name: "key_type", Type: field.TypeEnum, Enums: []string{"secp256k1", "bls"}, Default: "secp256k1"},
+		{Name: "actor_id", Type: field.TypeString, Nullable: true},
 		{Name: "wallet_addresses", Type: field.TypeInt},
 	}
 	// AddressesTable holds the schema information for the "addresses" table.
@@ -23,12 +27,34 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "addresses_wallets_addresses",
-				Columns:    []*schema.Column{AddressesColumns[3]},
+				Columns:    []*schema.Column{AddressesColumns[7]},
 				RefColumns: []*schema.Column{WalletsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// AuditEventsColumns holds the columns for the "audit_events" table.
+	AuditEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "wallet_id", Type: field.TypeInt},
+		{Name: "action", Type: field.TypeString},
+		{Name: "detail", Type: field.TypeString, Nullable: true},
+		{Name: "success", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditEventsTable holds the schema information for the "audit_events" table.
+	AuditEventsTable = &schema.Table{
+		Name:       "audit_events",
+		Columns:    AuditEventsColumns,
+		PrimaryKey: []*schema.Column{AuditEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditevent_wallet_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[1], AuditEventsColumns[5]},
+			},
+		},
+	}
 	// SettingsColumns holds the columns for the "settings" table.
 	SettingsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -43,13 +69,22 @@ var (
 	WalletsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "is_default", Type: field.TypeBool, Default: false},
-		{Name: "actor_id", Type: field.TypeString},
+		{Name: "actor_id", Type: field.TypeString, Nullable: true},
 		{Name: "name", Type: field.TypeString},
-		{Name: "encrypted_seed", Type: field.TypeBytes},
+		{Name: "derivation_scheme", Type: field.TypeEnum, Enums: []string{"legacy", "bip44", "imported"}, Default: "legacy"},
+		{Name: "encrypted_seed", Type: field.TypeBytes, Nullable: true},
+		{Name: "encrypted_passphrase", Type: field.TypeBytes, Nullable: true},
 		{Name: "encrypted_key_json", Type: field.TypeBytes},
 		{Name: "salt", Type: field.TypeBytes},
+		{Name: "kdf_algorithm", Type: field.TypeEnum, Enums: []string{"argon2id"}, Default: "argon2id"},
+		{Name: "kdf_time", Type: field.TypeUint32, Default: 1},
+		{Name: "kdf_memory", Type: field.TypeUint32, Default: 65536},
+		{Name: "kdf_threads", Type: field.TypeUint8, Default: 4},
+		{Name: "failed_attempts", Type: field.TypeInt, Default: 0},
+		{Name: "retry_after", Type: field.TypeTime, Nullable: true},
+		{Name: "backup_verified_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
-		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
 	}
 	// WalletsTable holds the schema information for the "wallets" table.
 	WalletsTable = &schema.Table{
@@ -60,6 +95,7 @@ var (
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AddressesTable,
+		AuditEventsTable,
 		SettingsTable,
 		WalletsTable,
 	}
@@ -12,9 +12,12 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	ormaddress "github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/address"
+	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/auditevent"
 	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/predicate"
-	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/wallet"
+	ormwallet "github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/wallet"
+	"github.com/codemaestro64/filament/libs/filwallet"
 	"github.com/codemaestro64/filament/libs/filwallet/address"
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
 )
 
 const (
@@ -26,26 +29,32 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAddress = "Address"
-	TypeSetting = "Setting"
-	TypeWallet  = "Wallet"
+	TypeAddress    = "Address"
+	TypeAuditEvent = "AuditEvent"
+	TypeSetting    = "Setting"
+	TypeWallet     = "Wallet"
 )
 
 // AddressMutation represents an operation that mutates the Address nodes in the graph.
 type AddressMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	_type         *address.Type
-	add_type      *address.Type
-	address       *string
-	clearedFields map[string]struct{}
-	wallet        *int
-	clearedwallet bool
-	done          bool
-	oldValue      func(context.Context) (*Address, error)
-	predicates    []predicate.Address
+	op               Op
+	typ              string
+	id               *int
+	_type            *address.Type
+	add_type         *address.Type
+	address          *string
+	account_index    *uint32
+	addaccount_index *int32
+	derivation_path  *string
+	key_type         *wallet.KeyType
+	actor_id         *string
+	clearedFields    map[string]struct{}
+	wallet           *int
+	clearedwallet    bool
+	done             bool
+	oldValue         func(context.Context) (*Address, error)
+	predicates       []predicate.Address
 }
 
 var _ ent.Mutation = (*AddressMutation)(nil)
@@ -238,6 +247,196 @@ func (m *AddressMutation) ResetAddress() {
 	m.address = nil
 }
 
+// SetAccountIndex sets the "account_index" field.
+func (m *AddressMutation) SetAccountIndex(u uint32) {
+	m.account_index = &u
+	m.addaccount_index = nil
+}
+
+// AccountIndex returns the value of the "account_index" field in the mutation.
+func (m *AddressMutation) AccountIndex() (r uint32, exists bool) {
+	v := m.account_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountIndex returns the old "account_index" field's value of the Address entity.
+// If the Address object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AddressMutation) OldAccountIndex(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountIndex: %w", err)
+	}
+	return oldValue.AccountIndex, nil
+}
+
+// AddAccountIndex adds u to the "account_index" field.
+func (m *AddressMutation) AddAccountIndex(u int32) {
+	if m.addaccount_index != nil {
+		*m.addaccount_index += u
+	} else {
+		m.addaccount_index = &u
+	}
+}
+
+// AddedAccountIndex returns the value that was added to the "account_index" field in this mutation.
+func (m *AddressMutation) AddedAccountIndex() (r int32, exists bool) {
+	v := m.addaccount_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAccountIndex resets all changes to the "account_index" field.
+func (m *AddressMutation) ResetAccountIndex() {
+	m.account_index = nil
+	m.addaccount_index = nil
+}
+
+// SetDerivationPath sets the "derivation_path" field.
+func (m *AddressMutation) SetDerivationPath(s string) {
+	m.derivation_path = &s
+}
+
+// DerivationPath returns the value of the "derivation_path" field in the mutation.
+func (m *AddressMutation) DerivationPath() (r string, exists bool) {
+	v := m.derivation_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDerivationPath returns the old "derivation_path" field's value of the Address entity.
+// If the Address object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AddressMutation) OldDerivationPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDerivationPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDerivationPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDerivationPath: %w", err)
+	}
+	return oldValue.DerivationPath, nil
+}
+
+// ClearDerivationPath clears the value of the "derivation_path" field.
+func (m *AddressMutation) ClearDerivationPath() {
+	m.derivation_path = nil
+	m.clearedFields[ormaddress.FieldDerivationPath] = struct{}{}
+}
+
+// DerivationPathCleared returns if the "derivation_path" field was cleared in this mutation.
+func (m *AddressMutation) DerivationPathCleared() bool {
+	_, ok := m.clearedFields[ormaddress.FieldDerivationPath]
+	return ok
+}
+
+// ResetDerivationPath resets all changes to the "derivation_path" field.
+func (m *AddressMutation) ResetDerivationPath() {
+	m.derivation_path = nil
+	delete(m.clearedFields, ormaddress.FieldDerivationPath)
+}
+
+// SetKeyType sets the "key_type" field.
+func (m *AddressMutation) SetKeyType(wt wallet.KeyType) {
+	m.key_type = &wt
+}
+
+// KeyType returns the value of the "key_type" field in the mutation.
+func (m *AddressMutation) KeyType() (r wallet.KeyType, exists bool) {
+	v := m.key_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyType returns the old "key_type" field's value of the Address entity.
+// If the Address object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AddressMutation) OldKeyType(ctx context.Context) (v wallet.KeyType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyType: %w", err)
+	}
+	return oldValue.KeyType, nil
+}
+
+// ResetKeyType resets all changes to the "key_type" field.
+func (m *AddressMutation) ResetKeyType() {
+	m.key_type = nil
+}
+
+// SetActorID sets the "actor_id" field.
+func (m *AddressMutation) SetActorID(s string) {
+	m.actor_id = &s
+}
+
+// ActorID returns the value of the "actor_id" field in the mutation.
+func (m *AddressMutation) ActorID() (r string, exists bool) {
+	v := m.actor_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActorID returns the old "actor_id" field's value of the Address entity.
+// If the Address object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AddressMutation) OldActorID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActorID: %w", err)
+	}
+	return oldValue.ActorID, nil
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (m *AddressMutation) ClearActorID() {
+	m.actor_id = nil
+	m.clearedFields[ormaddress.FieldActorID] = struct{}{}
+}
+
+// ActorIDCleared returns if the "actor_id" field was cleared in this mutation.
+func (m *AddressMutation) ActorIDCleared() bool {
+	_, ok := m.clearedFields[ormaddress.FieldActorID]
+	return ok
+}
+
+// ResetActorID resets all changes to the "actor_id" field.
+func (m *AddressMutation) ResetActorID() {
+	m.actor_id = nil
+	delete(m.clearedFields, ormaddress.FieldActorID)
+}
+
 // SetWalletID sets the "wallet" edge to the Wallet entity by id.
 func (m *AddressMutation) SetWalletID(id int) {
 	m.wallet = &id
@@ -311,13 +510,25 @@ func (m *AddressMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *AddressMutation) Fields() []string {
-	fields := make([]string, 0, 2)
+	fields := make([]string, 0, 6)
 	if m._type != nil {
 		fields = append(fields, ormaddress.FieldType)
 	}
 	if m.address != nil {
 		fields = append(fields, ormaddress.FieldAddress)
 	}
+	if m.account_index != nil {
+		fields = append(fields, ormaddress.FieldAccountIndex)
+	}
+	if m.derivation_path != nil {
+		fields = append(fields, ormaddress.FieldDerivationPath)
+	}
+	if m.key_type != nil {
+		fields = append(fields, ormaddress.FieldKeyType)
+	}
+	if m.actor_id != nil {
+		fields = append(fields, ormaddress.FieldActorID)
+	}
 	return fields
 }
 
@@ -330,6 +541,14 @@ func (m *AddressMutation) Field(name string) (ent.Value, bool) {
 		return m.GetType()
 	case ormaddress.FieldAddress:
 		return m.Address()
+	case ormaddress.FieldAccountIndex:
+		return m.AccountIndex()
+	case ormaddress.FieldDerivationPath:
+		return m.DerivationPath()
+	case ormaddress.FieldKeyType:
+		return m.KeyType()
+	case ormaddress.FieldActorID:
+		return m.ActorID()
 	}
 	return nil, false
 }
@@ -343,6 +562,14 @@ func (m *AddressMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldType(ctx)
 	case ormaddress.FieldAddress:
 		return m.OldAddress(ctx)
+	case ormaddress.FieldAccountIndex:
+		return m.OldAccountIndex(ctx)
+	case ormaddress.FieldDerivationPath:
+		return m.OldDerivationPath(ctx)
+	case ormaddress.FieldKeyType:
+		return m.OldKeyType(ctx)
+	case ormaddress.FieldActorID:
+		return m.OldActorID(ctx)
 	}
 	return nil, fmt.Errorf("unknown Address field %s", name)
 }
@@ -366,6 +593,34 @@ func (m *AddressMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetAddress(v)
 		return nil
+	case ormaddress.FieldAccountIndex:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountIndex(v)
+		return nil
+	case ormaddress.FieldDerivationPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDerivationPath(v)
+		return nil
+	case ormaddress.FieldKeyType:
+		v, ok := value.(wallet.KeyType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyType(v)
+		return nil
+	case ormaddress.FieldActorID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActorID(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Address field %s", name)
 }
@@ -377,6 +632,9 @@ func (m *AddressMutation) AddedFields() []string {
 	if m.add_type != nil {
 		fields = append(fields, ormaddress.FieldType)
 	}
+	if m.addaccount_index != nil {
+		fields = append(fields, ormaddress.FieldAccountIndex)
+	}
 	return fields
 }
 
@@ -387,6 +645,8 @@ func (m *AddressMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	case ormaddress.FieldType:
 		return m.AddedType()
+	case ormaddress.FieldAccountIndex:
+		return m.AddedAccountIndex()
 	}
 	return nil, false
 }
@@ -403,6 +663,13 @@ func (m *AddressMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddType(v)
 		return nil
+	case ormaddress.FieldAccountIndex:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAccountIndex(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Address numeric field %s", name)
 }
@@ -410,7 +677,14 @@ func (m *AddressMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *AddressMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(ormaddress.FieldDerivationPath) {
+		fields = append(fields, ormaddress.FieldDerivationPath)
+	}
+	if m.FieldCleared(ormaddress.FieldActorID) {
+		fields = append(fields, ormaddress.FieldActorID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -423,6 +697,14 @@ func (m *AddressMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *AddressMutation) ClearField(name string) error {
+	switch name {
+	case ormaddress.FieldDerivationPath:
+		m.ClearDerivationPath()
+		return nil
+	case ormaddress.FieldActorID:
+		m.ClearActorID()
+		return nil
+	}
 	return fmt.Errorf("unknown Address nullable field %s", name)
 }
 
@@ -436,6 +718,18 @@ func (m *AddressMutation) ResetField(name string) error {
 	case ormaddress.FieldAddress:
 		m.ResetAddress()
 		return nil
+	case ormaddress.FieldAccountIndex:
+		m.ResetAccountIndex()
+		return nil
+	case ormaddress.FieldDerivationPath:
+		m.ResetDerivationPath()
+		return nil
+	case ormaddress.FieldKeyType:
+		m.ResetKeyType()
+		return nil
+	case ormaddress.FieldActorID:
+		m.ResetActorID()
+		return nil
 	}
 	return fmt.Errorf("unknown Address field %s", name)
 }
@@ -514,29 +808,35 @@ func (m *AddressMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Address edge %s", name)
 }
 
-// SettingMutation represents an operation that mutates the Setting nodes in the graph.
-type SettingMutation struct {
+// AuditEventMutation represents an operation that mutates the AuditEvent nodes in the graph.
+type AuditEventMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
+	wallet_id     *int
+	addwallet_id  *int
+	action        *filwallet.AuditAction
+	detail        *string
+	success       *bool
+	created_at    *time.Time
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*Setting, error)
-	predicates    []predicate.Setting
+	oldValue      func(context.Context) (*AuditEvent, error)
+	predicates    []predicate.AuditEvent
 }
 
-var _ ent.Mutation = (*SettingMutation)(nil)
+var _ ent.Mutation = (*AuditEventMutation)(nil)
 
-// settingOption allows management of the mutation configuration using functional options.
-type settingOption func(*SettingMutation)
+// auditeventOption allows management of the mutation configuration using functional options.
+type auditeventOption func(*AuditEventMutation)
 
-// newSettingMutation creates new mutation for the Setting entity.
-func newSettingMutation(c config, op Op, opts ...settingOption) *SettingMutation {
-	m := &SettingMutation{
+// newAuditEventMutation creates new mutation for the AuditEvent entity.
+func newAuditEventMutation(c config, op Op, opts ...auditeventOption) *AuditEventMutation {
+	m := &AuditEventMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSetting,
+		typ:           TypeAuditEvent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -545,20 +845,20 @@ func newSettingMutation(c config, op Op, opts ...settingOption) *SettingMutation
 	return m
 }
 
-// withSettingID sets the ID field of the mutation.
-func withSettingID(id int) settingOption {
-	return func(m *SettingMutation) {
+// withAuditEventID sets the ID field of the mutation.
+func withAuditEventID(id int) auditeventOption {
+	return func(m *AuditEventMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Setting
+			value *AuditEvent
 		)
-		m.oldValue = func(ctx context.Context) (*Setting, error) {
+		m.oldValue = func(ctx context.Context) (*AuditEvent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Setting.Get(ctx, id)
+					value, err = m.Client().AuditEvent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -567,10 +867,10 @@ func withSettingID(id int) settingOption {
 	}
 }
 
-// withSetting sets the old Setting of the mutation.
-func withSetting(node *Setting) settingOption {
-	return func(m *SettingMutation) {
-		m.oldValue = func(context.Context) (*Setting, error) {
+// withAuditEvent sets the old AuditEvent of the mutation.
+func withAuditEvent(node *AuditEvent) auditeventOption {
+	return func(m *AuditEventMutation) {
+		m.oldValue = func(context.Context) (*AuditEvent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -579,7 +879,7 @@ func withSetting(node *Setting) settingOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SettingMutation) Client() *Client {
+func (m AuditEventMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -587,7 +887,7 @@ func (m SettingMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SettingMutation) Tx() (*Tx, error) {
+func (m AuditEventMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("orm: mutation is not running in a transaction")
 	}
@@ -598,7 +898,7 @@ func (m SettingMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SettingMutation) ID() (id int, exists bool) {
+func (m *AuditEventMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -609,7 +909,7 @@ func (m *SettingMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SettingMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AuditEventMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -618,200 +918,519 @@ func (m *SettingMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Setting.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuditEvent.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// Where appends a list predicates to the SettingMutation builder.
-func (m *SettingMutation) Where(ps ...predicate.Setting) {
-	m.predicates = append(m.predicates, ps...)
+// SetWalletID sets the "wallet_id" field.
+func (m *AuditEventMutation) SetWalletID(i int) {
+	m.wallet_id = &i
+	m.addwallet_id = nil
 }
 
-// WhereP appends storage-level predicates to the SettingMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SettingMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Setting, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// WalletID returns the value of the "wallet_id" field in the mutation.
+func (m *AuditEventMutation) WalletID() (r int, exists bool) {
+	v := m.wallet_id
+	if v == nil {
+		return
 	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *SettingMutation) Op() Op {
-	return m.op
+	return *v, true
 }
 
-// SetOp allows setting the mutation operation.
-func (m *SettingMutation) SetOp(op Op) {
-	m.op = op
+// OldWalletID returns the old "wallet_id" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldWalletID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWalletID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWalletID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWalletID: %w", err)
+	}
+	return oldValue.WalletID, nil
 }
 
-// Type returns the node type of this mutation (Setting).
-func (m *SettingMutation) Type() string {
-	return m.typ
+// AddWalletID adds i to the "wallet_id" field.
+func (m *AuditEventMutation) AddWalletID(i int) {
+	if m.addwallet_id != nil {
+		*m.addwallet_id += i
+	} else {
+		m.addwallet_id = &i
+	}
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *SettingMutation) Fields() []string {
-	fields := make([]string, 0, 0)
-	return fields
+// AddedWalletID returns the value that was added to the "wallet_id" field in this mutation.
+func (m *AuditEventMutation) AddedWalletID() (r int, exists bool) {
+	v := m.addwallet_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *SettingMutation) Field(name string) (ent.Value, bool) {
-	return nil, false
+// ResetWalletID resets all changes to the "wallet_id" field.
+func (m *AuditEventMutation) ResetWalletID() {
+	m.wallet_id = nil
+	m.addwallet_id = nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *SettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	return nil, fmt.Errorf("unknown Setting field %s", name)
+// SetAction sets the "action" field.
+func (m *AuditEventMutation) SetAction(fa filwallet.AuditAction) {
+	m.action = &fa
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *SettingMutation) SetField(name string, value ent.Value) error {
-	switch name {
+// Action returns the value of the "action" field in the mutation.
+func (m *AuditEventMutation) Action() (r filwallet.AuditAction, exists bool) {
+	v := m.action
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Setting field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *SettingMutation) AddedFields() []string {
-	return nil
+// OldAction returns the old "action" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldAction(ctx context.Context) (v filwallet.AuditAction, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *AuditEventMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetDetail sets the "detail" field.
+func (m *AuditEventMutation) SetDetail(s string) {
+	m.detail = &s
+}
+
+// Detail returns the value of the "detail" field in the mutation.
+func (m *AuditEventMutation) Detail() (r string, exists bool) {
+	v := m.detail
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDetail returns the old "detail" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldDetail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetail: %w", err)
+	}
+	return oldValue.Detail, nil
+}
+
+// ClearDetail clears the value of the "detail" field.
+func (m *AuditEventMutation) ClearDetail() {
+	m.detail = nil
+	m.clearedFields[auditevent.FieldDetail] = struct{}{}
+}
+
+// DetailCleared returns if the "detail" field was cleared in this mutation.
+func (m *AuditEventMutation) DetailCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldDetail]
+	return ok
+}
+
+// ResetDetail resets all changes to the "detail" field.
+func (m *AuditEventMutation) ResetDetail() {
+	m.detail = nil
+	delete(m.clearedFields, auditevent.FieldDetail)
+}
+
+// SetSuccess sets the "success" field.
+func (m *AuditEventMutation) SetSuccess(b bool) {
+	m.success = &b
+}
+
+// Success returns the value of the "success" field in the mutation.
+func (m *AuditEventMutation) Success() (r bool, exists bool) {
+	v := m.success
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuccess returns the old "success" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldSuccess(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuccess is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuccess requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuccess: %w", err)
+	}
+	return oldValue.Success, nil
+}
+
+// ResetSuccess resets all changes to the "success" field.
+func (m *AuditEventMutation) ResetSuccess() {
+	m.success = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AuditEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AuditEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AuditEventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the AuditEventMutation builder.
+func (m *AuditEventMutation) Where(ps ...predicate.AuditEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AuditEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AuditEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AuditEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AuditEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AuditEvent).
+func (m *AuditEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuditEventMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.wallet_id != nil {
+		fields = append(fields, auditevent.FieldWalletID)
+	}
+	if m.action != nil {
+		fields = append(fields, auditevent.FieldAction)
+	}
+	if m.detail != nil {
+		fields = append(fields, auditevent.FieldDetail)
+	}
+	if m.success != nil {
+		fields = append(fields, auditevent.FieldSuccess)
+	}
+	if m.created_at != nil {
+		fields = append(fields, auditevent.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuditEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case auditevent.FieldWalletID:
+		return m.WalletID()
+	case auditevent.FieldAction:
+		return m.Action()
+	case auditevent.FieldDetail:
+		return m.Detail()
+	case auditevent.FieldSuccess:
+		return m.Success()
+	case auditevent.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuditEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case auditevent.FieldWalletID:
+		return m.OldWalletID(ctx)
+	case auditevent.FieldAction:
+		return m.OldAction(ctx)
+	case auditevent.FieldDetail:
+		return m.OldDetail(ctx)
+	case auditevent.FieldSuccess:
+		return m.OldSuccess(ctx)
+	case auditevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown AuditEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case auditevent.FieldWalletID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWalletID(v)
+		return nil
+	case auditevent.FieldAction:
+		v, ok := value.(filwallet.AuditAction)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case auditevent.FieldDetail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDetail(v)
+		return nil
+	case auditevent.FieldSuccess:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuccess(v)
+		return nil
+	case auditevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuditEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AuditEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addwallet_id != nil {
+		fields = append(fields, auditevent.FieldWalletID)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SettingMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AuditEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case auditevent.FieldWalletID:
+		return m.AddedWalletID()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SettingMutation) AddField(name string, value ent.Value) error {
-	return fmt.Errorf("unknown Setting numeric field %s", name)
+func (m *AuditEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case auditevent.FieldWalletID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWalletID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuditEvent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SettingMutation) ClearedFields() []string {
-	return nil
+func (m *AuditEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(auditevent.FieldDetail) {
+		fields = append(fields, auditevent.FieldDetail)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SettingMutation) FieldCleared(name string) bool {
+func (m *AuditEventMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SettingMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Setting nullable field %s", name)
+func (m *AuditEventMutation) ClearField(name string) error {
+	switch name {
+	case auditevent.FieldDetail:
+		m.ClearDetail()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditEvent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SettingMutation) ResetField(name string) error {
-	return fmt.Errorf("unknown Setting field %s", name)
+func (m *AuditEventMutation) ResetField(name string) error {
+	switch name {
+	case auditevent.FieldWalletID:
+		m.ResetWalletID()
+		return nil
+	case auditevent.FieldAction:
+		m.ResetAction()
+		return nil
+	case auditevent.FieldDetail:
+		m.ResetDetail()
+		return nil
+	case auditevent.FieldSuccess:
+		m.ResetSuccess()
+		return nil
+	case auditevent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditEvent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SettingMutation) AddedEdges() []string {
+func (m *AuditEventMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SettingMutation) AddedIDs(name string) []ent.Value {
+func (m *AuditEventMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SettingMutation) RemovedEdges() []string {
+func (m *AuditEventMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SettingMutation) RemovedIDs(name string) []ent.Value {
+func (m *AuditEventMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SettingMutation) ClearedEdges() []string {
+func (m *AuditEventMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SettingMutation) EdgeCleared(name string) bool {
+func (m *AuditEventMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SettingMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Setting unique edge %s", name)
+func (m *AuditEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditEvent unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SettingMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Setting edge %s", name)
+func (m *AuditEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditEvent edge %s", name)
 }
 
-// WalletMutation represents an operation that mutates the Wallet nodes in the graph.
-type WalletMutation struct {
+// SettingMutation represents an operation that mutates the Setting nodes in the graph.
+type SettingMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *int
-	is_default         *bool
-	actor_id           *string
-	name               *string
-	encrypted_seed     *[]byte
-	encrypted_key_json *[]byte
-	salt               *[]byte
-	created_at         *time.Time
-	updated_at         *time.Time
-	clearedFields      map[string]struct{}
-	addresses          map[int]struct{}
-	removedaddresses   map[int]struct{}
-	clearedaddresses   bool
-	done               bool
-	oldValue           func(context.Context) (*Wallet, error)
-	predicates         []predicate.Wallet
+	op            Op
+	typ           string
+	id            *int
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Setting, error)
+	predicates    []predicate.Setting
 }
 
-var _ ent.Mutation = (*WalletMutation)(nil)
+var _ ent.Mutation = (*SettingMutation)(nil)
 
-// walletOption allows management of the mutation configuration using functional options.
-type walletOption func(*WalletMutation)
+// settingOption allows management of the mutation configuration using functional options.
+type settingOption func(*SettingMutation)
 
-// newWalletMutation creates new mutation for the Wallet entity.
-func newWalletMutation(c config, op Op, opts ...walletOption) *WalletMutation {
-	m := &WalletMutation{
+// newSettingMutation creates new mutation for the Setting entity.
+func newSettingMutation(c config, op Op, opts ...settingOption) *SettingMutation {
+	m := &SettingMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeWallet,
+		typ:           TypeSetting,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -820,20 +1439,20 @@ func newWalletMutation(c config, op Op, opts ...walletOption) *WalletMutation {
 	return m
 }
 
-// withWalletID sets the ID field of the mutation.
-func withWalletID(id int) walletOption {
-	return func(m *WalletMutation) {
+// withSettingID sets the ID field of the mutation.
+func withSettingID(id int) settingOption {
+	return func(m *SettingMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Wallet
+			value *Setting
 		)
-		m.oldValue = func(ctx context.Context) (*Wallet, error) {
+		m.oldValue = func(ctx context.Context) (*Setting, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Wallet.Get(ctx, id)
+					value, err = m.Client().Setting.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -842,10 +1461,10 @@ func withWalletID(id int) walletOption {
 	}
 }
 
-// withWallet sets the old Wallet of the mutation.
-func withWallet(node *Wallet) walletOption {
-	return func(m *WalletMutation) {
-		m.oldValue = func(context.Context) (*Wallet, error) {
+// withSetting sets the old Setting of the mutation.
+func withSetting(node *Setting) settingOption {
+	return func(m *SettingMutation) {
+		m.oldValue = func(context.Context) (*Setting, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -854,7 +1473,7 @@ func withWallet(node *Wallet) walletOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m WalletMutation) Client() *Client {
+func (m SettingMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -862,7 +1481,7 @@ func (m WalletMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m WalletMutation) Tx() (*Tx, error) {
+func (m SettingMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("orm: mutation is not running in a transaction")
 	}
@@ -873,7 +1492,7 @@ func (m WalletMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *WalletMutation) ID() (id int, exists bool) {
+func (m *SettingMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -884,7 +1503,7 @@ func (m *WalletMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *WalletMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *SettingMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -893,226 +1512,983 @@ func (m *WalletMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Wallet.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Setting.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetIsDefault sets the "is_default" field.
-func (m *WalletMutation) SetIsDefault(b bool) {
-	m.is_default = &b
+// Where appends a list predicates to the SettingMutation builder.
+func (m *SettingMutation) Where(ps ...predicate.Setting) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SettingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SettingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Setting, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SettingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SettingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Setting).
+func (m *SettingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SettingMutation) Fields() []string {
+	fields := make([]string, 0, 0)
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SettingMutation) Field(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	return nil, fmt.Errorf("unknown Setting field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SettingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Setting field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SettingMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SettingMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SettingMutation) AddField(name string, value ent.Value) error {
+	return fmt.Errorf("unknown Setting numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SettingMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SettingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SettingMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Setting nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SettingMutation) ResetField(name string) error {
+	return fmt.Errorf("unknown Setting field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SettingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SettingMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SettingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SettingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SettingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SettingMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SettingMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Setting unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SettingMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Setting edge %s", name)
+}
+
+// WalletMutation represents an operation that mutates the Wallet nodes in the graph.
+type WalletMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	is_default           *bool
+	actor_id             *string
+	name                 *string
+	derivation_scheme    *wallet.DerivationScheme
+	encrypted_seed       *[]byte
+	encrypted_passphrase *[]byte
+	encrypted_key_json   *[]byte
+	salt                 *[]byte
+	kdf_algorithm        *wallet.KDFAlgorithm
+	kdf_time             *uint32
+	addkdf_time          *int32
+	kdf_memory           *uint32
+	addkdf_memory        *int32
+	kdf_threads          *uint8
+	addkdf_threads       *int8
+	failed_attempts      *int
+	addfailed_attempts   *int
+	retry_after          *time.Time
+	backup_verified_at   *time.Time
+	created_at           *time.Time
+	updated_at           *time.Time
+	clearedFields        map[string]struct{}
+	addresses            map[int]struct{}
+	removedaddresses     map[int]struct{}
+	clearedaddresses     bool
+	done                 bool
+	oldValue             func(context.Context) (*Wallet, error)
+	predicates           []predicate.Wallet
+}
+
+var _ ent.Mutation = (*WalletMutation)(nil)
+
+// walletOption allows management of the mutation configuration using functional options.
+type walletOption func(*WalletMutation)
+
+// newWalletMutation creates new mutation for the Wallet entity.
+func newWalletMutation(c config, op Op, opts ...walletOption) *WalletMutation {
+	m := &WalletMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWallet,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWalletID sets the ID field of the mutation.
+func withWalletID(id int) walletOption {
+	return func(m *WalletMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Wallet
+		)
+		m.oldValue = func(ctx context.Context) (*Wallet, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Wallet.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWallet sets the old Wallet of the mutation.
+func withWallet(node *Wallet) walletOption {
+	return func(m *WalletMutation) {
+		m.oldValue = func(context.Context) (*Wallet, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WalletMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WalletMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("orm: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WalletMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WalletMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Wallet.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetIsDefault sets the "is_default" field.
+func (m *WalletMutation) SetIsDefault(b bool) {
+	m.is_default = &b
+}
+
+// IsDefault returns the value of the "is_default" field in the mutation.
+func (m *WalletMutation) IsDefault() (r bool, exists bool) {
+	v := m.is_default
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsDefault returns the old "is_default" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletMutation) OldIsDefault(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsDefault is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsDefault requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsDefault: %w", err)
+	}
+	return oldValue.IsDefault, nil
+}
+
+// ResetIsDefault resets all changes to the "is_default" field.
+func (m *WalletMutation) ResetIsDefault() {
+	m.is_default = nil
+}
+
+// SetActorID sets the "actor_id" field.
+func (m *WalletMutation) SetActorID(s string) {
+	m.actor_id = &s
+}
+
+// ActorID returns the value of the "actor_id" field in the mutation.
+func (m *WalletMutation) ActorID() (r string, exists bool) {
+	v := m.actor_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActorID returns the old "actor_id" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletMutation) OldActorID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActorID: %w", err)
+	}
+	return oldValue.ActorID, nil
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (m *WalletMutation) ClearActorID() {
+	m.actor_id = nil
+	m.clearedFields[ormwallet.FieldActorID] = struct{}{}
+}
+
+// ActorIDCleared returns if the "actor_id" field was cleared in this mutation.
+func (m *WalletMutation) ActorIDCleared() bool {
+	_, ok := m.clearedFields[ormwallet.FieldActorID]
+	return ok
+}
+
+// ResetActorID resets all changes to the "actor_id" field.
+func (m *WalletMutation) ResetActorID() {
+	m.actor_id = nil
+	delete(m.clearedFields, ormwallet.FieldActorID)
+}
+
+// SetName sets the "name" field.
+func (m *WalletMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *WalletMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *WalletMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDerivationScheme sets the "derivation_scheme" field.
+func (m *WalletMutation) SetDerivationScheme(ws wallet.DerivationScheme) {
+	m.derivation_scheme = &ws
+}
+
+// DerivationScheme returns the value of the "derivation_scheme" field in the mutation.
+func (m *WalletMutation) DerivationScheme() (r wallet.DerivationScheme, exists bool) {
+	v := m.derivation_scheme
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDerivationScheme returns the old "derivation_scheme" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletMutation) OldDerivationScheme(ctx context.Context) (v wallet.DerivationScheme, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDerivationScheme is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDerivationScheme requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDerivationScheme: %w", err)
+	}
+	return oldValue.DerivationScheme, nil
+}
+
+// ResetDerivationScheme resets all changes to the "derivation_scheme" field.
+func (m *WalletMutation) ResetDerivationScheme() {
+	m.derivation_scheme = nil
+}
+
+// SetEncryptedSeed sets the "encrypted_seed" field.
+func (m *WalletMutation) SetEncryptedSeed(b []byte) {
+	m.encrypted_seed = &b
+}
+
+// EncryptedSeed returns the value of the "encrypted_seed" field in the mutation.
+func (m *WalletMutation) EncryptedSeed() (r []byte, exists bool) {
+	v := m.encrypted_seed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEncryptedSeed returns the old "encrypted_seed" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletMutation) OldEncryptedSeed(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEncryptedSeed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEncryptedSeed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEncryptedSeed: %w", err)
+	}
+	return oldValue.EncryptedSeed, nil
+}
+
+// ClearEncryptedSeed clears the value of the "encrypted_seed" field.
+func (m *WalletMutation) ClearEncryptedSeed() {
+	m.encrypted_seed = nil
+	m.clearedFields[ormwallet.FieldEncryptedSeed] = struct{}{}
+}
+
+// EncryptedSeedCleared returns if the "encrypted_seed" field was cleared in this mutation.
+func (m *WalletMutation) EncryptedSeedCleared() bool {
+	_, ok := m.clearedFields[ormwallet.FieldEncryptedSeed]
+	return ok
+}
+
+// ResetEncryptedSeed resets all changes to the "encrypted_seed" field.
+func (m *WalletMutation) ResetEncryptedSeed() {
+	m.encrypted_seed = nil
+	delete(m.clearedFields, ormwallet.FieldEncryptedSeed)
+}
+
+// SetEncryptedPassphrase sets the "encrypted_passphrase" field.
+func (m *WalletMutation) SetEncryptedPassphrase(b []byte) {
+	m.encrypted_passphrase = &b
+}
+
+// EncryptedPassphrase returns the value of the "encrypted_passphrase" field in the mutation.
+func (m *WalletMutation) EncryptedPassphrase() (r []byte, exists bool) {
+	v := m.encrypted_passphrase
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEncryptedPassphrase returns the old "encrypted_passphrase" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletMutation) OldEncryptedPassphrase(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEncryptedPassphrase is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEncryptedPassphrase requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEncryptedPassphrase: %w", err)
+	}
+	return oldValue.EncryptedPassphrase, nil
+}
+
+// ClearEncryptedPassphrase clears the value of the "encrypted_passphrase" field.
+func (m *WalletMutation) ClearEncryptedPassphrase() {
+	m.encrypted_passphrase = nil
+	m.clearedFields[ormwallet.FieldEncryptedPassphrase] = struct{}{}
+}
+
+// EncryptedPassphraseCleared returns if the "encrypted_passphrase" field was cleared in this mutation.
+func (m *WalletMutation) EncryptedPassphraseCleared() bool {
+	_, ok := m.clearedFields[ormwallet.FieldEncryptedPassphrase]
+	return ok
+}
+
+// ResetEncryptedPassphrase resets all changes to the "encrypted_passphrase" field.
+func (m *WalletMutation) ResetEncryptedPassphrase() {
+	m.encrypted_passphrase = nil
+	delete(m.clearedFields, ormwallet.FieldEncryptedPassphrase)
+}
+
+// SetEncryptedKeyJSON sets the "encrypted_key_json" field.
+func (m *WalletMutation) SetEncryptedKeyJSON(b []byte) {
+	m.encrypted_key_json = &b
+}
+
+// EncryptedKeyJSON returns the value of the "encrypted_key_json" field in the mutation.
+func (m *WalletMutation) EncryptedKeyJSON() (r []byte, exists bool) {
+	v := m.encrypted_key_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEncryptedKeyJSON returns the old "encrypted_key_json" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletMutation) OldEncryptedKeyJSON(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEncryptedKeyJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEncryptedKeyJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEncryptedKeyJSON: %w", err)
+	}
+	return oldValue.EncryptedKeyJSON, nil
+}
+
+// ResetEncryptedKeyJSON resets all changes to the "encrypted_key_json" field.
+func (m *WalletMutation) ResetEncryptedKeyJSON() {
+	m.encrypted_key_json = nil
+}
+
+// SetSalt sets the "salt" field.
+func (m *WalletMutation) SetSalt(b []byte) {
+	m.salt = &b
+}
+
+// Salt returns the value of the "salt" field in the mutation.
+func (m *WalletMutation) Salt() (r []byte, exists bool) {
+	v := m.salt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSalt returns the old "salt" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletMutation) OldSalt(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSalt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSalt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSalt: %w", err)
+	}
+	return oldValue.Salt, nil
+}
+
+// ResetSalt resets all changes to the "salt" field.
+func (m *WalletMutation) ResetSalt() {
+	m.salt = nil
+}
+
+// SetKdfAlgorithm sets the "kdf_algorithm" field.
+func (m *WalletMutation) SetKdfAlgorithm(wa wallet.KDFAlgorithm) {
+	m.kdf_algorithm = &wa
+}
+
+// KdfAlgorithm returns the value of the "kdf_algorithm" field in the mutation.
+func (m *WalletMutation) KdfAlgorithm() (r wallet.KDFAlgorithm, exists bool) {
+	v := m.kdf_algorithm
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKdfAlgorithm returns the old "kdf_algorithm" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletMutation) OldKdfAlgorithm(ctx context.Context) (v wallet.KDFAlgorithm, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKdfAlgorithm is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKdfAlgorithm requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKdfAlgorithm: %w", err)
+	}
+	return oldValue.KdfAlgorithm, nil
+}
+
+// ResetKdfAlgorithm resets all changes to the "kdf_algorithm" field.
+func (m *WalletMutation) ResetKdfAlgorithm() {
+	m.kdf_algorithm = nil
 }
 
-// IsDefault returns the value of the "is_default" field in the mutation.
-func (m *WalletMutation) IsDefault() (r bool, exists bool) {
-	v := m.is_default
+// SetKdfTime sets the "kdf_time" field.
+func (m *WalletMutation) SetKdfTime(u uint32) {
+	m.kdf_time = &u
+	m.addkdf_time = nil
+}
+
+// KdfTime returns the value of the "kdf_time" field in the mutation.
+func (m *WalletMutation) KdfTime() (r uint32, exists bool) {
+	v := m.kdf_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsDefault returns the old "is_default" field's value of the Wallet entity.
+// OldKdfTime returns the old "kdf_time" field's value of the Wallet entity.
 // If the Wallet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WalletMutation) OldIsDefault(ctx context.Context) (v bool, err error) {
+func (m *WalletMutation) OldKdfTime(ctx context.Context) (v uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsDefault is only allowed on UpdateOne operations")
+		return v, errors.New("OldKdfTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsDefault requires an ID field in the mutation")
+		return v, errors.New("OldKdfTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsDefault: %w", err)
+		return v, fmt.Errorf("querying old value for OldKdfTime: %w", err)
 	}
-	return oldValue.IsDefault, nil
+	return oldValue.KdfTime, nil
 }
 
-// ResetIsDefault resets all changes to the "is_default" field.
-func (m *WalletMutation) ResetIsDefault() {
-	m.is_default = nil
+// AddKdfTime adds u to the "kdf_time" field.
+func (m *WalletMutation) AddKdfTime(u int32) {
+	if m.addkdf_time != nil {
+		*m.addkdf_time += u
+	} else {
+		m.addkdf_time = &u
+	}
 }
 
-// SetActorID sets the "actor_id" field.
-func (m *WalletMutation) SetActorID(s string) {
-	m.actor_id = &s
+// AddedKdfTime returns the value that was added to the "kdf_time" field in this mutation.
+func (m *WalletMutation) AddedKdfTime() (r int32, exists bool) {
+	v := m.addkdf_time
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ActorID returns the value of the "actor_id" field in the mutation.
-func (m *WalletMutation) ActorID() (r string, exists bool) {
-	v := m.actor_id
+// ResetKdfTime resets all changes to the "kdf_time" field.
+func (m *WalletMutation) ResetKdfTime() {
+	m.kdf_time = nil
+	m.addkdf_time = nil
+}
+
+// SetKdfMemory sets the "kdf_memory" field.
+func (m *WalletMutation) SetKdfMemory(u uint32) {
+	m.kdf_memory = &u
+	m.addkdf_memory = nil
+}
+
+// KdfMemory returns the value of the "kdf_memory" field in the mutation.
+func (m *WalletMutation) KdfMemory() (r uint32, exists bool) {
+	v := m.kdf_memory
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldActorID returns the old "actor_id" field's value of the Wallet entity.
+// OldKdfMemory returns the old "kdf_memory" field's value of the Wallet entity.
 // If the Wallet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WalletMutation) OldActorID(ctx context.Context) (v *string, err error) {
+func (m *WalletMutation) OldKdfMemory(ctx context.Context) (v uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActorID is only allowed on UpdateOne operations")
+		return v, errors.New("OldKdfMemory is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActorID requires an ID field in the mutation")
+		return v, errors.New("OldKdfMemory requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActorID: %w", err)
+		return v, fmt.Errorf("querying old value for OldKdfMemory: %w", err)
 	}
-	return oldValue.ActorID, nil
+	return oldValue.KdfMemory, nil
 }
 
-// ResetActorID resets all changes to the "actor_id" field.
-func (m *WalletMutation) ResetActorID() {
-	m.actor_id = nil
+// AddKdfMemory adds u to the "kdf_memory" field.
+func (m *WalletMutation) AddKdfMemory(u int32) {
+	if m.addkdf_memory != nil {
+		*m.addkdf_memory += u
+	} else {
+		m.addkdf_memory = &u
+	}
 }
 
-// SetName sets the "name" field.
-func (m *WalletMutation) SetName(s string) {
-	m.name = &s
+// AddedKdfMemory returns the value that was added to the "kdf_memory" field in this mutation.
+func (m *WalletMutation) AddedKdfMemory() (r int32, exists bool) {
+	v := m.addkdf_memory
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *WalletMutation) Name() (r string, exists bool) {
-	v := m.name
+// ResetKdfMemory resets all changes to the "kdf_memory" field.
+func (m *WalletMutation) ResetKdfMemory() {
+	m.kdf_memory = nil
+	m.addkdf_memory = nil
+}
+
+// SetKdfThreads sets the "kdf_threads" field.
+func (m *WalletMutation) SetKdfThreads(u uint8) {
+	m.kdf_threads = &u
+	m.addkdf_threads = nil
+}
+
+// KdfThreads returns the value of the "kdf_threads" field in the mutation.
+func (m *WalletMutation) KdfThreads() (r uint8, exists bool) {
+	v := m.kdf_threads
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Wallet entity.
+// OldKdfThreads returns the old "kdf_threads" field's value of the Wallet entity.
 // If the Wallet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WalletMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *WalletMutation) OldKdfThreads(ctx context.Context) (v uint8, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldKdfThreads is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldKdfThreads requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldKdfThreads: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.KdfThreads, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *WalletMutation) ResetName() {
-	m.name = nil
+// AddKdfThreads adds u to the "kdf_threads" field.
+func (m *WalletMutation) AddKdfThreads(u int8) {
+	if m.addkdf_threads != nil {
+		*m.addkdf_threads += u
+	} else {
+		m.addkdf_threads = &u
+	}
 }
 
-// SetEncryptedSeed sets the "encrypted_seed" field.
-func (m *WalletMutation) SetEncryptedSeed(b []byte) {
-	m.encrypted_seed = &b
+// AddedKdfThreads returns the value that was added to the "kdf_threads" field in this mutation.
+func (m *WalletMutation) AddedKdfThreads() (r int8, exists bool) {
+	v := m.addkdf_threads
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// EncryptedSeed returns the value of the "encrypted_seed" field in the mutation.
-func (m *WalletMutation) EncryptedSeed() (r []byte, exists bool) {
-	v := m.encrypted_seed
+// ResetKdfThreads resets all changes to the "kdf_threads" field.
+func (m *WalletMutation) ResetKdfThreads() {
+	m.kdf_threads = nil
+	m.addkdf_threads = nil
+}
+
+// SetFailedAttempts sets the "failed_attempts" field.
+func (m *WalletMutation) SetFailedAttempts(i int) {
+	m.failed_attempts = &i
+	m.addfailed_attempts = nil
+}
+
+// FailedAttempts returns the value of the "failed_attempts" field in the mutation.
+func (m *WalletMutation) FailedAttempts() (r int, exists bool) {
+	v := m.failed_attempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEncryptedSeed returns the old "encrypted_seed" field's value of the Wallet entity.
+// OldFailedAttempts returns the old "failed_attempts" field's value of the Wallet entity.
 // If the Wallet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WalletMutation) OldEncryptedSeed(ctx context.Context) (v []byte, err error) {
+func (m *WalletMutation) OldFailedAttempts(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEncryptedSeed is only allowed on UpdateOne operations")
+		return v, errors.New("OldFailedAttempts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEncryptedSeed requires an ID field in the mutation")
+		return v, errors.New("OldFailedAttempts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEncryptedSeed: %w", err)
+		return v, fmt.Errorf("querying old value for OldFailedAttempts: %w", err)
 	}
-	return oldValue.EncryptedSeed, nil
+	return oldValue.FailedAttempts, nil
 }
 
-// ResetEncryptedSeed resets all changes to the "encrypted_seed" field.
-func (m *WalletMutation) ResetEncryptedSeed() {
-	m.encrypted_seed = nil
+// AddFailedAttempts adds i to the "failed_attempts" field.
+func (m *WalletMutation) AddFailedAttempts(i int) {
+	if m.addfailed_attempts != nil {
+		*m.addfailed_attempts += i
+	} else {
+		m.addfailed_attempts = &i
+	}
 }
 
-// SetEncryptedKeyJSON sets the "encrypted_key_json" field.
-func (m *WalletMutation) SetEncryptedKeyJSON(b []byte) {
-	m.encrypted_key_json = &b
+// AddedFailedAttempts returns the value that was added to the "failed_attempts" field in this mutation.
+func (m *WalletMutation) AddedFailedAttempts() (r int, exists bool) {
+	v := m.addfailed_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// EncryptedKeyJSON returns the value of the "encrypted_key_json" field in the mutation.
-func (m *WalletMutation) EncryptedKeyJSON() (r []byte, exists bool) {
-	v := m.encrypted_key_json
+// ResetFailedAttempts resets all changes to the "failed_attempts" field.
+func (m *WalletMutation) ResetFailedAttempts() {
+	m.failed_attempts = nil
+	m.addfailed_attempts = nil
+}
+
+// SetRetryAfter sets the "retry_after" field.
+func (m *WalletMutation) SetRetryAfter(t time.Time) {
+	m.retry_after = &t
+}
+
+// RetryAfter returns the value of the "retry_after" field in the mutation.
+func (m *WalletMutation) RetryAfter() (r time.Time, exists bool) {
+	v := m.retry_after
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEncryptedKeyJSON returns the old "encrypted_key_json" field's value of the Wallet entity.
+// OldRetryAfter returns the old "retry_after" field's value of the Wallet entity.
 // If the Wallet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WalletMutation) OldEncryptedKeyJSON(ctx context.Context) (v []byte, err error) {
+func (m *WalletMutation) OldRetryAfter(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEncryptedKeyJSON is only allowed on UpdateOne operations")
+		return v, errors.New("OldRetryAfter is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEncryptedKeyJSON requires an ID field in the mutation")
+		return v, errors.New("OldRetryAfter requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEncryptedKeyJSON: %w", err)
+		return v, fmt.Errorf("querying old value for OldRetryAfter: %w", err)
 	}
-	return oldValue.EncryptedKeyJSON, nil
+	return oldValue.RetryAfter, nil
 }
 
-// ResetEncryptedKeyJSON resets all changes to the "encrypted_key_json" field.
-func (m *WalletMutation) ResetEncryptedKeyJSON() {
-	m.encrypted_key_json = nil
+// ClearRetryAfter clears the value of the "retry_after" field.
+func (m *WalletMutation) ClearRetryAfter() {
+	m.retry_after = nil
+	m.clearedFields[ormwallet.FieldRetryAfter] = struct{}{}
 }
 
-// SetSalt sets the "salt" field.
-func (m *WalletMutation) SetSalt(b []byte) {
-	m.salt = &b
+// RetryAfterCleared returns if the "retry_after" field was cleared in this mutation.
+func (m *WalletMutation) RetryAfterCleared() bool {
+	_, ok := m.clearedFields[ormwallet.FieldRetryAfter]
+	return ok
 }
 
-// Salt returns the value of the "salt" field in the mutation.
-func (m *WalletMutation) Salt() (r []byte, exists bool) {
-	v := m.salt
+// ResetRetryAfter resets all changes to the "retry_after" field.
+func (m *WalletMutation) ResetRetryAfter() {
+	m.retry_after = nil
+	delete(m.clearedFields, ormwallet.FieldRetryAfter)
+}
+
+// SetBackupVerifiedAt sets the "backup_verified_at" field.
+func (m *WalletMutation) SetBackupVerifiedAt(t time.Time) {
+	m.backup_verified_at = &t
+}
+
+// BackupVerifiedAt returns the value of the "backup_verified_at" field in the mutation.
+func (m *WalletMutation) BackupVerifiedAt() (r time.Time, exists bool) {
+	v := m.backup_verified_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSalt returns the old "salt" field's value of the Wallet entity.
+// OldBackupVerifiedAt returns the old "backup_verified_at" field's value of the Wallet entity.
 // If the Wallet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WalletMutation) OldSalt(ctx context.Context) (v []byte, err error) {
+func (m *WalletMutation) OldBackupVerifiedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSalt is only allowed on UpdateOne operations")
+		return v, errors.New("OldBackupVerifiedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSalt requires an ID field in the mutation")
+		return v, errors.New("OldBackupVerifiedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSalt: %w", err)
+		return v, fmt.Errorf("querying old value for OldBackupVerifiedAt: %w", err)
 	}
-	return oldValue.Salt, nil
+	return oldValue.BackupVerifiedAt, nil
 }
 
-// ResetSalt resets all changes to the "salt" field.
-func (m *WalletMutation) ResetSalt() {
-	m.salt = nil
+// ClearBackupVerifiedAt clears the value of the "backup_verified_at" field.
+func (m *WalletMutation) ClearBackupVerifiedAt() {
+	m.backup_verified_at = nil
+	m.clearedFields[ormwallet.FieldBackupVerifiedAt] = struct{}{}
+}
+
+// BackupVerifiedAtCleared returns if the "backup_verified_at" field was cleared in this mutation.
+func (m *WalletMutation) BackupVerifiedAtCleared() bool {
+	_, ok := m.clearedFields[ormwallet.FieldBackupVerifiedAt]
+	return ok
+}
+
+// ResetBackupVerifiedAt resets all changes to the "backup_verified_at" field.
+func (m *WalletMutation) ResetBackupVerifiedAt() {
+	m.backup_verified_at = nil
+	delete(m.clearedFields, ormwallet.FieldBackupVerifiedAt)
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -1182,9 +2558,22 @@ func (m *WalletMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err er
 	return oldValue.UpdatedAt, nil
 }
 
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *WalletMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[ormwallet.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *WalletMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[ormwallet.FieldUpdatedAt]
+	return ok
+}
+
 // ResetUpdatedAt resets all changes to the "updated_at" field.
 func (m *WalletMutation) ResetUpdatedAt() {
 	m.updated_at = nil
+	delete(m.clearedFields, ormwallet.FieldUpdatedAt)
 }
 
 // AddAddressIDs adds the "addresses" edge to the Address entity by ids.
@@ -1275,30 +2664,57 @@ func (m *WalletMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *WalletMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+	fields := make([]string, 0, 17)
 	if m.is_default != nil {
-		fields = append(fields, wallet.FieldIsDefault)
+		fields = append(fields, ormwallet.FieldIsDefault)
 	}
 	if m.actor_id != nil {
-		fields = append(fields, wallet.FieldActorID)
+		fields = append(fields, ormwallet.FieldActorID)
 	}
 	if m.name != nil {
-		fields = append(fields, wallet.FieldName)
+		fields = append(fields, ormwallet.FieldName)
+	}
+	if m.derivation_scheme != nil {
+		fields = append(fields, ormwallet.FieldDerivationScheme)
 	}
 	if m.encrypted_seed != nil {
-		fields = append(fields, wallet.FieldEncryptedSeed)
+		fields = append(fields, ormwallet.FieldEncryptedSeed)
+	}
+	if m.encrypted_passphrase != nil {
+		fields = append(fields, ormwallet.FieldEncryptedPassphrase)
 	}
 	if m.encrypted_key_json != nil {
-		fields = append(fields, wallet.FieldEncryptedKeyJSON)
+		fields = append(fields, ormwallet.FieldEncryptedKeyJSON)
 	}
 	if m.salt != nil {
-		fields = append(fields, wallet.FieldSalt)
+		fields = append(fields, ormwallet.FieldSalt)
+	}
+	if m.kdf_algorithm != nil {
+		fields = append(fields, ormwallet.FieldKdfAlgorithm)
+	}
+	if m.kdf_time != nil {
+		fields = append(fields, ormwallet.FieldKdfTime)
+	}
+	if m.kdf_memory != nil {
+		fields = append(fields, ormwallet.FieldKdfMemory)
+	}
+	if m.kdf_threads != nil {
+		fields = append(fields, ormwallet.FieldKdfThreads)
+	}
+	if m.failed_attempts != nil {
+		fields = append(fields, ormwallet.FieldFailedAttempts)
+	}
+	if m.retry_after != nil {
+		fields = append(fields, ormwallet.FieldRetryAfter)
+	}
+	if m.backup_verified_at != nil {
+		fields = append(fields, ormwallet.FieldBackupVerifiedAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, wallet.FieldCreatedAt)
+		fields = append(fields, ormwallet.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, wallet.FieldUpdatedAt)
+		fields = append(fields, ormwallet.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -1308,21 +2724,39 @@ func (m *WalletMutation) Fields() []string {
 // schema.
 func (m *WalletMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case wallet.FieldIsDefault:
+	case ormwallet.FieldIsDefault:
 		return m.IsDefault()
-	case wallet.FieldActorID:
+	case ormwallet.FieldActorID:
 		return m.ActorID()
-	case wallet.FieldName:
+	case ormwallet.FieldName:
 		return m.Name()
-	case wallet.FieldEncryptedSeed:
+	case ormwallet.FieldDerivationScheme:
+		return m.DerivationScheme()
+	case ormwallet.FieldEncryptedSeed:
 		return m.EncryptedSeed()
-	case wallet.FieldEncryptedKeyJSON:
+	case ormwallet.FieldEncryptedPassphrase:
+		return m.EncryptedPassphrase()
+	case ormwallet.FieldEncryptedKeyJSON:
 		return m.EncryptedKeyJSON()
-	case wallet.FieldSalt:
+	case ormwallet.FieldSalt:
 		return m.Salt()
-	case wallet.FieldCreatedAt:
+	case ormwallet.FieldKdfAlgorithm:
+		return m.KdfAlgorithm()
+	case ormwallet.FieldKdfTime:
+		return m.KdfTime()
+	case ormwallet.FieldKdfMemory:
+		return m.KdfMemory()
+	case ormwallet.FieldKdfThreads:
+		return m.KdfThreads()
+	case ormwallet.FieldFailedAttempts:
+		return m.FailedAttempts()
+	case ormwallet.FieldRetryAfter:
+		return m.RetryAfter()
+	case ormwallet.FieldBackupVerifiedAt:
+		return m.BackupVerifiedAt()
+	case ormwallet.FieldCreatedAt:
 		return m.CreatedAt()
-	case wallet.FieldUpdatedAt:
+	case ormwallet.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -1333,21 +2767,39 @@ func (m *WalletMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *WalletMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case wallet.FieldIsDefault:
+	case ormwallet.FieldIsDefault:
 		return m.OldIsDefault(ctx)
-	case wallet.FieldActorID:
+	case ormwallet.FieldActorID:
 		return m.OldActorID(ctx)
-	case wallet.FieldName:
+	case ormwallet.FieldName:
 		return m.OldName(ctx)
-	case wallet.FieldEncryptedSeed:
+	case ormwallet.FieldDerivationScheme:
+		return m.OldDerivationScheme(ctx)
+	case ormwallet.FieldEncryptedSeed:
 		return m.OldEncryptedSeed(ctx)
-	case wallet.FieldEncryptedKeyJSON:
+	case ormwallet.FieldEncryptedPassphrase:
+		return m.OldEncryptedPassphrase(ctx)
+	case ormwallet.FieldEncryptedKeyJSON:
 		return m.OldEncryptedKeyJSON(ctx)
-	case wallet.FieldSalt:
+	case ormwallet.FieldSalt:
 		return m.OldSalt(ctx)
-	case wallet.FieldCreatedAt:
+	case ormwallet.FieldKdfAlgorithm:
+		return m.OldKdfAlgorithm(ctx)
+	case ormwallet.FieldKdfTime:
+		return m.OldKdfTime(ctx)
+	case ormwallet.FieldKdfMemory:
+		return m.OldKdfMemory(ctx)
+	case ormwallet.FieldKdfThreads:
+		return m.OldKdfThreads(ctx)
+	case ormwallet.FieldFailedAttempts:
+		return m.OldFailedAttempts(ctx)
+	case ormwallet.FieldRetryAfter:
+		return m.OldRetryAfter(ctx)
+	case ormwallet.FieldBackupVerifiedAt:
+		return m.OldBackupVerifiedAt(ctx)
+	case ormwallet.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case wallet.FieldUpdatedAt:
+	case ormwallet.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
 	return nil, fmt.Errorf("unknown Wallet field %s", name)
@@ -1358,56 +2810,119 @@ func (m *WalletMutation) OldField(ctx context.Context, name string) (ent.Value,
 // type.
 func (m *WalletMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case wallet.FieldIsDefault:
+	case ormwallet.FieldIsDefault:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetIsDefault(v)
 		return nil
-	case wallet.FieldActorID:
+	case ormwallet.FieldActorID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetActorID(v)
 		return nil
-	case wallet.FieldName:
+	case ormwallet.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case wallet.FieldEncryptedSeed:
+	case ormwallet.FieldDerivationScheme:
+		v, ok := value.(wallet.DerivationScheme)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDerivationScheme(v)
+		return nil
+	case ormwallet.FieldEncryptedSeed:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetEncryptedSeed(v)
 		return nil
-	case wallet.FieldEncryptedKeyJSON:
+	case ormwallet.FieldEncryptedPassphrase:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEncryptedPassphrase(v)
+		return nil
+	case ormwallet.FieldEncryptedKeyJSON:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetEncryptedKeyJSON(v)
 		return nil
-	case wallet.FieldSalt:
+	case ormwallet.FieldSalt:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetSalt(v)
 		return nil
-	case wallet.FieldCreatedAt:
+	case ormwallet.FieldKdfAlgorithm:
+		v, ok := value.(wallet.KDFAlgorithm)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKdfAlgorithm(v)
+		return nil
+	case ormwallet.FieldKdfTime:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKdfTime(v)
+		return nil
+	case ormwallet.FieldKdfMemory:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKdfMemory(v)
+		return nil
+	case ormwallet.FieldKdfThreads:
+		v, ok := value.(uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKdfThreads(v)
+		return nil
+	case ormwallet.FieldFailedAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailedAttempts(v)
+		return nil
+	case ormwallet.FieldRetryAfter:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetryAfter(v)
+		return nil
+	case ormwallet.FieldBackupVerifiedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBackupVerifiedAt(v)
+		return nil
+	case ormwallet.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case wallet.FieldUpdatedAt:
+	case ormwallet.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -1421,13 +2936,36 @@ func (m *WalletMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *WalletMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addkdf_time != nil {
+		fields = append(fields, ormwallet.FieldKdfTime)
+	}
+	if m.addkdf_memory != nil {
+		fields = append(fields, ormwallet.FieldKdfMemory)
+	}
+	if m.addkdf_threads != nil {
+		fields = append(fields, ormwallet.FieldKdfThreads)
+	}
+	if m.addfailed_attempts != nil {
+		fields = append(fields, ormwallet.FieldFailedAttempts)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *WalletMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case ormwallet.FieldKdfTime:
+		return m.AddedKdfTime()
+	case ormwallet.FieldKdfMemory:
+		return m.AddedKdfMemory()
+	case ormwallet.FieldKdfThreads:
+		return m.AddedKdfThreads()
+	case ormwallet.FieldFailedAttempts:
+		return m.AddedFailedAttempts()
+	}
 	return nil, false
 }
 
@@ -1436,6 +2974,34 @@ func (m *WalletMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *WalletMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case ormwallet.FieldKdfTime:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddKdfTime(v)
+		return nil
+	case ormwallet.FieldKdfMemory:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddKdfMemory(v)
+		return nil
+	case ormwallet.FieldKdfThreads:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddKdfThreads(v)
+		return nil
+	case ormwallet.FieldFailedAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFailedAttempts(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Wallet numeric field %s", name)
 }
@@ -1443,7 +3009,26 @@ func (m *WalletMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *WalletMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(ormwallet.FieldActorID) {
+		fields = append(fields, ormwallet.FieldActorID)
+	}
+	if m.FieldCleared(ormwallet.FieldEncryptedSeed) {
+		fields = append(fields, ormwallet.FieldEncryptedSeed)
+	}
+	if m.FieldCleared(ormwallet.FieldEncryptedPassphrase) {
+		fields = append(fields, ormwallet.FieldEncryptedPassphrase)
+	}
+	if m.FieldCleared(ormwallet.FieldRetryAfter) {
+		fields = append(fields, ormwallet.FieldRetryAfter)
+	}
+	if m.FieldCleared(ormwallet.FieldBackupVerifiedAt) {
+		fields = append(fields, ormwallet.FieldBackupVerifiedAt)
+	}
+	if m.FieldCleared(ormwallet.FieldUpdatedAt) {
+		fields = append(fields, ormwallet.FieldUpdatedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -1456,6 +3041,26 @@ func (m *WalletMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *WalletMutation) ClearField(name string) error {
+	switch name {
+	case ormwallet.FieldActorID:
+		m.ClearActorID()
+		return nil
+	case ormwallet.FieldEncryptedSeed:
+		m.ClearEncryptedSeed()
+		return nil
+	case ormwallet.FieldEncryptedPassphrase:
+		m.ClearEncryptedPassphrase()
+		return nil
+	case ormwallet.FieldRetryAfter:
+		m.ClearRetryAfter()
+		return nil
+	case ormwallet.FieldBackupVerifiedAt:
+		m.ClearBackupVerifiedAt()
+		return nil
+	case ormwallet.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	}
 	return fmt.Errorf("unknown Wallet nullable field %s", name)
 }
 
@@ -1463,28 +3068,55 @@ func (m *WalletMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *WalletMutation) ResetField(name string) error {
 	switch name {
-	case wallet.FieldIsDefault:
+	case ormwallet.FieldIsDefault:
 		m.ResetIsDefault()
 		return nil
-	case wallet.FieldActorID:
+	case ormwallet.FieldActorID:
 		m.ResetActorID()
 		return nil
-	case wallet.FieldName:
+	case ormwallet.FieldName:
 		m.ResetName()
 		return nil
-	case wallet.FieldEncryptedSeed:
+	case ormwallet.FieldDerivationScheme:
+		m.ResetDerivationScheme()
+		return nil
+	case ormwallet.FieldEncryptedSeed:
 		m.ResetEncryptedSeed()
 		return nil
-	case wallet.FieldEncryptedKeyJSON:
+	case ormwallet.FieldEncryptedPassphrase:
+		m.ResetEncryptedPassphrase()
+		return nil
+	case ormwallet.FieldEncryptedKeyJSON:
 		m.ResetEncryptedKeyJSON()
 		return nil
-	case wallet.FieldSalt:
+	case ormwallet.FieldSalt:
 		m.ResetSalt()
 		return nil
-	case wallet.FieldCreatedAt:
+	case ormwallet.FieldKdfAlgorithm:
+		m.ResetKdfAlgorithm()
+		return nil
+	case ormwallet.FieldKdfTime:
+		m.ResetKdfTime()
+		return nil
+	case ormwallet.FieldKdfMemory:
+		m.ResetKdfMemory()
+		return nil
+	case ormwallet.FieldKdfThreads:
+		m.ResetKdfThreads()
+		return nil
+	case ormwallet.FieldFailedAttempts:
+		m.ResetFailedAttempts()
+		return nil
+	case ormwallet.FieldRetryAfter:
+		m.ResetRetryAfter()
+		return nil
+	case ormwallet.FieldBackupVerifiedAt:
+		m.ResetBackupVerifiedAt()
+		return nil
+	case ormwallet.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case wallet.FieldUpdatedAt:
+	case ormwallet.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
@@ -1495,7 +3127,7 @@ func (m *WalletMutation) ResetField(name string) error {
 func (m *WalletMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.addresses != nil {
-		edges = append(edges, wallet.EdgeAddresses)
+		edges = append(edges, ormwallet.EdgeAddresses)
 	}
 	return edges
 }
@@ -1504,7 +3136,7 @@ func (m *WalletMutation) AddedEdges() []string {
 // name in this mutation.
 func (m *WalletMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case wallet.EdgeAddresses:
+	case ormwallet.EdgeAddresses:
 		ids := make([]ent.Value, 0, len(m.addresses))
 		for id := range m.addresses {
 			ids = append(ids, id)
@@ -1518,7 +3150,7 @@ func (m *WalletMutation) AddedIDs(name string) []ent.Value {
 func (m *WalletMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.removedaddresses != nil {
-		edges = append(edges, wallet.EdgeAddresses)
+		edges = append(edges, ormwallet.EdgeAddresses)
 	}
 	return edges
 }
@@ -1527,7 +3159,7 @@ func (m *WalletMutation) RemovedEdges() []string {
 // the given name in this mutation.
 func (m *WalletMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case wallet.EdgeAddresses:
+	case ormwallet.EdgeAddresses:
 		ids := make([]ent.Value, 0, len(m.removedaddresses))
 		for id := range m.removedaddresses {
 			ids = append(ids, id)
@@ -1541,7 +3173,7 @@ func (m *WalletMutation) RemovedIDs(name string) []ent.Value {
 func (m *WalletMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.clearedaddresses {
-		edges = append(edges, wallet.EdgeAddresses)
+		edges = append(edges, ormwallet.EdgeAddresses)
 	}
 	return edges
 }
@@ -1550,7 +3182,7 @@ func (m *WalletMutation) ClearedEdges() []string {
 // was cleared in this mutation.
 func (m *WalletMutation) EdgeCleared(name string) bool {
 	switch name {
-	case wallet.EdgeAddresses:
+	case ormwallet.EdgeAddresses:
 		return m.clearedaddresses
 	}
 	return false
@@ -1568,7 +3200,7 @@ func (m *WalletMutation) ClearEdge(name string) error {
 // It returns an error if the edge is not defined in the schema.
 func (m *WalletMutation) ResetEdge(name string) error {
 	switch name {
-	case wallet.EdgeAddresses:
+	case ormwallet.EdgeAddresses:
 		m.ResetAddresses()
 		return nil
 	}
@@ -9,8 +9,11 @@ import (
 // Address is the predicate function for ormaddress builders.
 type Address func(*sql.Selector)
 
+// AuditEvent is the predicate function for auditevent builders.
+type AuditEvent func(*sql.Selector)
+
 // Setting is the predicate function for setting builders.
 type Setting func(*sql.Selector)
 
-// Wallet is the predicate function for wallet builders.
+// Wallet is the predicate function for ormwallet builders.
 type Wallet func(*sql.Selector)
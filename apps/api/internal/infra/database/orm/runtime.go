@@ -6,7 +6,8 @@ import (
 	"time"
 
 	ormaddress "github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/address"
-	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/wallet"
+	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/auditevent"
+	ormwallet "github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/wallet"
 	"github.com/codemaestro64/filament/apps/api/internal/infra/database/schema"
 )
 
@@ -20,30 +21,60 @@ func init() {
 	ormaddressDescAddress := ormaddressFields[1].Descriptor()
 	// ormaddress.AddressValidator is a validator for the "address" field. It is called by the builders before save.
 	ormaddress.AddressValidator = ormaddressDescAddress.Validators[0].(func(string) error)
-	walletFields := schema.Wallet{}.Fields()
-	_ = walletFields
-	// walletDescIsDefault is the schema descriptor for is_default field.
-	walletDescIsDefault := walletFields[0].Descriptor()
-	// wallet.DefaultIsDefault holds the default value on creation for the is_default field.
-	wallet.DefaultIsDefault = walletDescIsDefault.Default.(bool)
-	// walletDescName is the schema descriptor for name field.
-	walletDescName := walletFields[2].Descriptor()
-	// wallet.NameValidator is a validator for the "name" field. It is called by the builders before save.
-	wallet.NameValidator = walletDescName.Validators[0].(func(string) error)
-	// walletDescEncryptedSeed is the schema descriptor for encrypted_seed field.
-	walletDescEncryptedSeed := walletFields[3].Descriptor()
-	// wallet.EncryptedSeedValidator is a validator for the "encrypted_seed" field. It is called by the builders before save.
-	wallet.EncryptedSeedValidator = walletDescEncryptedSeed.Validators[0].(func([]byte) error)
-	// walletDescEncryptedKeyJSON is the schema descriptor for encrypted_key_json field.
-	walletDescEncryptedKeyJSON := walletFields[4].Descriptor()
-	// wallet.EncryptedKeyJSONValidator is a validator for the "encrypted_key_json" field. It is called by the builders before save.
-	wallet.EncryptedKeyJSONValidator = walletDescEncryptedKeyJSON.Validators[0].(func([]byte) error)
-	// walletDescSalt is the schema descriptor for salt field.
-	walletDescSalt := walletFields[5].Descriptor()
-	// wallet.SaltValidator is a validator for the "salt" field. It is called by the builders before save.
-	wallet.SaltValidator = walletDescSalt.Validators[0].(func([]byte) error)
-	// walletDescCreatedAt is the schema descriptor for created_at field.
-	walletDescCreatedAt := walletFields[6].Descriptor()
-	// wallet.DefaultCreatedAt holds the default value on creation for the created_at field.
-	wallet.DefaultCreatedAt = walletDescCreatedAt.Default.(func() time.Time)
+	// ormaddressDescAccountIndex is the schema descriptor for account_index field.
+	ormaddressDescAccountIndex := ormaddressFields[2].Descriptor()
+	// ormaddress.DefaultAccountIndex holds the default value on creation for the account_index field.
+	ormaddress.DefaultAccountIndex = ormaddressDescAccountIndex.Default.(uint32)
+	auditeventFields := schema.AuditEvent{}.Fields()
+	_ = auditeventFields
+	// auditeventDescAction is the schema descriptor for action field.
+	auditeventDescAction := auditeventFields[1].Descriptor()
+	// auditevent.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	auditevent.ActionValidator = auditeventDescAction.Validators[0].(func(string) error)
+	// auditeventDescSuccess is the schema descriptor for success field.
+	auditeventDescSuccess := auditeventFields[3].Descriptor()
+	// auditevent.DefaultSuccess holds the default value on creation for the success field.
+	auditevent.DefaultSuccess = auditeventDescSuccess.Default.(bool)
+	// auditeventDescCreatedAt is the schema descriptor for created_at field.
+	auditeventDescCreatedAt := auditeventFields[4].Descriptor()
+	// auditevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditevent.DefaultCreatedAt = auditeventDescCreatedAt.Default.(func() time.Time)
+	ormwalletFields := schema.Wallet{}.Fields()
+	_ = ormwalletFields
+	// ormwalletDescIsDefault is the schema descriptor for is_default field.
+	ormwalletDescIsDefault := ormwalletFields[0].Descriptor()
+	// ormwallet.DefaultIsDefault holds the default value on creation for the is_default field.
+	ormwallet.DefaultIsDefault = ormwalletDescIsDefault.Default.(bool)
+	// ormwalletDescName is the schema descriptor for name field.
+	ormwalletDescName := ormwalletFields[2].Descriptor()
+	// ormwallet.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	ormwallet.NameValidator = ormwalletDescName.Validators[0].(func(string) error)
+	// ormwalletDescEncryptedKeyJSON is the schema descriptor for encrypted_key_json field.
+	ormwalletDescEncryptedKeyJSON := ormwalletFields[6].Descriptor()
+	// ormwallet.EncryptedKeyJSONValidator is a validator for the "encrypted_key_json" field. It is called by the builders before save.
+	ormwallet.EncryptedKeyJSONValidator = ormwalletDescEncryptedKeyJSON.Validators[0].(func([]byte) error)
+	// ormwalletDescSalt is the schema descriptor for salt field.
+	ormwalletDescSalt := ormwalletFields[7].Descriptor()
+	// ormwallet.SaltValidator is a validator for the "salt" field. It is called by the builders before save.
+	ormwallet.SaltValidator = ormwalletDescSalt.Validators[0].(func([]byte) error)
+	// ormwalletDescKdfTime is the schema descriptor for kdf_time field.
+	ormwalletDescKdfTime := ormwalletFields[9].Descriptor()
+	// ormwallet.DefaultKdfTime holds the default value on creation for the kdf_time field.
+	ormwallet.DefaultKdfTime = ormwalletDescKdfTime.Default.(uint32)
+	// ormwalletDescKdfMemory is the schema descriptor for kdf_memory field.
+	ormwalletDescKdfMemory := ormwalletFields[10].Descriptor()
+	// ormwallet.DefaultKdfMemory holds the default value on creation for the kdf_memory field.
+	ormwallet.DefaultKdfMemory = ormwalletDescKdfMemory.Default.(uint32)
+	// ormwalletDescKdfThreads is the schema descriptor for kdf_threads field.
+	ormwalletDescKdfThreads := ormwalletFields[11].Descriptor()
+	// ormwallet.DefaultKdfThreads holds the default value on creation for the kdf_threads field.
+	ormwallet.DefaultKdfThreads = ormwalletDescKdfThreads.Default.(uint8)
+	// ormwalletDescFailedAttempts is the schema descriptor for failed_attempts field.
+	ormwalletDescFailedAttempts := ormwalletFields[12].Descriptor()
+	// ormwallet.DefaultFailedAttempts holds the default value on creation for the failed_attempts field.
+	ormwallet.DefaultFailedAttempts = ormwalletDescFailedAttempts.Default.(int)
+	// ormwalletDescCreatedAt is the schema descriptor for created_at field.
+	ormwalletDescCreatedAt := ormwalletFields[15].Descriptor()
+	// ormwallet.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ormwallet.DefaultCreatedAt = ormwalletDescCreatedAt.Default.(func() time.Time)
 }
@@ -14,6 +14,8 @@ type Tx struct {
 	config
 	// Address is the client for interacting with the Address builders.
 	Address *AddressClient
+	// AuditEvent is the client for interacting with the AuditEvent builders.
+	AuditEvent *AuditEventClient
 	// Setting is the client for interacting with the Setting builders.
 	Setting *SettingClient
 	// Wallet is the client for interacting with the Wallet builders.
@@ -150,6 +152,7 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Address = NewAddressClient(tx.config)
+	tx.AuditEvent = NewAuditEventClient(tx.config)
 	tx.Setting = NewSettingClient(tx.config)
 	tx.Wallet = NewWalletClient(tx.config)
 }
@@ -9,7 +9,8 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
-	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/wallet"
+	ormwallet "github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/wallet"
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
 )
 
 // Wallet is the model entity for the Wallet schema.
@@ -23,12 +24,30 @@ type Wallet struct {
 	ActorID *string `json:"actor_id,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// DerivationScheme holds the value of the "derivation_scheme" field.
+	DerivationScheme wallet.DerivationScheme `json:"derivation_scheme,omitempty"`
 	// EncryptedSeed holds the value of the "encrypted_seed" field.
 	EncryptedSeed []byte `json:"-"`
+	// EncryptedPassphrase holds the value of the "encrypted_passphrase" field.
+	EncryptedPassphrase []byte `json:"-"`
 	// EncryptedKeyJSON holds the value of the "encrypted_key_json" field.
 	EncryptedKeyJSON []byte `json:"-"`
 	// Salt holds the value of the "salt" field.
 	Salt []byte `json:"-"`
+	// KdfAlgorithm holds the value of the "kdf_algorithm" field.
+	KdfAlgorithm wallet.KDFAlgorithm `json:"kdf_algorithm,omitempty"`
+	// KdfTime holds the value of the "kdf_time" field.
+	KdfTime uint32 `json:"kdf_time,omitempty"`
+	// KdfMemory holds the value of the "kdf_memory" field.
+	KdfMemory uint32 `json:"kdf_memory,omitempty"`
+	// KdfThreads holds the value of the "kdf_threads" field.
+	KdfThreads uint8 `json:"kdf_threads,omitempty"`
+	// FailedAttempts holds the value of the "failed_attempts" field.
+	FailedAttempts int `json:"failed_attempts,omitempty"`
+	// RetryAfter holds the value of the "retry_after" field.
+	RetryAfter *time.Time `json:"retry_after,omitempty"`
+	// BackupVerifiedAt holds the value of the "backup_verified_at" field.
+	BackupVerifiedAt *time.Time `json:"backup_verified_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -62,15 +81,15 @@ func (*Wallet) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case wallet.FieldEncryptedSeed, wallet.FieldEncryptedKeyJSON, wallet.FieldSalt:
+		case ormwallet.FieldEncryptedSeed, ormwallet.FieldEncryptedPassphrase, ormwallet.FieldEncryptedKeyJSON, ormwallet.FieldSalt:
 			values[i] = new([]byte)
-		case wallet.FieldIsDefault:
+		case ormwallet.FieldIsDefault:
 			values[i] = new(sql.NullBool)
-		case wallet.FieldID:
+		case ormwallet.FieldID, ormwallet.FieldKdfTime, ormwallet.FieldKdfMemory, ormwallet.FieldKdfThreads, ormwallet.FieldFailedAttempts:
 			values[i] = new(sql.NullInt64)
-		case wallet.FieldActorID, wallet.FieldName:
+		case ormwallet.FieldActorID, ormwallet.FieldName, ormwallet.FieldDerivationScheme, ormwallet.FieldKdfAlgorithm:
 			values[i] = new(sql.NullString)
-		case wallet.FieldCreatedAt, wallet.FieldUpdatedAt:
+		case ormwallet.FieldRetryAfter, ormwallet.FieldBackupVerifiedAt, ormwallet.FieldCreatedAt, ormwallet.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -87,56 +106,112 @@ func (_m *Wallet) assignValues(columns []string, values []any) error {
 	}
 	for i := range columns {
 		switch columns[i] {
-		case wallet.FieldID:
+		case ormwallet.FieldID:
 			value, ok := values[i].(*sql.NullInt64)
 			if !ok {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			_m.ID = int(value.Int64)
-		case wallet.FieldIsDefault:
+		case ormwallet.FieldIsDefault:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field is_default", values[i])
 			} else if value.Valid {
 				_m.IsDefault = value.Bool
 			}
-		case wallet.FieldActorID:
+		case ormwallet.FieldActorID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field actor_id", values[i])
 			} else if value.Valid {
 				_m.ActorID = new(string)
 				*_m.ActorID = value.String
 			}
-		case wallet.FieldName:
+		case ormwallet.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
 			} else if value.Valid {
 				_m.Name = value.String
 			}
-		case wallet.FieldEncryptedSeed:
+		case ormwallet.FieldDerivationScheme:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field derivation_scheme", values[i])
+			} else if value.Valid {
+				_m.DerivationScheme = wallet.DerivationScheme(value.String)
+			}
+		case ormwallet.FieldEncryptedSeed:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field encrypted_seed", values[i])
 			} else if value != nil {
 				_m.EncryptedSeed = *value
 			}
-		case wallet.FieldEncryptedKeyJSON:
+		case ormwallet.FieldEncryptedPassphrase:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field encrypted_passphrase", values[i])
+			} else if value != nil {
+				_m.EncryptedPassphrase = *value
+			}
+		case ormwallet.FieldEncryptedKeyJSON:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field encrypted_key_json", values[i])
 			} else if value != nil {
 				_m.EncryptedKeyJSON = *value
 			}
-		case wallet.FieldSalt:
+		case ormwallet.FieldSalt:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field salt", values[i])
 			} else if value != nil {
 				_m.Salt = *value
 			}
-		case wallet.FieldCreatedAt:
+		case ormwallet.FieldKdfAlgorithm:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kdf_algorithm", values[i])
+			} else if value.Valid {
+				_m.KdfAlgorithm = wallet.KDFAlgorithm(value.String)
+			}
+		case ormwallet.FieldKdfTime:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field kdf_time", values[i])
+			} else if value.Valid {
+				_m.KdfTime = uint32(value.Int64)
+			}
+		case ormwallet.FieldKdfMemory:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field kdf_memory", values[i])
+			} else if value.Valid {
+				_m.KdfMemory = uint32(value.Int64)
+			}
+		case ormwallet.FieldKdfThreads:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field kdf_threads", values[i])
+			} else if value.Valid {
+				_m.KdfThreads = uint8(value.Int64)
+			}
+		case ormwallet.FieldFailedAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failed_attempts", values[i])
+			} else if value.Valid {
+				_m.FailedAttempts = int(value.Int64)
+			}
+		case ormwallet.FieldRetryAfter:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field retry_after", values[i])
+			} else if value.Valid {
+				_m.RetryAfter = new(time.Time)
+				*_m.RetryAfter = value.Time
+			}
+		case ormwallet.FieldBackupVerifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field backup_verified_at", values[i])
+			} else if value.Valid {
+				_m.BackupVerifiedAt = new(time.Time)
+				*_m.BackupVerifiedAt = value.Time
+			}
+		case ormwallet.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
 			} else if value.Valid {
 				_m.CreatedAt = value.Time
 			}
-		case wallet.FieldUpdatedAt:
+		case ormwallet.FieldUpdatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
 			} else if value.Valid {
@@ -195,12 +270,42 @@ func (_m *Wallet) String() string {
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
 	builder.WriteString(", ")
+	builder.WriteString("derivation_scheme=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DerivationScheme))
+	builder.WriteString(", ")
 	builder.WriteString("encrypted_seed=<sensitive>")
 	builder.WriteString(", ")
+	builder.WriteString("encrypted_passphrase=<sensitive>")
+	builder.WriteString(", ")
 	builder.WriteString("encrypted_key_json=<sensitive>")
 	builder.WriteString(", ")
 	builder.WriteString("salt=<sensitive>")
 	builder.WriteString(", ")
+	builder.WriteString("kdf_algorithm=")
+	builder.WriteString(fmt.Sprintf("%v", _m.KdfAlgorithm))
+	builder.WriteString(", ")
+	builder.WriteString("kdf_time=")
+	builder.WriteString(fmt.Sprintf("%v", _m.KdfTime))
+	builder.WriteString(", ")
+	builder.WriteString("kdf_memory=")
+	builder.WriteString(fmt.Sprintf("%v", _m.KdfMemory))
+	builder.WriteString(", ")
+	builder.WriteString("kdf_threads=")
+	builder.WriteString(fmt.Sprintf("%v", _m.KdfThreads))
+	builder.WriteString(", ")
+	builder.WriteString("failed_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FailedAttempts))
+	builder.WriteString(", ")
+	if v := _m.RetryAfter; v != nil {
+		builder.WriteString("retry_after=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.BackupVerifiedAt; v != nil {
+		builder.WriteString("backup_verified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
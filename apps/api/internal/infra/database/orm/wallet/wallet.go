@@ -1,12 +1,14 @@
 // Code generated by ent, DO NOT EDIT.
 
-package wallet
+package ormwallet
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
 )
 
 const (
@@ -20,12 +22,30 @@ const (
 	FieldActorID = "actor_id"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldDerivationScheme holds the string denoting the derivation_scheme field in the database.
+	FieldDerivationScheme = "derivation_scheme"
 	// FieldEncryptedSeed holds the string denoting the encrypted_seed field in the database.
 	FieldEncryptedSeed = "encrypted_seed"
+	// FieldEncryptedPassphrase holds the string denoting the encrypted_passphrase field in the database.
+	FieldEncryptedPassphrase = "encrypted_passphrase"
 	// FieldEncryptedKeyJSON holds the string denoting the encrypted_key_json field in the database.
 	FieldEncryptedKeyJSON = "encrypted_key_json"
 	// FieldSalt holds the string denoting the salt field in the database.
 	FieldSalt = "salt"
+	// FieldKdfAlgorithm holds the string denoting the kdf_algorithm field in the database.
+	FieldKdfAlgorithm = "kdf_algorithm"
+	// FieldKdfTime holds the string denoting the kdf_time field in the database.
+	FieldKdfTime = "kdf_time"
+	// FieldKdfMemory holds the string denoting the kdf_memory field in the database.
+	FieldKdfMemory = "kdf_memory"
+	// FieldKdfThreads holds the string denoting the kdf_threads field in the database.
+	FieldKdfThreads = "kdf_threads"
+	// FieldFailedAttempts holds the string denoting the failed_attempts field in the database.
+	FieldFailedAttempts = "failed_attempts"
+	// FieldRetryAfter holds the string denoting the retry_after field in the database.
+	FieldRetryAfter = "retry_after"
+	// FieldBackupVerifiedAt holds the string denoting the backup_verified_at field in the database.
+	FieldBackupVerifiedAt = "backup_verified_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -49,9 +69,18 @@ var Columns = []string{
 	FieldIsDefault,
 	FieldActorID,
 	FieldName,
+	FieldDerivationScheme,
 	FieldEncryptedSeed,
+	FieldEncryptedPassphrase,
 	FieldEncryptedKeyJSON,
 	FieldSalt,
+	FieldKdfAlgorithm,
+	FieldKdfTime,
+	FieldKdfMemory,
+	FieldKdfThreads,
+	FieldFailedAttempts,
+	FieldRetryAfter,
+	FieldBackupVerifiedAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -71,16 +100,46 @@ var (
 	DefaultIsDefault bool
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
-	// EncryptedSeedValidator is a validator for the "encrypted_seed" field. It is called by the builders before save.
-	EncryptedSeedValidator func([]byte) error
 	// EncryptedKeyJSONValidator is a validator for the "encrypted_key_json" field. It is called by the builders before save.
 	EncryptedKeyJSONValidator func([]byte) error
 	// SaltValidator is a validator for the "salt" field. It is called by the builders before save.
 	SaltValidator func([]byte) error
+	// DefaultKdfTime holds the default value on creation for the "kdf_time" field.
+	DefaultKdfTime uint32
+	// DefaultKdfMemory holds the default value on creation for the "kdf_memory" field.
+	DefaultKdfMemory uint32
+	// DefaultKdfThreads holds the default value on creation for the "kdf_threads" field.
+	DefaultKdfThreads uint8
+	// DefaultFailedAttempts holds the default value on creation for the "failed_attempts" field.
+	DefaultFailedAttempts int
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 )
 
+const DefaultDerivationScheme wallet.DerivationScheme = "legacy"
+
+// DerivationSchemeValidator is a validator for the "derivation_scheme" field enum values. It is called by the builders before save.
+func DerivationSchemeValidator(ds wallet.DerivationScheme) error {
+	switch ds.String() {
+	case "legacy", "bip44", "imported":
+		return nil
+	default:
+		return fmt.Errorf("ormwallet: invalid enum value for derivation_scheme field: %q", ds)
+	}
+}
+
+const DefaultKdfAlgorithm wallet.KDFAlgorithm = "argon2id"
+
+// KdfAlgorithmValidator is a validator for the "kdf_algorithm" field enum values. It is called by the builders before save.
+func KdfAlgorithmValidator(ka wallet.KDFAlgorithm) error {
+	switch ka.String() {
+	case "argon2id":
+		return nil
+	default:
+		return fmt.Errorf("ormwallet: invalid enum value for kdf_algorithm field: %q", ka)
+	}
+}
+
 // OrderOption defines the ordering options for the Wallet queries.
 type OrderOption func(*sql.Selector)
 
@@ -104,6 +163,46 @@ func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
 }
 
+// ByDerivationScheme orders the results by the derivation_scheme field.
+func ByDerivationScheme(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDerivationScheme, opts...).ToFunc()
+}
+
+// ByKdfAlgorithm orders the results by the kdf_algorithm field.
+func ByKdfAlgorithm(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKdfAlgorithm, opts...).ToFunc()
+}
+
+// ByKdfTime orders the results by the kdf_time field.
+func ByKdfTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKdfTime, opts...).ToFunc()
+}
+
+// ByKdfMemory orders the results by the kdf_memory field.
+func ByKdfMemory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKdfMemory, opts...).ToFunc()
+}
+
+// ByKdfThreads orders the results by the kdf_threads field.
+func ByKdfThreads(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKdfThreads, opts...).ToFunc()
+}
+
+// ByFailedAttempts orders the results by the failed_attempts field.
+func ByFailedAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailedAttempts, opts...).ToFunc()
+}
+
+// ByRetryAfter orders the results by the retry_after field.
+func ByRetryAfter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetryAfter, opts...).ToFunc()
+}
+
+// ByBackupVerifiedAt orders the results by the backup_verified_at field.
+func ByBackupVerifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBackupVerifiedAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
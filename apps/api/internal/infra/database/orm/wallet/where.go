@@ -1,6 +1,6 @@
 // Code generated by ent, DO NOT EDIT.
 
-package wallet
+package ormwallet
 
 import (
 	"time"
@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/predicate"
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
 )
 
 // ID filters vertices based on their ID field.
@@ -75,6 +76,11 @@ func EncryptedSeed(v []byte) predicate.Wallet {
 	return predicate.Wallet(sql.FieldEQ(FieldEncryptedSeed, v))
 }
 
+// EncryptedPassphrase applies equality check predicate on the "encrypted_passphrase" field. It's identical to EncryptedPassphraseEQ.
+func EncryptedPassphrase(v []byte) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldEncryptedPassphrase, v))
+}
+
 // EncryptedKeyJSON applies equality check predicate on the "encrypted_key_json" field. It's identical to EncryptedKeyJSONEQ.
 func EncryptedKeyJSON(v []byte) predicate.Wallet {
 	return predicate.Wallet(sql.FieldEQ(FieldEncryptedKeyJSON, v))
@@ -85,6 +91,36 @@ func Salt(v []byte) predicate.Wallet {
 	return predicate.Wallet(sql.FieldEQ(FieldSalt, v))
 }
 
+// KdfTime applies equality check predicate on the "kdf_time" field. It's identical to KdfTimeEQ.
+func KdfTime(v uint32) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldKdfTime, v))
+}
+
+// KdfMemory applies equality check predicate on the "kdf_memory" field. It's identical to KdfMemoryEQ.
+func KdfMemory(v uint32) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldKdfMemory, v))
+}
+
+// KdfThreads applies equality check predicate on the "kdf_threads" field. It's identical to KdfThreadsEQ.
+func KdfThreads(v uint8) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldKdfThreads, v))
+}
+
+// FailedAttempts applies equality check predicate on the "failed_attempts" field. It's identical to FailedAttemptsEQ.
+func FailedAttempts(v int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldFailedAttempts, v))
+}
+
+// RetryAfter applies equality check predicate on the "retry_after" field. It's identical to RetryAfterEQ.
+func RetryAfter(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldRetryAfter, v))
+}
+
+// BackupVerifiedAt applies equality check predicate on the "backup_verified_at" field. It's identical to BackupVerifiedAtEQ.
+func BackupVerifiedAt(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldBackupVerifiedAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Wallet {
 	return predicate.Wallet(sql.FieldEQ(FieldCreatedAt, v))
@@ -160,6 +196,16 @@ func ActorIDHasSuffix(v string) predicate.Wallet {
 	return predicate.Wallet(sql.FieldHasSuffix(FieldActorID, v))
 }
 
+// ActorIDIsNil applies the IsNil predicate on the "actor_id" field.
+func ActorIDIsNil() predicate.Wallet {
+	return predicate.Wallet(sql.FieldIsNull(FieldActorID))
+}
+
+// ActorIDNotNil applies the NotNil predicate on the "actor_id" field.
+func ActorIDNotNil() predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotNull(FieldActorID))
+}
+
 // ActorIDEqualFold applies the EqualFold predicate on the "actor_id" field.
 func ActorIDEqualFold(v string) predicate.Wallet {
 	return predicate.Wallet(sql.FieldEqualFold(FieldActorID, v))
@@ -235,6 +281,36 @@ func NameContainsFold(v string) predicate.Wallet {
 	return predicate.Wallet(sql.FieldContainsFold(FieldName, v))
 }
 
+// DerivationSchemeEQ applies the EQ predicate on the "derivation_scheme" field.
+func DerivationSchemeEQ(v wallet.DerivationScheme) predicate.Wallet {
+	vc := v
+	return predicate.Wallet(sql.FieldEQ(FieldDerivationScheme, vc))
+}
+
+// DerivationSchemeNEQ applies the NEQ predicate on the "derivation_scheme" field.
+func DerivationSchemeNEQ(v wallet.DerivationScheme) predicate.Wallet {
+	vc := v
+	return predicate.Wallet(sql.FieldNEQ(FieldDerivationScheme, vc))
+}
+
+// DerivationSchemeIn applies the In predicate on the "derivation_scheme" field.
+func DerivationSchemeIn(vs ...wallet.DerivationScheme) predicate.Wallet {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Wallet(sql.FieldIn(FieldDerivationScheme, v...))
+}
+
+// DerivationSchemeNotIn applies the NotIn predicate on the "derivation_scheme" field.
+func DerivationSchemeNotIn(vs ...wallet.DerivationScheme) predicate.Wallet {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Wallet(sql.FieldNotIn(FieldDerivationScheme, v...))
+}
+
 // EncryptedSeedEQ applies the EQ predicate on the "encrypted_seed" field.
 func EncryptedSeedEQ(v []byte) predicate.Wallet {
 	return predicate.Wallet(sql.FieldEQ(FieldEncryptedSeed, v))
@@ -275,6 +351,66 @@ func EncryptedSeedLTE(v []byte) predicate.Wallet {
 	return predicate.Wallet(sql.FieldLTE(FieldEncryptedSeed, v))
 }
 
+// EncryptedSeedIsNil applies the IsNil predicate on the "encrypted_seed" field.
+func EncryptedSeedIsNil() predicate.Wallet {
+	return predicate.Wallet(sql.FieldIsNull(FieldEncryptedSeed))
+}
+
+// EncryptedSeedNotNil applies the NotNil predicate on the "encrypted_seed" field.
+func EncryptedSeedNotNil() predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotNull(FieldEncryptedSeed))
+}
+
+// EncryptedPassphraseEQ applies the EQ predicate on the "encrypted_passphrase" field.
+func EncryptedPassphraseEQ(v []byte) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldEncryptedPassphrase, v))
+}
+
+// EncryptedPassphraseNEQ applies the NEQ predicate on the "encrypted_passphrase" field.
+func EncryptedPassphraseNEQ(v []byte) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldEncryptedPassphrase, v))
+}
+
+// EncryptedPassphraseIn applies the In predicate on the "encrypted_passphrase" field.
+func EncryptedPassphraseIn(vs ...[]byte) predicate.Wallet {
+	return predicate.Wallet(sql.FieldIn(FieldEncryptedPassphrase, vs...))
+}
+
+// EncryptedPassphraseNotIn applies the NotIn predicate on the "encrypted_passphrase" field.
+func EncryptedPassphraseNotIn(vs ...[]byte) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotIn(FieldEncryptedPassphrase, vs...))
+}
+
+// EncryptedPassphraseGT applies the GT predicate on the "encrypted_passphrase" field.
+func EncryptedPassphraseGT(v []byte) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGT(FieldEncryptedPassphrase, v))
+}
+
+// EncryptedPassphraseGTE applies the GTE predicate on the "encrypted_passphrase" field.
+func EncryptedPassphraseGTE(v []byte) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGTE(FieldEncryptedPassphrase, v))
+}
+
+// EncryptedPassphraseLT applies the LT predicate on the "encrypted_passphrase" field.
+func EncryptedPassphraseLT(v []byte) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLT(FieldEncryptedPassphrase, v))
+}
+
+// EncryptedPassphraseLTE applies the LTE predicate on the "encrypted_passphrase" field.
+func EncryptedPassphraseLTE(v []byte) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLTE(FieldEncryptedPassphrase, v))
+}
+
+// EncryptedPassphraseIsNil applies the IsNil predicate on the "encrypted_passphrase" field.
+func EncryptedPassphraseIsNil() predicate.Wallet {
+	return predicate.Wallet(sql.FieldIsNull(FieldEncryptedPassphrase))
+}
+
+// EncryptedPassphraseNotNil applies the NotNil predicate on the "encrypted_passphrase" field.
+func EncryptedPassphraseNotNil() predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotNull(FieldEncryptedPassphrase))
+}
+
 // EncryptedKeyJSONEQ applies the EQ predicate on the "encrypted_key_json" field.
 func EncryptedKeyJSONEQ(v []byte) predicate.Wallet {
 	return predicate.Wallet(sql.FieldEQ(FieldEncryptedKeyJSON, v))
@@ -355,6 +491,296 @@ func SaltLTE(v []byte) predicate.Wallet {
 	return predicate.Wallet(sql.FieldLTE(FieldSalt, v))
 }
 
+// KdfAlgorithmEQ applies the EQ predicate on the "kdf_algorithm" field.
+func KdfAlgorithmEQ(v wallet.KDFAlgorithm) predicate.Wallet {
+	vc := v
+	return predicate.Wallet(sql.FieldEQ(FieldKdfAlgorithm, vc))
+}
+
+// KdfAlgorithmNEQ applies the NEQ predicate on the "kdf_algorithm" field.
+func KdfAlgorithmNEQ(v wallet.KDFAlgorithm) predicate.Wallet {
+	vc := v
+	return predicate.Wallet(sql.FieldNEQ(FieldKdfAlgorithm, vc))
+}
+
+// KdfAlgorithmIn applies the In predicate on the "kdf_algorithm" field.
+func KdfAlgorithmIn(vs ...wallet.KDFAlgorithm) predicate.Wallet {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Wallet(sql.FieldIn(FieldKdfAlgorithm, v...))
+}
+
+// KdfAlgorithmNotIn applies the NotIn predicate on the "kdf_algorithm" field.
+func KdfAlgorithmNotIn(vs ...wallet.KDFAlgorithm) predicate.Wallet {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Wallet(sql.FieldNotIn(FieldKdfAlgorithm, v...))
+}
+
+// KdfTimeEQ applies the EQ predicate on the "kdf_time" field.
+func KdfTimeEQ(v uint32) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldKdfTime, v))
+}
+
+// KdfTimeNEQ applies the NEQ predicate on the "kdf_time" field.
+func KdfTimeNEQ(v uint32) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldKdfTime, v))
+}
+
+// KdfTimeIn applies the In predicate on the "kdf_time" field.
+func KdfTimeIn(vs ...uint32) predicate.Wallet {
+	return predicate.Wallet(sql.FieldIn(FieldKdfTime, vs...))
+}
+
+// KdfTimeNotIn applies the NotIn predicate on the "kdf_time" field.
+func KdfTimeNotIn(vs ...uint32) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotIn(FieldKdfTime, vs...))
+}
+
+// KdfTimeGT applies the GT predicate on the "kdf_time" field.
+func KdfTimeGT(v uint32) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGT(FieldKdfTime, v))
+}
+
+// KdfTimeGTE applies the GTE predicate on the "kdf_time" field.
+func KdfTimeGTE(v uint32) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGTE(FieldKdfTime, v))
+}
+
+// KdfTimeLT applies the LT predicate on the "kdf_time" field.
+func KdfTimeLT(v uint32) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLT(FieldKdfTime, v))
+}
+
+// KdfTimeLTE applies the LTE predicate on the "kdf_time" field.
+func KdfTimeLTE(v uint32) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLTE(FieldKdfTime, v))
+}
+
+// KdfMemoryEQ applies the EQ predicate on the "kdf_memory" field.
+func KdfMemoryEQ(v uint32) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldKdfMemory, v))
+}
+
+// KdfMemoryNEQ applies the NEQ predicate on the "kdf_memory" field.
+func KdfMemoryNEQ(v uint32) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldKdfMemory, v))
+}
+
+// KdfMemoryIn applies the In predicate on the "kdf_memory" field.
+func KdfMemoryIn(vs ...uint32) predicate.Wallet {
+	return predicate.Wallet(sql.FieldIn(FieldKdfMemory, vs...))
+}
+
+// KdfMemoryNotIn applies the NotIn predicate on the "kdf_memory" field.
+func KdfMemoryNotIn(vs ...uint32) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotIn(FieldKdfMemory, vs...))
+}
+
+// KdfMemoryGT applies the GT predicate on the "kdf_memory" field.
+func KdfMemoryGT(v uint32) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGT(FieldKdfMemory, v))
+}
+
+// KdfMemoryGTE applies the GTE predicate on the "kdf_memory" field.
+func KdfMemoryGTE(v uint32) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGTE(FieldKdfMemory, v))
+}
+
+// KdfMemoryLT applies the LT predicate on the "kdf_memory" field.
+func KdfMemoryLT(v uint32) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLT(FieldKdfMemory, v))
+}
+
+// KdfMemoryLTE applies the LTE predicate on the "kdf_memory" field.
+func KdfMemoryLTE(v uint32) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLTE(FieldKdfMemory, v))
+}
+
+// KdfThreadsEQ applies the EQ predicate on the "kdf_threads" field.
+func KdfThreadsEQ(v uint8) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldKdfThreads, v))
+}
+
+// KdfThreadsNEQ applies the NEQ predicate on the "kdf_threads" field.
+func KdfThreadsNEQ(v uint8) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldKdfThreads, v))
+}
+
+// KdfThreadsIn applies the In predicate on the "kdf_threads" field.
+func KdfThreadsIn(vs ...uint8) predicate.Wallet {
+	return predicate.Wallet(sql.FieldIn(FieldKdfThreads, vs...))
+}
+
+// KdfThreadsNotIn applies the NotIn predicate on the "kdf_threads" field.
+func KdfThreadsNotIn(vs ...uint8) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotIn(FieldKdfThreads, vs...))
+}
+
+// KdfThreadsGT applies the GT predicate on the "kdf_threads" field.
+func KdfThreadsGT(v uint8) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGT(FieldKdfThreads, v))
+}
+
+// KdfThreadsGTE applies the GTE predicate on the "kdf_threads" field.
+func KdfThreadsGTE(v uint8) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGTE(FieldKdfThreads, v))
+}
+
+// KdfThreadsLT applies the LT predicate on the "kdf_threads" field.
+func KdfThreadsLT(v uint8) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLT(FieldKdfThreads, v))
+}
+
+// KdfThreadsLTE applies the LTE predicate on the "kdf_threads" field.
+func KdfThreadsLTE(v uint8) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLTE(FieldKdfThreads, v))
+}
+
+// FailedAttemptsEQ applies the EQ predicate on the "failed_attempts" field.
+func FailedAttemptsEQ(v int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldFailedAttempts, v))
+}
+
+// FailedAttemptsNEQ applies the NEQ predicate on the "failed_attempts" field.
+func FailedAttemptsNEQ(v int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldFailedAttempts, v))
+}
+
+// FailedAttemptsIn applies the In predicate on the "failed_attempts" field.
+func FailedAttemptsIn(vs ...int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldIn(FieldFailedAttempts, vs...))
+}
+
+// FailedAttemptsNotIn applies the NotIn predicate on the "failed_attempts" field.
+func FailedAttemptsNotIn(vs ...int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotIn(FieldFailedAttempts, vs...))
+}
+
+// FailedAttemptsGT applies the GT predicate on the "failed_attempts" field.
+func FailedAttemptsGT(v int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGT(FieldFailedAttempts, v))
+}
+
+// FailedAttemptsGTE applies the GTE predicate on the "failed_attempts" field.
+func FailedAttemptsGTE(v int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGTE(FieldFailedAttempts, v))
+}
+
+// FailedAttemptsLT applies the LT predicate on the "failed_attempts" field.
+func FailedAttemptsLT(v int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLT(FieldFailedAttempts, v))
+}
+
+// FailedAttemptsLTE applies the LTE predicate on the "failed_attempts" field.
+func FailedAttemptsLTE(v int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLTE(FieldFailedAttempts, v))
+}
+
+// RetryAfterEQ applies the EQ predicate on the "retry_after" field.
+func RetryAfterEQ(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldRetryAfter, v))
+}
+
+// RetryAfterNEQ applies the NEQ predicate on the "retry_after" field.
+func RetryAfterNEQ(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldRetryAfter, v))
+}
+
+// RetryAfterIn applies the In predicate on the "retry_after" field.
+func RetryAfterIn(vs ...time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldIn(FieldRetryAfter, vs...))
+}
+
+// RetryAfterNotIn applies the NotIn predicate on the "retry_after" field.
+func RetryAfterNotIn(vs ...time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotIn(FieldRetryAfter, vs...))
+}
+
+// RetryAfterGT applies the GT predicate on the "retry_after" field.
+func RetryAfterGT(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGT(FieldRetryAfter, v))
+}
+
+// RetryAfterGTE applies the GTE predicate on the "retry_after" field.
+func RetryAfterGTE(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGTE(FieldRetryAfter, v))
+}
+
+// RetryAfterLT applies the LT predicate on the "retry_after" field.
+func RetryAfterLT(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLT(FieldRetryAfter, v))
+}
+
+// RetryAfterLTE applies the LTE predicate on the "retry_after" field.
+func RetryAfterLTE(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLTE(FieldRetryAfter, v))
+}
+
+// RetryAfterIsNil applies the IsNil predicate on the "retry_after" field.
+func RetryAfterIsNil() predicate.Wallet {
+	return predicate.Wallet(sql.FieldIsNull(FieldRetryAfter))
+}
+
+// RetryAfterNotNil applies the NotNil predicate on the "retry_after" field.
+func RetryAfterNotNil() predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotNull(FieldRetryAfter))
+}
+
+// BackupVerifiedAtEQ applies the EQ predicate on the "backup_verified_at" field.
+func BackupVerifiedAtEQ(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldBackupVerifiedAt, v))
+}
+
+// BackupVerifiedAtNEQ applies the NEQ predicate on the "backup_verified_at" field.
+func BackupVerifiedAtNEQ(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldBackupVerifiedAt, v))
+}
+
+// BackupVerifiedAtIn applies the In predicate on the "backup_verified_at" field.
+func BackupVerifiedAtIn(vs ...time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldIn(FieldBackupVerifiedAt, vs...))
+}
+
+// BackupVerifiedAtNotIn applies the NotIn predicate on the "backup_verified_at" field.
+func BackupVerifiedAtNotIn(vs ...time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotIn(FieldBackupVerifiedAt, vs...))
+}
+
+// BackupVerifiedAtGT applies the GT predicate on the "backup_verified_at" field.
+func BackupVerifiedAtGT(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGT(FieldBackupVerifiedAt, v))
+}
+
+// BackupVerifiedAtGTE applies the GTE predicate on the "backup_verified_at" field.
+func BackupVerifiedAtGTE(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGTE(FieldBackupVerifiedAt, v))
+}
+
+// BackupVerifiedAtLT applies the LT predicate on the "backup_verified_at" field.
+func BackupVerifiedAtLT(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLT(FieldBackupVerifiedAt, v))
+}
+
+// BackupVerifiedAtLTE applies the LTE predicate on the "backup_verified_at" field.
+func BackupVerifiedAtLTE(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLTE(FieldBackupVerifiedAt, v))
+}
+
+// BackupVerifiedAtIsNil applies the IsNil predicate on the "backup_verified_at" field.
+func BackupVerifiedAtIsNil() predicate.Wallet {
+	return predicate.Wallet(sql.FieldIsNull(FieldBackupVerifiedAt))
+}
+
+// BackupVerifiedAtNotNil applies the NotNil predicate on the "backup_verified_at" field.
+func BackupVerifiedAtNotNil() predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotNull(FieldBackupVerifiedAt))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Wallet {
 	return predicate.Wallet(sql.FieldEQ(FieldCreatedAt, v))
@@ -435,6 +861,16 @@ func UpdatedAtLTE(v time.Time) predicate.Wallet {
 	return predicate.Wallet(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.Wallet {
+	return predicate.Wallet(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotNull(FieldUpdatedAt))
+}
+
 // HasAddresses applies the HasEdge predicate on the "addresses" edge.
 func HasAddresses() predicate.Wallet {
 	return predicate.Wallet(func(s *sql.Selector) {
@@ -11,7 +11,8 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	ormaddress "github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/address"
-	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/wallet"
+	ormwallet "github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/wallet"
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
 )
 
 // WalletCreate is the builder for creating a Wallet entity.
@@ -41,18 +42,46 @@ func (_c *WalletCreate) SetActorID(v string) *WalletCreate {
 	return _c
 }
 
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_c *WalletCreate) SetNillableActorID(v *string) *WalletCreate {
+	if v != nil {
+		_c.SetActorID(*v)
+	}
+	return _c
+}
+
 // SetName sets the "name" field.
 func (_c *WalletCreate) SetName(v string) *WalletCreate {
 	_c.mutation.SetName(v)
 	return _c
 }
 
+// SetDerivationScheme sets the "derivation_scheme" field.
+func (_c *WalletCreate) SetDerivationScheme(v wallet.DerivationScheme) *WalletCreate {
+	_c.mutation.SetDerivationScheme(v)
+	return _c
+}
+
+// SetNillableDerivationScheme sets the "derivation_scheme" field if the given value is not nil.
+func (_c *WalletCreate) SetNillableDerivationScheme(v *wallet.DerivationScheme) *WalletCreate {
+	if v != nil {
+		_c.SetDerivationScheme(*v)
+	}
+	return _c
+}
+
 // SetEncryptedSeed sets the "encrypted_seed" field.
 func (_c *WalletCreate) SetEncryptedSeed(v []byte) *WalletCreate {
 	_c.mutation.SetEncryptedSeed(v)
 	return _c
 }
 
+// SetEncryptedPassphrase sets the "encrypted_passphrase" field.
+func (_c *WalletCreate) SetEncryptedPassphrase(v []byte) *WalletCreate {
+	_c.mutation.SetEncryptedPassphrase(v)
+	return _c
+}
+
 // SetEncryptedKeyJSON sets the "encrypted_key_json" field.
 func (_c *WalletCreate) SetEncryptedKeyJSON(v []byte) *WalletCreate {
 	_c.mutation.SetEncryptedKeyJSON(v)
@@ -65,6 +94,104 @@ func (_c *WalletCreate) SetSalt(v []byte) *WalletCreate {
 	return _c
 }
 
+// SetKdfAlgorithm sets the "kdf_algorithm" field.
+func (_c *WalletCreate) SetKdfAlgorithm(v wallet.KDFAlgorithm) *WalletCreate {
+	_c.mutation.SetKdfAlgorithm(v)
+	return _c
+}
+
+// SetNillableKdfAlgorithm sets the "kdf_algorithm" field if the given value is not nil.
+func (_c *WalletCreate) SetNillableKdfAlgorithm(v *wallet.KDFAlgorithm) *WalletCreate {
+	if v != nil {
+		_c.SetKdfAlgorithm(*v)
+	}
+	return _c
+}
+
+// SetKdfTime sets the "kdf_time" field.
+func (_c *WalletCreate) SetKdfTime(v uint32) *WalletCreate {
+	_c.mutation.SetKdfTime(v)
+	return _c
+}
+
+// SetNillableKdfTime sets the "kdf_time" field if the given value is not nil.
+func (_c *WalletCreate) SetNillableKdfTime(v *uint32) *WalletCreate {
+	if v != nil {
+		_c.SetKdfTime(*v)
+	}
+	return _c
+}
+
+// SetKdfMemory sets the "kdf_memory" field.
+func (_c *WalletCreate) SetKdfMemory(v uint32) *WalletCreate {
+	_c.mutation.SetKdfMemory(v)
+	return _c
+}
+
+// SetNillableKdfMemory sets the "kdf_memory" field if the given value is not nil.
+func (_c *WalletCreate) SetNillableKdfMemory(v *uint32) *WalletCreate {
+	if v != nil {
+		_c.SetKdfMemory(*v)
+	}
+	return _c
+}
+
+// SetKdfThreads sets the "kdf_threads" field.
+func (_c *WalletCreate) SetKdfThreads(v uint8) *WalletCreate {
+	_c.mutation.SetKdfThreads(v)
+	return _c
+}
+
+// SetNillableKdfThreads sets the "kdf_threads" field if the given value is not nil.
+func (_c *WalletCreate) SetNillableKdfThreads(v *uint8) *WalletCreate {
+	if v != nil {
+		_c.SetKdfThreads(*v)
+	}
+	return _c
+}
+
+// SetFailedAttempts sets the "failed_attempts" field.
+func (_c *WalletCreate) SetFailedAttempts(v int) *WalletCreate {
+	_c.mutation.SetFailedAttempts(v)
+	return _c
+}
+
+// SetNillableFailedAttempts sets the "failed_attempts" field if the given value is not nil.
+func (_c *WalletCreate) SetNillableFailedAttempts(v *int) *WalletCreate {
+	if v != nil {
+		_c.SetFailedAttempts(*v)
+	}
+	return _c
+}
+
+// SetRetryAfter sets the "retry_after" field.
+func (_c *WalletCreate) SetRetryAfter(v time.Time) *WalletCreate {
+	_c.mutation.SetRetryAfter(v)
+	return _c
+}
+
+// SetNillableRetryAfter sets the "retry_after" field if the given value is not nil.
+func (_c *WalletCreate) SetNillableRetryAfter(v *time.Time) *WalletCreate {
+	if v != nil {
+		_c.SetRetryAfter(*v)
+	}
+	return _c
+}
+
+// SetBackupVerifiedAt sets the "backup_verified_at" field.
+func (_c *WalletCreate) SetBackupVerifiedAt(v time.Time) *WalletCreate {
+	_c.mutation.SetBackupVerifiedAt(v)
+	return _c
+}
+
+// SetNillableBackupVerifiedAt sets the "backup_verified_at" field if the given value is not nil.
+func (_c *WalletCreate) SetNillableBackupVerifiedAt(v *time.Time) *WalletCreate {
+	if v != nil {
+		_c.SetBackupVerifiedAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *WalletCreate) SetCreatedAt(v time.Time) *WalletCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -85,6 +212,14 @@ func (_c *WalletCreate) SetUpdatedAt(v time.Time) *WalletCreate {
 	return _c
 }
 
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *WalletCreate) SetNillableUpdatedAt(v *time.Time) *WalletCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
 // AddAddressIDs adds the "addresses" edge to the Address entity by IDs.
 func (_c *WalletCreate) AddAddressIDs(ids ...int) *WalletCreate {
 	_c.mutation.AddAddressIDs(ids...)
@@ -136,11 +271,35 @@ func (_c *WalletCreate) ExecX(ctx context.Context) {
 // defaults sets the default values of the builder before save.
 func (_c *WalletCreate) defaults() {
 	if _, ok := _c.mutation.IsDefault(); !ok {
-		v := wallet.DefaultIsDefault
+		v := ormwallet.DefaultIsDefault
 		_c.mutation.SetIsDefault(v)
 	}
+	if _, ok := _c.mutation.DerivationScheme(); !ok {
+		v := ormwallet.DefaultDerivationScheme
+		_c.mutation.SetDerivationScheme(v)
+	}
+	if _, ok := _c.mutation.KdfAlgorithm(); !ok {
+		v := ormwallet.DefaultKdfAlgorithm
+		_c.mutation.SetKdfAlgorithm(v)
+	}
+	if _, ok := _c.mutation.KdfTime(); !ok {
+		v := ormwallet.DefaultKdfTime
+		_c.mutation.SetKdfTime(v)
+	}
+	if _, ok := _c.mutation.KdfMemory(); !ok {
+		v := ormwallet.DefaultKdfMemory
+		_c.mutation.SetKdfMemory(v)
+	}
+	if _, ok := _c.mutation.KdfThreads(); !ok {
+		v := ormwallet.DefaultKdfThreads
+		_c.mutation.SetKdfThreads(v)
+	}
+	if _, ok := _c.mutation.FailedAttempts(); !ok {
+		v := ormwallet.DefaultFailedAttempts
+		_c.mutation.SetFailedAttempts(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
-		v := wallet.DefaultCreatedAt()
+		v := ormwallet.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 }
@@ -150,30 +309,27 @@ func (_c *WalletCreate) check() error {
 	if _, ok := _c.mutation.IsDefault(); !ok {
 		return &ValidationError{Name: "is_default", err: errors.New(`orm: missing required field "Wallet.is_default"`)}
 	}
-	if _, ok := _c.mutation.ActorID(); !ok {
-		return &ValidationError{Name: "actor_id", err: errors.New(`orm: missing required field "Wallet.actor_id"`)}
-	}
 	if _, ok := _c.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`orm: missing required field "Wallet.name"`)}
 	}
 	if v, ok := _c.mutation.Name(); ok {
-		if err := wallet.NameValidator(v); err != nil {
+		if err := ormwallet.NameValidator(v); err != nil {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`orm: validator failed for field "Wallet.name": %w`, err)}
 		}
 	}
-	if _, ok := _c.mutation.EncryptedSeed(); !ok {
-		return &ValidationError{Name: "encrypted_seed", err: errors.New(`orm: missing required field "Wallet.encrypted_seed"`)}
+	if _, ok := _c.mutation.DerivationScheme(); !ok {
+		return &ValidationError{Name: "derivation_scheme", err: errors.New(`orm: missing required field "Wallet.derivation_scheme"`)}
 	}
-	if v, ok := _c.mutation.EncryptedSeed(); ok {
-		if err := wallet.EncryptedSeedValidator(v); err != nil {
-			return &ValidationError{Name: "encrypted_seed", err: fmt.Errorf(`orm: validator failed for field "Wallet.encrypted_seed": %w`, err)}
+	if v, ok := _c.mutation.DerivationScheme(); ok {
+		if err := ormwallet.DerivationSchemeValidator(v); err != nil {
+			return &ValidationError{Name: "derivation_scheme", err: fmt.Errorf(`orm: validator failed for field "Wallet.derivation_scheme": %w`, err)}
 		}
 	}
 	if _, ok := _c.mutation.EncryptedKeyJSON(); !ok {
 		return &ValidationError{Name: "encrypted_key_json", err: errors.New(`orm: missing required field "Wallet.encrypted_key_json"`)}
 	}
 	if v, ok := _c.mutation.EncryptedKeyJSON(); ok {
-		if err := wallet.EncryptedKeyJSONValidator(v); err != nil {
+		if err := ormwallet.EncryptedKeyJSONValidator(v); err != nil {
 			return &ValidationError{Name: "encrypted_key_json", err: fmt.Errorf(`orm: validator failed for field "Wallet.encrypted_key_json": %w`, err)}
 		}
 	}
@@ -181,16 +337,33 @@ func (_c *WalletCreate) check() error {
 		return &ValidationError{Name: "salt", err: errors.New(`orm: missing required field "Wallet.salt"`)}
 	}
 	if v, ok := _c.mutation.Salt(); ok {
-		if err := wallet.SaltValidator(v); err != nil {
+		if err := ormwallet.SaltValidator(v); err != nil {
 			return &ValidationError{Name: "salt", err: fmt.Errorf(`orm: validator failed for field "Wallet.salt": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.KdfAlgorithm(); !ok {
+		return &ValidationError{Name: "kdf_algorithm", err: errors.New(`orm: missing required field "Wallet.kdf_algorithm"`)}
+	}
+	if v, ok := _c.mutation.KdfAlgorithm(); ok {
+		if err := ormwallet.KdfAlgorithmValidator(v); err != nil {
+			return &ValidationError{Name: "kdf_algorithm", err: fmt.Errorf(`orm: validator failed for field "Wallet.kdf_algorithm": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.KdfTime(); !ok {
+		return &ValidationError{Name: "kdf_time", err: errors.New(`orm: missing required field "Wallet.kdf_time"`)}
+	}
+	if _, ok := _c.mutation.KdfMemory(); !ok {
+		return &ValidationError{Name: "kdf_memory", err: errors.New(`orm: missing required field "Wallet.kdf_memory"`)}
+	}
+	if _, ok := _c.mutation.KdfThreads(); !ok {
+		return &ValidationError{Name: "kdf_threads", err: errors.New(`orm: missing required field "Wallet.kdf_threads"`)}
+	}
+	if _, ok := _c.mutation.FailedAttempts(); !ok {
+		return &ValidationError{Name: "failed_attempts", err: errors.New(`orm: missing required field "Wallet.failed_attempts"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`orm: missing required field "Wallet.created_at"`)}
 	}
-	if _, ok := _c.mutation.UpdatedAt(); !ok {
-		return &ValidationError{Name: "updated_at", err: errors.New(`orm: missing required field "Wallet.updated_at"`)}
-	}
 	return nil
 }
 
@@ -215,46 +388,82 @@ func (_c *WalletCreate) sqlSave(ctx context.Context) (*Wallet, error) {
 func (_c *WalletCreate) createSpec() (*Wallet, *sqlgraph.CreateSpec) {
 	var (
 		_node = &Wallet{config: _c.config}
-		_spec = sqlgraph.NewCreateSpec(wallet.Table, sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeInt))
+		_spec = sqlgraph.NewCreateSpec(ormwallet.Table, sqlgraph.NewFieldSpec(ormwallet.FieldID, field.TypeInt))
 	)
 	if value, ok := _c.mutation.IsDefault(); ok {
-		_spec.SetField(wallet.FieldIsDefault, field.TypeBool, value)
+		_spec.SetField(ormwallet.FieldIsDefault, field.TypeBool, value)
 		_node.IsDefault = value
 	}
 	if value, ok := _c.mutation.ActorID(); ok {
-		_spec.SetField(wallet.FieldActorID, field.TypeString, value)
+		_spec.SetField(ormwallet.FieldActorID, field.TypeString, value)
 		_node.ActorID = &value
 	}
 	if value, ok := _c.mutation.Name(); ok {
-		_spec.SetField(wallet.FieldName, field.TypeString, value)
+		_spec.SetField(ormwallet.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := _c.mutation.DerivationScheme(); ok {
+		_spec.SetField(ormwallet.FieldDerivationScheme, field.TypeEnum, value)
+		_node.DerivationScheme = value
+	}
 	if value, ok := _c.mutation.EncryptedSeed(); ok {
-		_spec.SetField(wallet.FieldEncryptedSeed, field.TypeBytes, value)
+		_spec.SetField(ormwallet.FieldEncryptedSeed, field.TypeBytes, value)
 		_node.EncryptedSeed = value
 	}
+	if value, ok := _c.mutation.EncryptedPassphrase(); ok {
+		_spec.SetField(ormwallet.FieldEncryptedPassphrase, field.TypeBytes, value)
+		_node.EncryptedPassphrase = value
+	}
 	if value, ok := _c.mutation.EncryptedKeyJSON(); ok {
-		_spec.SetField(wallet.FieldEncryptedKeyJSON, field.TypeBytes, value)
+		_spec.SetField(ormwallet.FieldEncryptedKeyJSON, field.TypeBytes, value)
 		_node.EncryptedKeyJSON = value
 	}
 	if value, ok := _c.mutation.Salt(); ok {
-		_spec.SetField(wallet.FieldSalt, field.TypeBytes, value)
+		_spec.SetField(ormwallet.FieldSalt, field.TypeBytes, value)
 		_node.Salt = value
 	}
+	if value, ok := _c.mutation.KdfAlgorithm(); ok {
+		_spec.SetField(ormwallet.FieldKdfAlgorithm, field.TypeEnum, value)
+		_node.KdfAlgorithm = value
+	}
+	if value, ok := _c.mutation.KdfTime(); ok {
+		_spec.SetField(ormwallet.FieldKdfTime, field.TypeUint32, value)
+		_node.KdfTime = value
+	}
+	if value, ok := _c.mutation.KdfMemory(); ok {
+		_spec.SetField(ormwallet.FieldKdfMemory, field.TypeUint32, value)
+		_node.KdfMemory = value
+	}
+	if value, ok := _c.mutation.KdfThreads(); ok {
+		_spec.SetField(ormwallet.FieldKdfThreads, field.TypeUint8, value)
+		_node.KdfThreads = value
+	}
+	if value, ok := _c.mutation.FailedAttempts(); ok {
+		_spec.SetField(ormwallet.FieldFailedAttempts, field.TypeInt, value)
+		_node.FailedAttempts = value
+	}
+	if value, ok := _c.mutation.RetryAfter(); ok {
+		_spec.SetField(ormwallet.FieldRetryAfter, field.TypeTime, value)
+		_node.RetryAfter = &value
+	}
+	if value, ok := _c.mutation.BackupVerifiedAt(); ok {
+		_spec.SetField(ormwallet.FieldBackupVerifiedAt, field.TypeTime, value)
+		_node.BackupVerifiedAt = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
-		_spec.SetField(wallet.FieldCreatedAt, field.TypeTime, value)
+		_spec.SetField(ormwallet.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
 	if value, ok := _c.mutation.UpdatedAt(); ok {
-		_spec.SetField(wallet.FieldUpdatedAt, field.TypeTime, value)
+		_spec.SetField(ormwallet.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = &value
 	}
 	if nodes := _c.mutation.AddressesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   wallet.AddressesTable,
-			Columns: []string{wallet.AddressesColumn},
+			Table:   ormwallet.AddressesTable,
+			Columns: []string{ormwallet.AddressesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(ormaddress.FieldID, field.TypeInt),
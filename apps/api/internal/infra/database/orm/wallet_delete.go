@@ -9,7 +9,8 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/predicate"
-	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/wallet"
+
+	ormwallet "github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/wallet"
 )
 
 // WalletDelete is the builder for deleting a Wallet entity.
@@ -40,7 +41,7 @@ func (_d *WalletDelete) ExecX(ctx context.Context) int {
 }
 
 func (_d *WalletDelete) sqlExec(ctx context.Context) (int, error) {
-	_spec := sqlgraph.NewDeleteSpec(wallet.Table, sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeInt))
+	_spec := sqlgraph.NewDeleteSpec(ormwallet.Table, sqlgraph.NewFieldSpec(ormwallet.FieldID, field.TypeInt))
 	if ps := _d.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -74,7 +75,7 @@ func (_d *WalletDeleteOne) Exec(ctx context.Context) error {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{wallet.Label}
+		return &NotFoundError{ormwallet.Label}
 	default:
 		return nil
 	}
@@ -14,14 +14,14 @@ import (
 	"entgo.io/ent/schema/field"
 	ormaddress "github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/address"
 	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/predicate"
-	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/wallet"
+	ormwallet "github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/wallet"
 )
 
 // WalletQuery is the builder for querying Wallet entities.
 type WalletQuery struct {
 	config
 	ctx           *QueryContext
-	order         []wallet.OrderOption
+	order         []ormwallet.OrderOption
 	inters        []Interceptor
 	predicates    []predicate.Wallet
 	withAddresses *AddressQuery
@@ -56,7 +56,7 @@ func (_q *WalletQuery) Unique(unique bool) *WalletQuery {
 }
 
 // Order specifies how the records should be ordered.
-func (_q *WalletQuery) Order(o ...wallet.OrderOption) *WalletQuery {
+func (_q *WalletQuery) Order(o ...ormwallet.OrderOption) *WalletQuery {
 	_q.order = append(_q.order, o...)
 	return _q
 }
@@ -73,9 +73,9 @@ func (_q *WalletQuery) QueryAddresses() *AddressQuery {
 			return nil, err
 		}
 		step := sqlgraph.NewStep(
-			sqlgraph.From(wallet.Table, wallet.FieldID, selector),
+			sqlgraph.From(ormwallet.Table, ormwallet.FieldID, selector),
 			sqlgraph.To(ormaddress.Table, ormaddress.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, wallet.AddressesTable, wallet.AddressesColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, ormwallet.AddressesTable, ormwallet.AddressesColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
 		return fromU, nil
@@ -91,7 +91,7 @@ func (_q *WalletQuery) First(ctx context.Context) (*Wallet, error) {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{wallet.Label}
+		return nil, &NotFoundError{ormwallet.Label}
 	}
 	return nodes[0], nil
 }
@@ -113,7 +113,7 @@ func (_q *WalletQuery) FirstID(ctx context.Context) (id int, err error) {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{wallet.Label}
+		err = &NotFoundError{ormwallet.Label}
 		return
 	}
 	return ids[0], nil
@@ -140,9 +140,9 @@ func (_q *WalletQuery) Only(ctx context.Context) (*Wallet, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{wallet.Label}
+		return nil, &NotFoundError{ormwallet.Label}
 	default:
-		return nil, &NotSingularError{wallet.Label}
+		return nil, &NotSingularError{ormwallet.Label}
 	}
 }
 
@@ -167,9 +167,9 @@ func (_q *WalletQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{wallet.Label}
+		err = &NotFoundError{ormwallet.Label}
 	default:
-		err = &NotSingularError{wallet.Label}
+		err = &NotSingularError{ormwallet.Label}
 	}
 	return
 }
@@ -208,7 +208,7 @@ func (_q *WalletQuery) IDs(ctx context.Context) (ids []int, err error) {
 		_q.Unique(true)
 	}
 	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
-	if err = _q.Select(wallet.FieldID).Scan(ctx, &ids); err != nil {
+	if err = _q.Select(ormwallet.FieldID).Scan(ctx, &ids); err != nil {
 		return nil, err
 	}
 	return ids, nil
@@ -272,7 +272,7 @@ func (_q *WalletQuery) Clone() *WalletQuery {
 	return &WalletQuery{
 		config:        _q.config,
 		ctx:           _q.ctx.Clone(),
-		order:         append([]wallet.OrderOption{}, _q.order...),
+		order:         append([]ormwallet.OrderOption{}, _q.order...),
 		inters:        append([]Interceptor{}, _q.inters...),
 		predicates:    append([]predicate.Wallet{}, _q.predicates...),
 		withAddresses: _q.withAddresses.Clone(),
@@ -304,14 +304,14 @@ func (_q *WalletQuery) WithAddresses(opts ...func(*AddressQuery)) *WalletQuery {
 //	}
 //
 //	client.Wallet.Query().
-//		GroupBy(wallet.FieldIsDefault).
+//		GroupBy(ormwallet.FieldIsDefault).
 //		Aggregate(orm.Count()).
 //		Scan(ctx, &v)
 func (_q *WalletQuery) GroupBy(field string, fields ...string) *WalletGroupBy {
 	_q.ctx.Fields = append([]string{field}, fields...)
 	grbuild := &WalletGroupBy{build: _q}
 	grbuild.flds = &_q.ctx.Fields
-	grbuild.label = wallet.Label
+	grbuild.label = ormwallet.Label
 	grbuild.scan = grbuild.Scan
 	return grbuild
 }
@@ -326,12 +326,12 @@ func (_q *WalletQuery) GroupBy(field string, fields ...string) *WalletGroupBy {
 //	}
 //
 //	client.Wallet.Query().
-//		Select(wallet.FieldIsDefault).
+//		Select(ormwallet.FieldIsDefault).
 //		Scan(ctx, &v)
 func (_q *WalletQuery) Select(fields ...string) *WalletSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
 	sbuild := &WalletSelect{WalletQuery: _q}
-	sbuild.label = wallet.Label
+	sbuild.label = ormwallet.Label
 	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
 	return sbuild
 }
@@ -353,7 +353,7 @@ func (_q *WalletQuery) prepareQuery(ctx context.Context) error {
 		}
 	}
 	for _, f := range _q.ctx.Fields {
-		if !wallet.ValidColumn(f) {
+		if !ormwallet.ValidColumn(f) {
 			return &ValidationError{Name: f, err: fmt.Errorf("orm: invalid field %q for query", f)}
 		}
 	}
@@ -415,7 +415,7 @@ func (_q *WalletQuery) loadAddresses(ctx context.Context, query *AddressQuery, n
 	}
 	query.withFKs = true
 	query.Where(predicate.Address(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(wallet.AddressesColumn), fks...))
+		s.Where(sql.InValues(s.C(ormwallet.AddressesColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
@@ -445,7 +445,7 @@ func (_q *WalletQuery) sqlCount(ctx context.Context) (int, error) {
 }
 
 func (_q *WalletQuery) querySpec() *sqlgraph.QuerySpec {
-	_spec := sqlgraph.NewQuerySpec(wallet.Table, wallet.Columns, sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeInt))
+	_spec := sqlgraph.NewQuerySpec(ormwallet.Table, ormwallet.Columns, sqlgraph.NewFieldSpec(ormwallet.FieldID, field.TypeInt))
 	_spec.From = _q.sql
 	if unique := _q.ctx.Unique; unique != nil {
 		_spec.Unique = *unique
@@ -454,9 +454,9 @@ func (_q *WalletQuery) querySpec() *sqlgraph.QuerySpec {
 	}
 	if fields := _q.ctx.Fields; len(fields) > 0 {
 		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, wallet.FieldID)
+		_spec.Node.Columns = append(_spec.Node.Columns, ormwallet.FieldID)
 		for i := range fields {
-			if fields[i] != wallet.FieldID {
+			if fields[i] != ormwallet.FieldID {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
@@ -486,10 +486,10 @@ func (_q *WalletQuery) querySpec() *sqlgraph.QuerySpec {
 
 func (_q *WalletQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	builder := sql.Dialect(_q.driver.Dialect())
-	t1 := builder.Table(wallet.Table)
+	t1 := builder.Table(ormwallet.Table)
 	columns := _q.ctx.Fields
 	if len(columns) == 0 {
-		columns = wallet.Columns
+		columns = ormwallet.Columns
 	}
 	selector := builder.Select(t1.Columns(columns...)...).From(t1)
 	if _q.sql != nil {
@@ -13,7 +13,8 @@ import (
 	"entgo.io/ent/schema/field"
 	ormaddress "github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/address"
 	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/predicate"
-	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/wallet"
+	ormwallet "github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/wallet"
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
 )
 
 // WalletUpdate is the builder for updating Wallet entities.
@@ -57,6 +58,12 @@ func (_u *WalletUpdate) SetNillableActorID(v *string) *WalletUpdate {
 	return _u
 }
 
+// ClearActorID clears the value of the "actor_id" field.
+func (_u *WalletUpdate) ClearActorID() *WalletUpdate {
+	_u.mutation.ClearActorID()
+	return _u
+}
+
 // SetName sets the "name" field.
 func (_u *WalletUpdate) SetName(v string) *WalletUpdate {
 	_u.mutation.SetName(v)
@@ -71,12 +78,44 @@ func (_u *WalletUpdate) SetNillableName(v *string) *WalletUpdate {
 	return _u
 }
 
+// SetDerivationScheme sets the "derivation_scheme" field.
+func (_u *WalletUpdate) SetDerivationScheme(v wallet.DerivationScheme) *WalletUpdate {
+	_u.mutation.SetDerivationScheme(v)
+	return _u
+}
+
+// SetNillableDerivationScheme sets the "derivation_scheme" field if the given value is not nil.
+func (_u *WalletUpdate) SetNillableDerivationScheme(v *wallet.DerivationScheme) *WalletUpdate {
+	if v != nil {
+		_u.SetDerivationScheme(*v)
+	}
+	return _u
+}
+
 // SetEncryptedSeed sets the "encrypted_seed" field.
 func (_u *WalletUpdate) SetEncryptedSeed(v []byte) *WalletUpdate {
 	_u.mutation.SetEncryptedSeed(v)
 	return _u
 }
 
+// ClearEncryptedSeed clears the value of the "encrypted_seed" field.
+func (_u *WalletUpdate) ClearEncryptedSeed() *WalletUpdate {
+	_u.mutation.ClearEncryptedSeed()
+	return _u
+}
+
+// SetEncryptedPassphrase sets the "encrypted_passphrase" field.
+func (_u *WalletUpdate) SetEncryptedPassphrase(v []byte) *WalletUpdate {
+	_u.mutation.SetEncryptedPassphrase(v)
+	return _u
+}
+
+// ClearEncryptedPassphrase clears the value of the "encrypted_passphrase" field.
+func (_u *WalletUpdate) ClearEncryptedPassphrase() *WalletUpdate {
+	_u.mutation.ClearEncryptedPassphrase()
+	return _u
+}
+
 // SetEncryptedKeyJSON sets the "encrypted_key_json" field.
 func (_u *WalletUpdate) SetEncryptedKeyJSON(v []byte) *WalletUpdate {
 	_u.mutation.SetEncryptedKeyJSON(v)
@@ -89,6 +128,144 @@ func (_u *WalletUpdate) SetSalt(v []byte) *WalletUpdate {
 	return _u
 }
 
+// SetKdfAlgorithm sets the "kdf_algorithm" field.
+func (_u *WalletUpdate) SetKdfAlgorithm(v wallet.KDFAlgorithm) *WalletUpdate {
+	_u.mutation.SetKdfAlgorithm(v)
+	return _u
+}
+
+// SetNillableKdfAlgorithm sets the "kdf_algorithm" field if the given value is not nil.
+func (_u *WalletUpdate) SetNillableKdfAlgorithm(v *wallet.KDFAlgorithm) *WalletUpdate {
+	if v != nil {
+		_u.SetKdfAlgorithm(*v)
+	}
+	return _u
+}
+
+// SetKdfTime sets the "kdf_time" field.
+func (_u *WalletUpdate) SetKdfTime(v uint32) *WalletUpdate {
+	_u.mutation.ResetKdfTime()
+	_u.mutation.SetKdfTime(v)
+	return _u
+}
+
+// SetNillableKdfTime sets the "kdf_time" field if the given value is not nil.
+func (_u *WalletUpdate) SetNillableKdfTime(v *uint32) *WalletUpdate {
+	if v != nil {
+		_u.SetKdfTime(*v)
+	}
+	return _u
+}
+
+// AddKdfTime adds value to the "kdf_time" field.
+func (_u *WalletUpdate) AddKdfTime(v int32) *WalletUpdate {
+	_u.mutation.AddKdfTime(v)
+	return _u
+}
+
+// SetKdfMemory sets the "kdf_memory" field.
+func (_u *WalletUpdate) SetKdfMemory(v uint32) *WalletUpdate {
+	_u.mutation.ResetKdfMemory()
+	_u.mutation.SetKdfMemory(v)
+	return _u
+}
+
+// SetNillableKdfMemory sets the "kdf_memory" field if the given value is not nil.
+func (_u *WalletUpdate) SetNillableKdfMemory(v *uint32) *WalletUpdate {
+	if v != nil {
+		_u.SetKdfMemory(*v)
+	}
+	return _u
+}
+
+// AddKdfMemory adds value to the "kdf_memory" field.
+func (_u *WalletUpdate) AddKdfMemory(v int32) *WalletUpdate {
+	_u.mutation.AddKdfMemory(v)
+	return _u
+}
+
+// SetKdfThreads sets the "kdf_threads" field.
+func (_u *WalletUpdate) SetKdfThreads(v uint8) *WalletUpdate {
+	_u.mutation.ResetKdfThreads()
+	_u.mutation.SetKdfThreads(v)
+	return _u
+}
+
+// SetNillableKdfThreads sets the "kdf_threads" field if the given value is not nil.
+func (_u *WalletUpdate) SetNillableKdfThreads(v *uint8) *WalletUpdate {
+	if v != nil {
+		_u.SetKdfThreads(*v)
+	}
+	return _u
+}
+
+// AddKdfThreads adds value to the "kdf_threads" field.
+func (_u *WalletUpdate) AddKdfThreads(v int8) *WalletUpdate {
+	_u.mutation.AddKdfThreads(v)
+	return _u
+}
+
+// SetFailedAttempts sets the "failed_attempts" field.
+func (_u *WalletUpdate) SetFailedAttempts(v int) *WalletUpdate {
+	_u.mutation.ResetFailedAttempts()
+	_u.mutation.SetFailedAttempts(v)
+	return _u
+}
+
+// SetNillableFailedAttempts sets the "failed_attempts" field if the given value is not nil.
+func (_u *WalletUpdate) SetNillableFailedAttempts(v *int) *WalletUpdate {
+	if v != nil {
+		_u.SetFailedAttempts(*v)
+	}
+	return _u
+}
+
+// AddFailedAttempts adds value to the "failed_attempts" field.
+func (_u *WalletUpdate) AddFailedAttempts(v int) *WalletUpdate {
+	_u.mutation.AddFailedAttempts(v)
+	return _u
+}
+
+// SetRetryAfter sets the "retry_after" field.
+func (_u *WalletUpdate) SetRetryAfter(v time.Time) *WalletUpdate {
+	_u.mutation.SetRetryAfter(v)
+	return _u
+}
+
+// SetNillableRetryAfter sets the "retry_after" field if the given value is not nil.
+func (_u *WalletUpdate) SetNillableRetryAfter(v *time.Time) *WalletUpdate {
+	if v != nil {
+		_u.SetRetryAfter(*v)
+	}
+	return _u
+}
+
+// ClearRetryAfter clears the value of the "retry_after" field.
+func (_u *WalletUpdate) ClearRetryAfter() *WalletUpdate {
+	_u.mutation.ClearRetryAfter()
+	return _u
+}
+
+// SetBackupVerifiedAt sets the "backup_verified_at" field.
+func (_u *WalletUpdate) SetBackupVerifiedAt(v time.Time) *WalletUpdate {
+	_u.mutation.SetBackupVerifiedAt(v)
+	return _u
+}
+
+// SetNillableBackupVerifiedAt sets the "backup_verified_at" field if the given value is not nil.
+func (_u *WalletUpdate) SetNillableBackupVerifiedAt(v *time.Time) *WalletUpdate {
+	if v != nil {
+		_u.SetBackupVerifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearBackupVerifiedAt clears the value of the "backup_verified_at" field.
+func (_u *WalletUpdate) ClearBackupVerifiedAt() *WalletUpdate {
+	_u.mutation.ClearBackupVerifiedAt()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *WalletUpdate) SetCreatedAt(v time.Time) *WalletUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -117,6 +294,12 @@ func (_u *WalletUpdate) SetNillableUpdatedAt(v *time.Time) *WalletUpdate {
 	return _u
 }
 
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *WalletUpdate) ClearUpdatedAt() *WalletUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
 // AddAddressIDs adds the "addresses" edge to the Address entity by IDs.
 func (_u *WalletUpdate) AddAddressIDs(ids ...int) *WalletUpdate {
 	_u.mutation.AddAddressIDs(ids...)
@@ -188,25 +371,30 @@ func (_u *WalletUpdate) ExecX(ctx context.Context) {
 // check runs all checks and user-defined validators on the builder.
 func (_u *WalletUpdate) check() error {
 	if v, ok := _u.mutation.Name(); ok {
-		if err := wallet.NameValidator(v); err != nil {
+		if err := ormwallet.NameValidator(v); err != nil {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`orm: validator failed for field "Wallet.name": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.EncryptedSeed(); ok {
-		if err := wallet.EncryptedSeedValidator(v); err != nil {
-			return &ValidationError{Name: "encrypted_seed", err: fmt.Errorf(`orm: validator failed for field "Wallet.encrypted_seed": %w`, err)}
+	if v, ok := _u.mutation.DerivationScheme(); ok {
+		if err := ormwallet.DerivationSchemeValidator(v); err != nil {
+			return &ValidationError{Name: "derivation_scheme", err: fmt.Errorf(`orm: validator failed for field "Wallet.derivation_scheme": %w`, err)}
 		}
 	}
 	if v, ok := _u.mutation.EncryptedKeyJSON(); ok {
-		if err := wallet.EncryptedKeyJSONValidator(v); err != nil {
+		if err := ormwallet.EncryptedKeyJSONValidator(v); err != nil {
 			return &ValidationError{Name: "encrypted_key_json", err: fmt.Errorf(`orm: validator failed for field "Wallet.encrypted_key_json": %w`, err)}
 		}
 	}
 	if v, ok := _u.mutation.Salt(); ok {
-		if err := wallet.SaltValidator(v); err != nil {
+		if err := ormwallet.SaltValidator(v); err != nil {
 			return &ValidationError{Name: "salt", err: fmt.Errorf(`orm: validator failed for field "Wallet.salt": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.KdfAlgorithm(); ok {
+		if err := ormwallet.KdfAlgorithmValidator(v); err != nil {
+			return &ValidationError{Name: "kdf_algorithm", err: fmt.Errorf(`orm: validator failed for field "Wallet.kdf_algorithm": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -214,7 +402,7 @@ func (_u *WalletUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
 	}
-	_spec := sqlgraph.NewUpdateSpec(wallet.Table, wallet.Columns, sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeInt))
+	_spec := sqlgraph.NewUpdateSpec(ormwallet.Table, ormwallet.Columns, sqlgraph.NewFieldSpec(ormwallet.FieldID, field.TypeInt))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -223,35 +411,92 @@ func (_u *WalletUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 	}
 	if value, ok := _u.mutation.IsDefault(); ok {
-		_spec.SetField(wallet.FieldIsDefault, field.TypeBool, value)
+		_spec.SetField(ormwallet.FieldIsDefault, field.TypeBool, value)
 	}
 	if value, ok := _u.mutation.ActorID(); ok {
-		_spec.SetField(wallet.FieldActorID, field.TypeString, value)
+		_spec.SetField(ormwallet.FieldActorID, field.TypeString, value)
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(ormwallet.FieldActorID, field.TypeString)
 	}
 	if value, ok := _u.mutation.Name(); ok {
-		_spec.SetField(wallet.FieldName, field.TypeString, value)
+		_spec.SetField(ormwallet.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DerivationScheme(); ok {
+		_spec.SetField(ormwallet.FieldDerivationScheme, field.TypeEnum, value)
 	}
 	if value, ok := _u.mutation.EncryptedSeed(); ok {
-		_spec.SetField(wallet.FieldEncryptedSeed, field.TypeBytes, value)
+		_spec.SetField(ormwallet.FieldEncryptedSeed, field.TypeBytes, value)
+	}
+	if _u.mutation.EncryptedSeedCleared() {
+		_spec.ClearField(ormwallet.FieldEncryptedSeed, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.EncryptedPassphrase(); ok {
+		_spec.SetField(ormwallet.FieldEncryptedPassphrase, field.TypeBytes, value)
+	}
+	if _u.mutation.EncryptedPassphraseCleared() {
+		_spec.ClearField(ormwallet.FieldEncryptedPassphrase, field.TypeBytes)
 	}
 	if value, ok := _u.mutation.EncryptedKeyJSON(); ok {
-		_spec.SetField(wallet.FieldEncryptedKeyJSON, field.TypeBytes, value)
+		_spec.SetField(ormwallet.FieldEncryptedKeyJSON, field.TypeBytes, value)
 	}
 	if value, ok := _u.mutation.Salt(); ok {
-		_spec.SetField(wallet.FieldSalt, field.TypeBytes, value)
+		_spec.SetField(ormwallet.FieldSalt, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.KdfAlgorithm(); ok {
+		_spec.SetField(ormwallet.FieldKdfAlgorithm, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.KdfTime(); ok {
+		_spec.SetField(ormwallet.FieldKdfTime, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedKdfTime(); ok {
+		_spec.AddField(ormwallet.FieldKdfTime, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.KdfMemory(); ok {
+		_spec.SetField(ormwallet.FieldKdfMemory, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedKdfMemory(); ok {
+		_spec.AddField(ormwallet.FieldKdfMemory, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.KdfThreads(); ok {
+		_spec.SetField(ormwallet.FieldKdfThreads, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedKdfThreads(); ok {
+		_spec.AddField(ormwallet.FieldKdfThreads, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.FailedAttempts(); ok {
+		_spec.SetField(ormwallet.FieldFailedAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailedAttempts(); ok {
+		_spec.AddField(ormwallet.FieldFailedAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RetryAfter(); ok {
+		_spec.SetField(ormwallet.FieldRetryAfter, field.TypeTime, value)
+	}
+	if _u.mutation.RetryAfterCleared() {
+		_spec.ClearField(ormwallet.FieldRetryAfter, field.TypeTime)
+	}
+	if value, ok := _u.mutation.BackupVerifiedAt(); ok {
+		_spec.SetField(ormwallet.FieldBackupVerifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.BackupVerifiedAtCleared() {
+		_spec.ClearField(ormwallet.FieldBackupVerifiedAt, field.TypeTime)
 	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
-		_spec.SetField(wallet.FieldCreatedAt, field.TypeTime, value)
+		_spec.SetField(ormwallet.FieldCreatedAt, field.TypeTime, value)
 	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(wallet.FieldUpdatedAt, field.TypeTime, value)
+		_spec.SetField(ormwallet.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(ormwallet.FieldUpdatedAt, field.TypeTime)
 	}
 	if _u.mutation.AddressesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   wallet.AddressesTable,
-			Columns: []string{wallet.AddressesColumn},
+			Table:   ormwallet.AddressesTable,
+			Columns: []string{ormwallet.AddressesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(ormaddress.FieldID, field.TypeInt),
@@ -263,8 +508,8 @@ func (_u *WalletUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   wallet.AddressesTable,
-			Columns: []string{wallet.AddressesColumn},
+			Table:   ormwallet.AddressesTable,
+			Columns: []string{ormwallet.AddressesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(ormaddress.FieldID, field.TypeInt),
@@ -279,8 +524,8 @@ func (_u *WalletUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   wallet.AddressesTable,
-			Columns: []string{wallet.AddressesColumn},
+			Table:   ormwallet.AddressesTable,
+			Columns: []string{ormwallet.AddressesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(ormaddress.FieldID, field.TypeInt),
@@ -293,7 +538,7 @@ func (_u *WalletUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{wallet.Label}
+			err = &NotFoundError{ormwallet.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -339,6 +584,12 @@ func (_u *WalletUpdateOne) SetNillableActorID(v *string) *WalletUpdateOne {
 	return _u
 }
 
+// ClearActorID clears the value of the "actor_id" field.
+func (_u *WalletUpdateOne) ClearActorID() *WalletUpdateOne {
+	_u.mutation.ClearActorID()
+	return _u
+}
+
 // SetName sets the "name" field.
 func (_u *WalletUpdateOne) SetName(v string) *WalletUpdateOne {
 	_u.mutation.SetName(v)
@@ -353,12 +604,44 @@ func (_u *WalletUpdateOne) SetNillableName(v *string) *WalletUpdateOne {
 	return _u
 }
 
+// SetDerivationScheme sets the "derivation_scheme" field.
+func (_u *WalletUpdateOne) SetDerivationScheme(v wallet.DerivationScheme) *WalletUpdateOne {
+	_u.mutation.SetDerivationScheme(v)
+	return _u
+}
+
+// SetNillableDerivationScheme sets the "derivation_scheme" field if the given value is not nil.
+func (_u *WalletUpdateOne) SetNillableDerivationScheme(v *wallet.DerivationScheme) *WalletUpdateOne {
+	if v != nil {
+		_u.SetDerivationScheme(*v)
+	}
+	return _u
+}
+
 // SetEncryptedSeed sets the "encrypted_seed" field.
 func (_u *WalletUpdateOne) SetEncryptedSeed(v []byte) *WalletUpdateOne {
 	_u.mutation.SetEncryptedSeed(v)
 	return _u
 }
 
+// ClearEncryptedSeed clears the value of the "encrypted_seed" field.
+func (_u *WalletUpdateOne) ClearEncryptedSeed() *WalletUpdateOne {
+	_u.mutation.ClearEncryptedSeed()
+	return _u
+}
+
+// SetEncryptedPassphrase sets the "encrypted_passphrase" field.
+func (_u *WalletUpdateOne) SetEncryptedPassphrase(v []byte) *WalletUpdateOne {
+	_u.mutation.SetEncryptedPassphrase(v)
+	return _u
+}
+
+// ClearEncryptedPassphrase clears the value of the "encrypted_passphrase" field.
+func (_u *WalletUpdateOne) ClearEncryptedPassphrase() *WalletUpdateOne {
+	_u.mutation.ClearEncryptedPassphrase()
+	return _u
+}
+
 // SetEncryptedKeyJSON sets the "encrypted_key_json" field.
 func (_u *WalletUpdateOne) SetEncryptedKeyJSON(v []byte) *WalletUpdateOne {
 	_u.mutation.SetEncryptedKeyJSON(v)
@@ -371,6 +654,144 @@ func (_u *WalletUpdateOne) SetSalt(v []byte) *WalletUpdateOne {
 	return _u
 }
 
+// SetKdfAlgorithm sets the "kdf_algorithm" field.
+func (_u *WalletUpdateOne) SetKdfAlgorithm(v wallet.KDFAlgorithm) *WalletUpdateOne {
+	_u.mutation.SetKdfAlgorithm(v)
+	return _u
+}
+
+// SetNillableKdfAlgorithm sets the "kdf_algorithm" field if the given value is not nil.
+func (_u *WalletUpdateOne) SetNillableKdfAlgorithm(v *wallet.KDFAlgorithm) *WalletUpdateOne {
+	if v != nil {
+		_u.SetKdfAlgorithm(*v)
+	}
+	return _u
+}
+
+// SetKdfTime sets the "kdf_time" field.
+func (_u *WalletUpdateOne) SetKdfTime(v uint32) *WalletUpdateOne {
+	_u.mutation.ResetKdfTime()
+	_u.mutation.SetKdfTime(v)
+	return _u
+}
+
+// SetNillableKdfTime sets the "kdf_time" field if the given value is not nil.
+func (_u *WalletUpdateOne) SetNillableKdfTime(v *uint32) *WalletUpdateOne {
+	if v != nil {
+		_u.SetKdfTime(*v)
+	}
+	return _u
+}
+
+// AddKdfTime adds value to the "kdf_time" field.
+func (_u *WalletUpdateOne) AddKdfTime(v int32) *WalletUpdateOne {
+	_u.mutation.AddKdfTime(v)
+	return _u
+}
+
+// SetKdfMemory sets the "kdf_memory" field.
+func (_u *WalletUpdateOne) SetKdfMemory(v uint32) *WalletUpdateOne {
+	_u.mutation.ResetKdfMemory()
+	_u.mutation.SetKdfMemory(v)
+	return _u
+}
+
+// SetNillableKdfMemory sets the "kdf_memory" field if the given value is not nil.
+func (_u *WalletUpdateOne) SetNillableKdfMemory(v *uint32) *WalletUpdateOne {
+	if v != nil {
+		_u.SetKdfMemory(*v)
+	}
+	return _u
+}
+
+// AddKdfMemory adds value to the "kdf_memory" field.
+func (_u *WalletUpdateOne) AddKdfMemory(v int32) *WalletUpdateOne {
+	_u.mutation.AddKdfMemory(v)
+	return _u
+}
+
+// SetKdfThreads sets the "kdf_threads" field.
+func (_u *WalletUpdateOne) SetKdfThreads(v uint8) *WalletUpdateOne {
+	_u.mutation.ResetKdfThreads()
+	_u.mutation.SetKdfThreads(v)
+	return _u
+}
+
+// SetNillableKdfThreads sets the "kdf_threads" field if the given value is not nil.
+func (_u *WalletUpdateOne) SetNillableKdfThreads(v *uint8) *WalletUpdateOne {
+	if v != nil {
+		_u.SetKdfThreads(*v)
+	}
+	return _u
+}
+
+// AddKdfThreads adds value to the "kdf_threads" field.
+func (_u *WalletUpdateOne) AddKdfThreads(v int8) *WalletUpdateOne {
+	_u.mutation.AddKdfThreads(v)
+	return _u
+}
+
+// SetFailedAttempts sets the "failed_attempts" field.
+func (_u *WalletUpdateOne) SetFailedAttempts(v int) *WalletUpdateOne {
+	_u.mutation.ResetFailedAttempts()
+	_u.mutation.SetFailedAttempts(v)
+	return _u
+}
+
+// SetNillableFailedAttempts sets the "failed_attempts" field if the given value is not nil.
+func (_u *WalletUpdateOne) SetNillableFailedAttempts(v *int) *WalletUpdateOne {
+	if v != nil {
+		_u.SetFailedAttempts(*v)
+	}
+	return _u
+}
+
+// AddFailedAttempts adds value to the "failed_attempts" field.
+func (_u *WalletUpdateOne) AddFailedAttempts(v int) *WalletUpdateOne {
+	_u.mutation.AddFailedAttempts(v)
+	return _u
+}
+
+// SetRetryAfter sets the "retry_after" field.
+func (_u *WalletUpdateOne) SetRetryAfter(v time.Time) *WalletUpdateOne {
+	_u.mutation.SetRetryAfter(v)
+	return _u
+}
+
+// SetNillableRetryAfter sets the "retry_after" field if the given value is not nil.
+func (_u *WalletUpdateOne) SetNillableRetryAfter(v *time.Time) *WalletUpdateOne {
+	if v != nil {
+		_u.SetRetryAfter(*v)
+	}
+	return _u
+}
+
+// ClearRetryAfter clears the value of the "retry_after" field.
+func (_u *WalletUpdateOne) ClearRetryAfter() *WalletUpdateOne {
+	_u.mutation.ClearRetryAfter()
+	return _u
+}
+
+// SetBackupVerifiedAt sets the "backup_verified_at" field.
+func (_u *WalletUpdateOne) SetBackupVerifiedAt(v time.Time) *WalletUpdateOne {
+	_u.mutation.SetBackupVerifiedAt(v)
+	return _u
+}
+
+// SetNillableBackupVerifiedAt sets the "backup_verified_at" field if the given value is not nil.
+func (_u *WalletUpdateOne) SetNillableBackupVerifiedAt(v *time.Time) *WalletUpdateOne {
+	if v != nil {
+		_u.SetBackupVerifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearBackupVerifiedAt clears the value of the "backup_verified_at" field.
+func (_u *WalletUpdateOne) ClearBackupVerifiedAt() *WalletUpdateOne {
+	_u.mutation.ClearBackupVerifiedAt()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *WalletUpdateOne) SetCreatedAt(v time.Time) *WalletUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -399,6 +820,12 @@ func (_u *WalletUpdateOne) SetNillableUpdatedAt(v *time.Time) *WalletUpdateOne {
 	return _u
 }
 
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *WalletUpdateOne) ClearUpdatedAt() *WalletUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
 // AddAddressIDs adds the "addresses" edge to the Address entity by IDs.
 func (_u *WalletUpdateOne) AddAddressIDs(ids ...int) *WalletUpdateOne {
 	_u.mutation.AddAddressIDs(ids...)
@@ -483,25 +910,30 @@ func (_u *WalletUpdateOne) ExecX(ctx context.Context) {
 // check runs all checks and user-defined validators on the builder.
 func (_u *WalletUpdateOne) check() error {
 	if v, ok := _u.mutation.Name(); ok {
-		if err := wallet.NameValidator(v); err != nil {
+		if err := ormwallet.NameValidator(v); err != nil {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`orm: validator failed for field "Wallet.name": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.EncryptedSeed(); ok {
-		if err := wallet.EncryptedSeedValidator(v); err != nil {
-			return &ValidationError{Name: "encrypted_seed", err: fmt.Errorf(`orm: validator failed for field "Wallet.encrypted_seed": %w`, err)}
+	if v, ok := _u.mutation.DerivationScheme(); ok {
+		if err := ormwallet.DerivationSchemeValidator(v); err != nil {
+			return &ValidationError{Name: "derivation_scheme", err: fmt.Errorf(`orm: validator failed for field "Wallet.derivation_scheme": %w`, err)}
 		}
 	}
 	if v, ok := _u.mutation.EncryptedKeyJSON(); ok {
-		if err := wallet.EncryptedKeyJSONValidator(v); err != nil {
+		if err := ormwallet.EncryptedKeyJSONValidator(v); err != nil {
 			return &ValidationError{Name: "encrypted_key_json", err: fmt.Errorf(`orm: validator failed for field "Wallet.encrypted_key_json": %w`, err)}
 		}
 	}
 	if v, ok := _u.mutation.Salt(); ok {
-		if err := wallet.SaltValidator(v); err != nil {
+		if err := ormwallet.SaltValidator(v); err != nil {
 			return &ValidationError{Name: "salt", err: fmt.Errorf(`orm: validator failed for field "Wallet.salt": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.KdfAlgorithm(); ok {
+		if err := ormwallet.KdfAlgorithmValidator(v); err != nil {
+			return &ValidationError{Name: "kdf_algorithm", err: fmt.Errorf(`orm: validator failed for field "Wallet.kdf_algorithm": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -509,7 +941,7 @@ func (_u *WalletUpdateOne) sqlSave(ctx context.Context) (_node *Wallet, err erro
 	if err := _u.check(); err != nil {
 		return _node, err
 	}
-	_spec := sqlgraph.NewUpdateSpec(wallet.Table, wallet.Columns, sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeInt))
+	_spec := sqlgraph.NewUpdateSpec(ormwallet.Table, ormwallet.Columns, sqlgraph.NewFieldSpec(ormwallet.FieldID, field.TypeInt))
 	id, ok := _u.mutation.ID()
 	if !ok {
 		return nil, &ValidationError{Name: "id", err: errors.New(`orm: missing "Wallet.id" for update`)}
@@ -517,12 +949,12 @@ func (_u *WalletUpdateOne) sqlSave(ctx context.Context) (_node *Wallet, err erro
 	_spec.Node.ID.Value = id
 	if fields := _u.fields; len(fields) > 0 {
 		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, wallet.FieldID)
+		_spec.Node.Columns = append(_spec.Node.Columns, ormwallet.FieldID)
 		for _, f := range fields {
-			if !wallet.ValidColumn(f) {
+			if !ormwallet.ValidColumn(f) {
 				return nil, &ValidationError{Name: f, err: fmt.Errorf("orm: invalid field %q for query", f)}
 			}
-			if f != wallet.FieldID {
+			if f != ormwallet.FieldID {
 				_spec.Node.Columns = append(_spec.Node.Columns, f)
 			}
 		}
@@ -535,35 +967,92 @@ func (_u *WalletUpdateOne) sqlSave(ctx context.Context) (_node *Wallet, err erro
 		}
 	}
 	if value, ok := _u.mutation.IsDefault(); ok {
-		_spec.SetField(wallet.FieldIsDefault, field.TypeBool, value)
+		_spec.SetField(ormwallet.FieldIsDefault, field.TypeBool, value)
 	}
 	if value, ok := _u.mutation.ActorID(); ok {
-		_spec.SetField(wallet.FieldActorID, field.TypeString, value)
+		_spec.SetField(ormwallet.FieldActorID, field.TypeString, value)
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(ormwallet.FieldActorID, field.TypeString)
 	}
 	if value, ok := _u.mutation.Name(); ok {
-		_spec.SetField(wallet.FieldName, field.TypeString, value)
+		_spec.SetField(ormwallet.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DerivationScheme(); ok {
+		_spec.SetField(ormwallet.FieldDerivationScheme, field.TypeEnum, value)
 	}
 	if value, ok := _u.mutation.EncryptedSeed(); ok {
-		_spec.SetField(wallet.FieldEncryptedSeed, field.TypeBytes, value)
+		_spec.SetField(ormwallet.FieldEncryptedSeed, field.TypeBytes, value)
+	}
+	if _u.mutation.EncryptedSeedCleared() {
+		_spec.ClearField(ormwallet.FieldEncryptedSeed, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.EncryptedPassphrase(); ok {
+		_spec.SetField(ormwallet.FieldEncryptedPassphrase, field.TypeBytes, value)
+	}
+	if _u.mutation.EncryptedPassphraseCleared() {
+		_spec.ClearField(ormwallet.FieldEncryptedPassphrase, field.TypeBytes)
 	}
 	if value, ok := _u.mutation.EncryptedKeyJSON(); ok {
-		_spec.SetField(wallet.FieldEncryptedKeyJSON, field.TypeBytes, value)
+		_spec.SetField(ormwallet.FieldEncryptedKeyJSON, field.TypeBytes, value)
 	}
 	if value, ok := _u.mutation.Salt(); ok {
-		_spec.SetField(wallet.FieldSalt, field.TypeBytes, value)
+		_spec.SetField(ormwallet.FieldSalt, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.KdfAlgorithm(); ok {
+		_spec.SetField(ormwallet.FieldKdfAlgorithm, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.KdfTime(); ok {
+		_spec.SetField(ormwallet.FieldKdfTime, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedKdfTime(); ok {
+		_spec.AddField(ormwallet.FieldKdfTime, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.KdfMemory(); ok {
+		_spec.SetField(ormwallet.FieldKdfMemory, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedKdfMemory(); ok {
+		_spec.AddField(ormwallet.FieldKdfMemory, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.KdfThreads(); ok {
+		_spec.SetField(ormwallet.FieldKdfThreads, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedKdfThreads(); ok {
+		_spec.AddField(ormwallet.FieldKdfThreads, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.FailedAttempts(); ok {
+		_spec.SetField(ormwallet.FieldFailedAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailedAttempts(); ok {
+		_spec.AddField(ormwallet.FieldFailedAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RetryAfter(); ok {
+		_spec.SetField(ormwallet.FieldRetryAfter, field.TypeTime, value)
+	}
+	if _u.mutation.RetryAfterCleared() {
+		_spec.ClearField(ormwallet.FieldRetryAfter, field.TypeTime)
+	}
+	if value, ok := _u.mutation.BackupVerifiedAt(); ok {
+		_spec.SetField(ormwallet.FieldBackupVerifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.BackupVerifiedAtCleared() {
+		_spec.ClearField(ormwallet.FieldBackupVerifiedAt, field.TypeTime)
 	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
-		_spec.SetField(wallet.FieldCreatedAt, field.TypeTime, value)
+		_spec.SetField(ormwallet.FieldCreatedAt, field.TypeTime, value)
 	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(wallet.FieldUpdatedAt, field.TypeTime, value)
+		_spec.SetField(ormwallet.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(ormwallet.FieldUpdatedAt, field.TypeTime)
 	}
 	if _u.mutation.AddressesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   wallet.AddressesTable,
-			Columns: []string{wallet.AddressesColumn},
+			Table:   ormwallet.AddressesTable,
+			Columns: []string{ormwallet.AddressesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(ormaddress.FieldID, field.TypeInt),
@@ -575,8 +1064,8 @@ func (_u *WalletUpdateOne) sqlSave(ctx context.Context) (_node *Wallet, err erro
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   wallet.AddressesTable,
-			Columns: []string{wallet.AddressesColumn},
+			Table:   ormwallet.AddressesTable,
+			Columns: []string{ormwallet.AddressesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(ormaddress.FieldID, field.TypeInt),
@@ -591,8 +1080,8 @@ func (_u *WalletUpdateOne) sqlSave(ctx context.Context) (_node *Wallet, err erro
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   wallet.AddressesTable,
-			Columns: []string{wallet.AddressesColumn},
+			Table:   ormwallet.AddressesTable,
+			Columns: []string{ormwallet.AddressesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(ormaddress.FieldID, field.TypeInt),
@@ -608,7 +1097,7 @@ func (_u *WalletUpdateOne) sqlSave(ctx context.Context) (_node *Wallet, err erro
 	_spec.ScanValues = _node.scanValues
 	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{wallet.Label}
+			err = &NotFoundError{ormwallet.Label}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
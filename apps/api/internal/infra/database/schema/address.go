@@ -5,6 +5,7 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/codemaestro64/filament/libs/filwallet/address"
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
 )
 
 // Address holds the schema definition for the Address entity.
@@ -17,6 +18,14 @@ func (Address) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int32("type").GoType(address.Type(0)),
 		field.String("address").Unique().NotEmpty(),
+		field.Uint32("account_index").Default(0),
+		field.String("derivation_path").Optional(),
+		// Key type of the account, shared by all of its addresses. Rows
+		// created before BLS keys could be imported are secp256k1.
+		field.Enum("key_type").
+			GoType(wallet.KeyType("")).
+			Default(string(wallet.KeyTypeSecp256k1)),
+		field.String("actor_id").Optional(),
 	}
 }
 
@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/codemaestro64/filament/libs/filwallet"
+)
+
+// AuditEvent holds the schema definition for the AuditEvent entity.
+type AuditEvent struct {
+	ent.Schema
+}
+
+// Fields of the AuditEvent.
+func (AuditEvent) Fields() []ent.Field {
+	return []ent.Field{
+		// Not an edge, events outlive deleted wallets.
+		field.Int("wallet_id"),
+		field.String("action").GoType(filwallet.AuditAction("")).NotEmpty(),
+		field.String("detail").Optional(),
+		field.Bool("success").Default(false),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Indexes of the AuditEvent.
+func (AuditEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("wallet_id", "created_at"),
+	}
+}
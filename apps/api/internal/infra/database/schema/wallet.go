@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
 )
 
 // Wallet holds the schema definition for the Wallet entity.
@@ -17,13 +18,34 @@ type Wallet struct {
 func (Wallet) Fields() []ent.Field {
 	return []ent.Field{
 		field.Bool("is_default").Default(false),
-		field.String("actor_id").Nillable(),
+		field.String("actor_id").Optional().Nillable(),
 		field.String("name").NotEmpty(),
-		field.Bytes("encrypted_seed").Sensitive().NotEmpty(),
+		// Rows created before BIP44 support use the legacy derivation.
+		field.Enum("derivation_scheme").
+			GoType(wallet.DerivationScheme("")).
+			Default(string(wallet.SchemeLegacy)),
+		// Wallets created from an imported key have no seed.
+		field.Bytes("encrypted_seed").Sensitive().Optional(),
+		// BIP39 passphrase of the seed, empty when it has none.
+		field.Bytes("encrypted_passphrase").Sensitive().Optional(),
 		field.Bytes("encrypted_key_json").Sensitive().NotEmpty(),
 		field.Bytes("salt").Sensitive().NotEmpty(),
+		// Password hashing parameters. The defaults are the ones every
+		// wallet used before they were stored.
+		field.Enum("kdf_algorithm").
+			GoType(wallet.KDFAlgorithm("")).
+			Default(string(wallet.LegacyKDFParams.Algorithm)),
+		field.Uint32("kdf_time").Default(wallet.LegacyKDFParams.Time),
+		field.Uint32("kdf_memory").Default(wallet.LegacyKDFParams.Memory),
+		field.Uint8("kdf_threads").Default(wallet.LegacyKDFParams.Threads),
+		// Wrong passwords in a row, reset by a correct one.
+		field.Int("failed_attempts").Default(0),
+		// Password checks are refused until retry_after.
+		field.Time("retry_after").Optional().Nillable(),
+		// Last time the user proved to hold the seed phrase.
+		field.Time("backup_verified_at").Optional().Nillable(),
 		field.Time("created_at").Default(time.Now),
-		field.Time("updated_at").Nillable(),
+		field.Time("updated_at").Optional().Nillable(),
 	}
 }
 
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/codemaestro64/filament/libs/filwallet"
+)
+
+func (r *walletRepo) RecordAuditEvent(ctx context.Context, event filwallet.AuditEvent) error {
+	err := r.db.AuditEvent.Create().
+		SetWalletID(event.WalletID).
+		SetAction(event.Action).
+		SetDetail(event.Detail).
+		SetSuccess(event.Success).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("db: record audit event: %w", err)
+	}
+
+	return nil
+}
@@ -1,6 +1,11 @@
 package repository
 
-import "github.com/codemaestro64/filament/apps/api/internal/infra/database/orm"
+import (
+	"context"
+	"fmt"
+
+	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm"
+)
 
 type Repository struct {
 	Setting SettingRepo
@@ -13,3 +18,24 @@ func New(dbClient *orm.Client) *Repository {
 		Wallet:  newWalletRepo(dbClient),
 	}
 }
+
+// withTx runs fn inside a transaction, rolling back if fn fails.
+func withTx(ctx context.Context, db *orm.Client, fn func(tx *orm.Tx) error) error {
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w: rollback: %v", err, rerr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+
+	return nil
+}
@@ -3,8 +3,11 @@ package repository
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/codemaestro64/filament/apps/api/internal/infra/database/orm"
+	dbaddress "github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/address"
 	dbwallet "github.com/codemaestro64/filament/apps/api/internal/infra/database/orm/wallet"
 	"github.com/codemaestro64/filament/libs/filwallet"
 	"github.com/codemaestro64/filament/libs/filwallet/address"
@@ -17,6 +20,14 @@ type WalletRepo interface {
 	GetWallets(ctx context.Context) ([]*wallet.Wallet, error)
 	DeleteWallet(ctx context.Context, walletID int) error
 	SaveWallet(ctx context.Context, saveParams filwallet.SaveWalletParams) (*wallet.Wallet, error)
+	SaveAccount(ctx context.Context, walletID int, account wallet.Account) error
+	UpdateWalletDerivation(ctx context.Context, walletID int, p filwallet.UpdateDerivationParams) error
+	UpdateActorIDs(ctx context.Context, walletID int, actorIDs map[string]string) error
+	RenameAddresses(ctx context.Context, walletID int, renames map[string]string) error
+	UpdateWalletSecrets(ctx context.Context, updates []filwallet.UpdateSecretsParams) error
+	UpdateFailedAttempts(ctx context.Context, walletID int, attempts int, retryAfter time.Time) error
+	UpdateBackupVerified(ctx context.Context, walletID int, at time.Time) error
+	RecordAuditEvent(ctx context.Context, event filwallet.AuditEvent) error
 }
 
 type walletRepo struct {
@@ -41,34 +52,34 @@ func (r *walletRepo) CountWallets(ctx context.Context) (int, error) {
 func (r *walletRepo) FindWallet(ctx context.Context, walletID int) (*wallet.Wallet, error) {
 	dbWallet, err := r.db.Wallet.Query().
 		Where(dbwallet.IDEQ(walletID)).
-		WithAddresses().
+		WithAddresses(orderAddresses).
 		First(ctx)
 
-	if err != nil && !orm.IsNotFound(err) {
+	if err != nil {
+		if orm.IsNotFound(err) {
+			return nil, filwallet.ErrNotFound
+		}
 		return nil, fmt.Errorf("db: find wallet by ID: %w", err)
 	}
 
-	wal := &wallet.Wallet{
-		IsDefault:         dbWallet.IsDefault,
-		Name:              dbWallet.Name,
-		EncryptedMnemonic: dbWallet.EncryptedSeed,
-		Salt:              dbWallet.Salt,
-		EncryptedKeyJSON:  dbWallet.EncryptedKeyJSON,
-		CreatedAt:         dbWallet.CreatedAt,
-	}
+	return toWallet(dbWallet), nil
+}
 
-	for _, addr := range dbWallet.Edges.Addresses {
-		wal.Addresses = append(wal.Addresses, address.Address{
-			Type:  addr.Type,
-			Value: addr.Address,
-		})
+func (r *walletRepo) GetWallets(ctx context.Context) ([]*wallet.Wallet, error) {
+	dbWallets, err := r.db.Wallet.Query().
+		WithAddresses(orderAddresses).
+		Order(orm.Asc(dbwallet.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db: get wallets: %w", err)
 	}
 
-	return wal, nil
-}
+	wallets := make([]*wallet.Wallet, 0, len(dbWallets))
+	for _, dbWallet := range dbWallets {
+		wallets = append(wallets, toWallet(dbWallet))
+	}
 
-func (r *walletRepo) GetWallets(ctx context.Context) ([]*wallet.Wallet, error) {
-	return nil, nil
+	return wallets, nil
 }
 
 func (r *walletRepo) DeleteWallet(ctx context.Context, walletID int) error {
@@ -81,5 +92,346 @@ func (r *walletRepo) DeleteWallet(ctx context.Context, walletID int) error {
 }
 
 func (r *walletRepo) SaveWallet(ctx context.Context, saveParams filwallet.SaveWalletParams) (*wallet.Wallet, error) {
-	return nil, nil
+	var saved *orm.Wallet
+	err := withTx(ctx, r.db, func(tx *orm.Tx) error {
+		create := tx.Wallet.Create().
+			SetName(saveParams.Name).
+			SetDerivationScheme(saveParams.DerivationScheme).
+			SetEncryptedSeed(saveParams.EncryptedSeed).
+			SetEncryptedPassphrase(saveParams.EncryptedPassphrase).
+			SetEncryptedKeyJSON(saveParams.KeyJSON).
+			SetSalt(saveParams.Salt).
+			SetKdfAlgorithm(saveParams.KDF.Algorithm).
+			SetKdfTime(saveParams.KDF.Time).
+			SetKdfMemory(saveParams.KDF.Memory).
+			SetKdfThreads(saveParams.KDF.Threads)
+		if !saveParams.BackupVerifiedAt.IsZero() {
+			create.SetBackupVerifiedAt(saveParams.BackupVerifiedAt)
+		}
+
+		dbWallet, err := create.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create wallet: %w", err)
+		}
+
+		for _, account := range saveParams.Accounts {
+			if err := createAccountAddresses(ctx, tx.Client(), dbWallet.ID, account); err != nil {
+				return err
+			}
+		}
+
+		saved, err = tx.Wallet.Query().
+			Where(dbwallet.IDEQ(dbWallet.ID)).
+			WithAddresses(orderAddresses).
+			Only(ctx)
+		return err
+	})
+	if err != nil {
+		// Addresses are unique, so a constraint error means the key is
+		// already in another wallet.
+		if orm.IsConstraintError(err) {
+			return nil, wallet.ErrWalletAlreadyExists
+		}
+		return nil, fmt.Errorf("db: save wallet: %w", err)
+	}
+
+	return toWallet(saved), nil
+}
+
+func (r *walletRepo) SaveAccount(ctx context.Context, walletID int, account wallet.Account) error {
+	err := withTx(ctx, r.db, func(tx *orm.Tx) error {
+		return createAccountAddresses(ctx, tx.Client(), walletID, account)
+	})
+	if err != nil {
+		return fmt.Errorf("db: save account: %w", err)
+	}
+
+	return nil
+}
+
+func (r *walletRepo) UpdateWalletDerivation(ctx context.Context, walletID int, p filwallet.UpdateDerivationParams) error {
+	err := withTx(ctx, r.db, func(tx *orm.Tx) error {
+		err := tx.Wallet.UpdateOneID(walletID).
+			SetDerivationScheme(p.Scheme).
+			SetEncryptedKeyJSON(p.KeyJSON).
+			SetUpdatedAt(time.Now()).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("update wallet: %w", err)
+		}
+
+		_, err = tx.Address.Delete().
+			Where(dbaddress.HasWalletWith(dbwallet.IDEQ(walletID))).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("delete addresses: %w", err)
+		}
+
+		for _, account := range p.Accounts {
+			if err := createAccountAddresses(ctx, tx.Client(), walletID, account); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		if orm.IsConstraintError(err) {
+			return wallet.ErrWalletAlreadyExists
+		}
+		return fmt.Errorf("db: update wallet derivation: %w", err)
+	}
+
+	return nil
+}
+
+func (r *walletRepo) UpdateWalletSecrets(ctx context.Context, updates []filwallet.UpdateSecretsParams) error {
+	err := withTx(ctx, r.db, func(tx *orm.Tx) error {
+		for _, u := range updates {
+			update := tx.Wallet.UpdateOneID(u.WalletID).
+				Where(dbwallet.SaltEQ(u.OldSalt)).
+				SetSalt(u.Secrets.Salt).
+				SetKdfAlgorithm(u.Secrets.KDF.Algorithm).
+				SetKdfTime(u.Secrets.KDF.Time).
+				SetKdfMemory(u.Secrets.KDF.Memory).
+				SetKdfThreads(u.Secrets.KDF.Threads).
+				SetEncryptedKeyJSON(u.Secrets.EncryptedKeyJSON).
+				SetUpdatedAt(time.Now())
+			if len(u.Secrets.EncryptedMnemonic) > 0 {
+				update.SetEncryptedSeed(u.Secrets.EncryptedMnemonic)
+			}
+			if len(u.Secrets.EncryptedPassphrase) > 0 {
+				update.SetEncryptedPassphrase(u.Secrets.EncryptedPassphrase)
+			}
+
+			// A wallet that no longer has OldSalt was re-encrypted
+			// after the secrets were derived, they would replace the
+			// newer ones.
+			if err := update.Exec(ctx); orm.IsNotFound(err) {
+				return fmt.Errorf("update wallet %d: %w", u.WalletID, filwallet.ErrWalletChanged)
+			} else if err != nil {
+				return fmt.Errorf("update wallet %d: %w", u.WalletID, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("db: update wallet secrets: %w", err)
+	}
+
+	return nil
+}
+
+func (r *walletRepo) UpdateFailedAttempts(ctx context.Context, walletID int, attempts int, retryAfter time.Time) error {
+	update := r.db.Wallet.UpdateOneID(walletID).SetFailedAttempts(attempts)
+	if retryAfter.IsZero() {
+		update.ClearRetryAfter()
+	} else {
+		update.SetRetryAfter(retryAfter)
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		if orm.IsNotFound(err) {
+			return filwallet.ErrNotFound
+		}
+		return fmt.Errorf("db: update failed attempts: %w", err)
+	}
+
+	return nil
+}
+
+func (r *walletRepo) UpdateBackupVerified(ctx context.Context, walletID int, at time.Time) error {
+	err := r.db.Wallet.UpdateOneID(walletID).SetBackupVerifiedAt(at).Exec(ctx)
+	if err != nil {
+		if orm.IsNotFound(err) {
+			return filwallet.ErrNotFound
+		}
+		return fmt.Errorf("db: update backup verified: %w", err)
+	}
+
+	return nil
+}
+
+func (r *walletRepo) UpdateActorIDs(ctx context.Context, walletID int, actorIDs map[string]string) error {
+	err := withTx(ctx, r.db, func(tx *orm.Tx) error {
+		addrs, err := tx.Address.Query().
+			Where(dbaddress.HasWalletWith(dbwallet.IDEQ(walletID))).
+			Order(orm.Asc(dbaddress.FieldAccountIndex), orm.Asc(dbaddress.FieldID)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("query addresses: %w", err)
+		}
+
+		walletActorSet := false
+		for _, addr := range addrs {
+			actorID, ok := actorIDs[addr.Address]
+			if !ok {
+				continue
+			}
+
+			if err := tx.Address.UpdateOne(addr).SetActorID(actorID).Exec(ctx); err != nil {
+				return fmt.Errorf("update address %s: %w", addr.Address, err)
+			}
+
+			// The wallet's actor is the one of its primary f1 address, or f3
+			// for a BLS key.
+			if !walletActorSet && addr.AccountIndex == 0 && (addr.Type == address.TypeF1 || addr.Type == address.TypeF3) {
+				err := tx.Wallet.UpdateOneID(walletID).
+					SetActorID(actorID).
+					SetUpdatedAt(time.Now()).
+					Exec(ctx)
+				if err != nil {
+					return fmt.Errorf("update wallet: %w", err)
+				}
+				walletActorSet = true
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("db: update actor ids: %w", err)
+	}
+
+	return nil
+}
+
+func (r *walletRepo) RenameAddresses(ctx context.Context, walletID int, renames map[string]string) error {
+	err := withTx(ctx, r.db, func(tx *orm.Tx) error {
+		addrs, err := tx.Address.Query().
+			Where(dbaddress.HasWalletWith(dbwallet.IDEQ(walletID))).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("query addresses: %w", err)
+		}
+
+		for _, addr := range addrs {
+			update := tx.Address.UpdateOne(addr)
+			changed := false
+			if renamed, ok := renames[addr.Address]; ok {
+				update.SetAddress(renamed)
+				changed = true
+			}
+			if renamed, ok := renames[addr.ActorID]; ok {
+				update.SetActorID(renamed)
+				changed = true
+			}
+
+			if !changed {
+				continue
+			}
+			if err := update.Exec(ctx); err != nil {
+				return fmt.Errorf("update address %s: %w", addr.Address, err)
+			}
+		}
+
+		dbWallet, err := tx.Wallet.Get(ctx, walletID)
+		if err != nil {
+			return fmt.Errorf("get wallet: %w", err)
+		}
+
+		if renamed, ok := renames[stringValue(dbWallet.ActorID)]; ok {
+			err := tx.Wallet.UpdateOne(dbWallet).
+				SetActorID(renamed).
+				SetUpdatedAt(time.Now()).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("update wallet: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("db: rename addresses: %w", err)
+	}
+
+	return nil
+}
+
+func createAccountAddresses(ctx context.Context, db *orm.Client, walletID int, account wallet.Account) error {
+	builders := make([]*orm.AddressCreate, 0, len(account.Addresses))
+	for _, addr := range account.Addresses {
+		create := db.Address.Create().
+			SetWalletID(walletID).
+			SetType(addr.Type).
+			SetAddress(addr.Value).
+			SetAccountIndex(account.Index).
+			SetDerivationPath(account.Path)
+		if account.KeyType != "" {
+			create.SetKeyType(account.KeyType)
+		}
+		builders = append(builders, create)
+	}
+
+	if err := db.Address.CreateBulk(builders...).Exec(ctx); err != nil {
+		return fmt.Errorf("create addresses for account %d: %w", account.Index, err)
+	}
+
+	return nil
+}
+
+func orderAddresses(q *orm.AddressQuery) {
+	q.Order(orm.Asc(dbaddress.FieldID))
+}
+
+func toWallet(dbWallet *orm.Wallet) *wallet.Wallet {
+	wal := &wallet.Wallet{
+		ID:                  dbWallet.ID,
+		IsDefault:           dbWallet.IsDefault,
+		Name:                dbWallet.Name,
+		ActorID:             stringValue(dbWallet.ActorID),
+		DerivationScheme:    dbWallet.DerivationScheme,
+		EncryptedMnemonic:   dbWallet.EncryptedSeed,
+		EncryptedPassphrase: dbWallet.EncryptedPassphrase,
+		Salt:                dbWallet.Salt,
+		KDF: wallet.KDFParams{
+			Algorithm: dbWallet.KdfAlgorithm,
+			Time:      dbWallet.KdfTime,
+			Memory:    dbWallet.KdfMemory,
+			Threads:   dbWallet.KdfThreads,
+		},
+		EncryptedKeyJSON: dbWallet.EncryptedKeyJSON,
+		FailedAttempts:   dbWallet.FailedAttempts,
+		CreatedAt:        dbWallet.CreatedAt,
+	}
+	if dbWallet.RetryAfter != nil {
+		wal.RetryAfter = *dbWallet.RetryAfter
+	}
+	if dbWallet.BackupVerifiedAt != nil {
+		wal.BackupVerifiedAt = *dbWallet.BackupVerifiedAt
+	}
+
+	accounts := make(map[uint32]*wallet.Account)
+	for _, addr := range dbWallet.Edges.Addresses {
+		account, ok := accounts[addr.AccountIndex]
+		if !ok {
+			account = &wallet.Account{Index: addr.AccountIndex, Path: addr.DerivationPath, KeyType: addr.KeyType}
+			accounts[addr.AccountIndex] = account
+		}
+
+		account.Addresses = append(account.Addresses, address.Address{
+			Type:    addr.Type,
+			Value:   addr.Address,
+			ActorID: addr.ActorID,
+		})
+	}
+
+	for _, account := range accounts {
+		wal.Accounts = append(wal.Accounts, *account)
+	}
+	sort.Slice(wal.Accounts, func(i, j int) bool {
+		return wal.Accounts[i].Index < wal.Accounts[j].Index
+	})
+
+	return wal
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
 }
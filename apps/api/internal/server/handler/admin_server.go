@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/codemaestro64/filament/apps/api/internal/domain"
+	"github.com/codemaestro64/filament/apps/api/internal/service"
+	pbv1 "github.com/codemaestro64/filament/libs/proto/gen/go/v1"
+	"github.com/codemaestro64/filament/libs/proto/gen/go/v1/pbv1connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AdminServer struct {
+	adminService service.AdminService
+}
+
+func NewAdminServer(srvc *service.Service, options connect.Option) (string, http.Handler) {
+	adminServer := &AdminServer{
+		adminService: srvc.Admin,
+	}
+
+	return pbv1connect.NewAdminServiceHandler(adminServer, options)
+}
+
+func (s *AdminServer) MigrateWallet(
+	ctx context.Context,
+	req *Request[pbv1.MigrateWalletRequest],
+) (*Response[pbv1.MigrateWalletResponse], error) {
+
+	result, err := s.adminService.MigrateWallet(ctx, domain.MigrateWalletRequest{
+		WalletID:   int(req.Msg.GetWalletId()),
+		Password:   req.Msg.GetPassword(),
+		Commit:     req.Msg.GetCommit(),
+		BuildSweep: req.Msg.GetBuildSweep(),
+	})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	resp := &pbv1.MigrateWalletResponse{
+		WalletId:         int64(result.WalletID),
+		DerivationScheme: toPbDerivationScheme(result.DerivationScheme),
+		LegacyAddresses:  toPbAddresses(result.LegacyAddresses),
+		NewAddresses:     toPbAddresses(result.NewAddresses),
+		Sweeps:           toPbSignedMessages(result.Sweeps),
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+func (s *AdminServer) GetEndpointHealth(
+	ctx context.Context,
+	_ *Request[pbv1.GetEndpointHealthRequest],
+) (*Response[pbv1.GetEndpointHealthResponse], error) {
+
+	result, err := s.adminService.GetEndpointHealth(ctx, domain.GetEndpointHealthRequest{})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	resp := &pbv1.GetEndpointHealthResponse{
+		Endpoints: make([]*pbv1.EndpointHealth, 0, len(result.Endpoints)),
+	}
+	for _, e := range result.Endpoints {
+		health := &pbv1.EndpointHealth{
+			Url:                 e.URL,
+			Healthy:             e.Healthy,
+			InSync:              e.InSync,
+			HeadHeight:          e.HeadHeight,
+			LatencyMs:           e.Latency.Milliseconds(),
+			ConsecutiveFailures: uint32(e.ConsecutiveFailures),
+			LastError:           e.LastError,
+		}
+		if !e.CheckedAt.IsZero() {
+			health.CheckedAt = timestamppb.New(e.CheckedAt)
+		}
+		if !e.RetryAt.IsZero() {
+			health.RetryAt = timestamppb.New(e.RetryAt)
+		}
+		resp.Endpoints = append(resp.Endpoints, health)
+	}
+
+	return connect.NewResponse(resp), nil
+}
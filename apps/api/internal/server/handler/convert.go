@@ -0,0 +1,217 @@
+package handler
+
+import (
+	"strings"
+	"time"
+
+	"github.com/codemaestro64/filament/apps/api/internal/domain"
+	"github.com/codemaestro64/filament/libs/filwallet"
+	"github.com/codemaestro64/filament/libs/filwallet/address"
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
+	pbv1 "github.com/codemaestro64/filament/libs/proto/gen/go/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func toPbAddresses(addrs []address.Address) []*pbv1.Address {
+	pbAddrs := make([]*pbv1.Address, 0, len(addrs))
+	for _, addr := range addrs {
+		pbAddrs = append(pbAddrs, toPbAddress(addr))
+	}
+
+	return pbAddrs
+}
+
+func toPbAddressType(t address.Type) pbv1.AddressType {
+	switch t {
+	case address.TypeF0:
+		return pbv1.AddressType_ADDRESS_TYPE_F0
+	case address.TypeF2:
+		return pbv1.AddressType_ADDRESS_TYPE_F2
+	case address.TypeF3:
+		return pbv1.AddressType_ADDRESS_TYPE_F3
+	case address.TypeF4:
+		return pbv1.AddressType_ADDRESS_TYPE_F4
+	case address.Type0X:
+		return pbv1.AddressType_ADDRESS_TYPE_0X
+	default:
+		return pbv1.AddressType_ADDRESS_TYPE_F1
+	}
+}
+
+// toPbAddressMap keys addresses by type. The map holds one address per type,
+// so later accounts don't override the primary one.
+func toPbAddressMap(addrs []address.Address) map[string]string {
+	addresses := make(map[string]string, len(addrs))
+	for _, addr := range addrs {
+		if _, ok := addresses[addr.Type.String()]; !ok {
+			addresses[addr.Type.String()] = addr.Value
+		}
+	}
+
+	return addresses
+}
+
+func toPbUnlockStatus(s domain.UnlockStatus) pbv1.UnlockStatus {
+	switch s {
+	case domain.UnlockStatusWrongPassword:
+		return pbv1.UnlockStatus_UNLOCK_STATUS_WRONG_PASSWORD
+	case domain.UnlockStatusCorrupted:
+		return pbv1.UnlockStatus_UNLOCK_STATUS_CORRUPTED
+	case domain.UnlockStatusLockedOut:
+		return pbv1.UnlockStatus_UNLOCK_STATUS_LOCKED_OUT
+	default:
+		return pbv1.UnlockStatus_UNLOCK_STATUS_UNLOCKED
+	}
+}
+
+var mnemonicLanguages = map[pbv1.MnemonicLanguage]string{
+	pbv1.MnemonicLanguage_MNEMONIC_LANGUAGE_ENGLISH:             string(filwallet.LanguageEnglish),
+	pbv1.MnemonicLanguage_MNEMONIC_LANGUAGE_CHINESE_SIMPLIFIED:  string(filwallet.LanguageChineseSimplified),
+	pbv1.MnemonicLanguage_MNEMONIC_LANGUAGE_CHINESE_TRADITIONAL: string(filwallet.LanguageChineseTraditional),
+	pbv1.MnemonicLanguage_MNEMONIC_LANGUAGE_CZECH:               string(filwallet.LanguageCzech),
+	pbv1.MnemonicLanguage_MNEMONIC_LANGUAGE_FRENCH:              string(filwallet.LanguageFrench),
+	pbv1.MnemonicLanguage_MNEMONIC_LANGUAGE_ITALIAN:             string(filwallet.LanguageItalian),
+	pbv1.MnemonicLanguage_MNEMONIC_LANGUAGE_JAPANESE:            string(filwallet.LanguageJapanese),
+	pbv1.MnemonicLanguage_MNEMONIC_LANGUAGE_KOREAN:              string(filwallet.LanguageKorean),
+	pbv1.MnemonicLanguage_MNEMONIC_LANGUAGE_SPANISH:             string(filwallet.LanguageSpanish),
+}
+
+// fromPbMnemonicLanguage returns the wordlist of l, empty when unspecified.
+func fromPbMnemonicLanguage(l pbv1.MnemonicLanguage) string {
+	if l == pbv1.MnemonicLanguage_MNEMONIC_LANGUAGE_UNSPECIFIED {
+		return ""
+	}
+
+	lang, ok := mnemonicLanguages[l]
+	if !ok {
+		return l.String()
+	}
+
+	return lang
+}
+
+// toPbMnemonicLanguage is the inverse of fromPbMnemonicLanguage.
+func toPbMnemonicLanguage(lang string) pbv1.MnemonicLanguage {
+	for l, name := range mnemonicLanguages {
+		if name == lang {
+			return l
+		}
+	}
+
+	return pbv1.MnemonicLanguage_MNEMONIC_LANGUAGE_UNSPECIFIED
+}
+
+func toPbDerivationScheme(s wallet.DerivationScheme) pbv1.DerivationScheme {
+	switch s {
+	case wallet.SchemeBIP44:
+		return pbv1.DerivationScheme_DERIVATION_SCHEME_BIP44
+	case wallet.SchemeImported:
+		return pbv1.DerivationScheme_DERIVATION_SCHEME_IMPORTED
+	default:
+		return pbv1.DerivationScheme_DERIVATION_SCHEME_LEGACY
+	}
+}
+
+func toPbAmount(a domain.Amount) *pbv1.Amount {
+	return &pbv1.Amount{
+		Value:    a.Value,
+		Ticker:   a.Ticker,
+		Decimals: a.Decimals,
+	}
+}
+
+func toPbBalance(a *domain.Amount) *pbv1.Amount {
+	if a == nil {
+		return nil
+	}
+
+	return toPbAmount(*a)
+}
+
+func toPbWallet(w domain.Wallet) *pbv1.Wallet {
+	return &pbv1.Wallet{
+		WalletId:         int64(w.ID),
+		IsDefault:        w.IsDefault,
+		Name:             w.Name,
+		ActorId:          w.ActorID,
+		Addresses:        toPbAddresses(w.Addresses),
+		Balance:          toPbBalance(w.Balance),
+		CreatedAt:        timestamppb.New(w.CreatedAt),
+		BackupVerifiedAt: optionalTimestamp(w.BackupVerifiedAt),
+	}
+}
+
+// optionalTimestamp leaves zero times unset.
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
+
+func fromPbAmount(a *pbv1.Amount) domain.Amount {
+	return domain.Amount{
+		Value:    a.GetValue(),
+		Ticker:   a.GetTicker(),
+		Decimals: a.GetDecimals(),
+	}
+}
+
+func toPbAddress(addr address.Address) *pbv1.Address {
+	return &pbv1.Address{
+		Type:    toPbAddressType(addr.Type),
+		Value:   addr.Value,
+		ActorId: addr.ActorID,
+	}
+}
+
+func toPbTransaction(tx domain.Transaction) *pbv1.Transaction {
+	txType := pbv1.TransactionActionType(tx.Type)
+	txStatus := pbv1.TransactionStatusType(tx.Status)
+
+	return &pbv1.Transaction{
+		Id: tx.ID,
+		Type: &pbv1.TransactionType{
+			Type:  txType,
+			Value: strings.ToLower(strings.TrimPrefix(txType.String(), "TRANSACTION_TYPE_")),
+		},
+		Status: &pbv1.TransactionStatus{
+			Type: txStatus,
+		},
+		Amount:             toPbAmount(tx.Amount),
+		SourceAddress:      toPbAddress(tx.SourceAddress),
+		DestinationAddress: toPbAddress(tx.DestinationAddress),
+		Fee:                toPbAmount(tx.Fee),
+		CreatedAt:          timestamppb.New(tx.CreatedAt),
+	}
+}
+
+func toPbSignedMessages(smsgs []domain.SignedMessage) []*pbv1.SignedMessage {
+	pbMsgs := make([]*pbv1.SignedMessage, 0, len(smsgs))
+	for _, smsg := range smsgs {
+		pbMsgs = append(pbMsgs, toPbSignedMessage(smsg))
+	}
+
+	return pbMsgs
+}
+
+func toPbSignedMessage(smsg domain.SignedMessage) *pbv1.SignedMessage {
+	return &pbv1.SignedMessage{
+		Message:   toPbUnsignedMessage(smsg.Message),
+		Cid:       smsg.Cid,
+		Signature: smsg.Signature,
+	}
+}
+
+func toPbUnsignedMessage(msg domain.UnsignedMessage) *pbv1.UnsignedMessage {
+	return &pbv1.UnsignedMessage{
+		From:       msg.From,
+		To:         msg.To,
+		Value:      toPbAmount(msg.Value),
+		Nonce:      msg.Nonce,
+		GasLimit:   msg.GasLimit,
+		GasFeeCap:  msg.GasFeeCap,
+		GasPremium: msg.GasPremium,
+	}
+}
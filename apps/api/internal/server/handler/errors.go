@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/codemaestro64/filament/apps/api/internal/domain"
+	pbv1 "github.com/codemaestro64/filament/libs/proto/gen/go/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// connectError converts a service error into a connect error carrying
+// ErrorDetails for the client.
+func connectError(err error) error {
+	code, errCode := connect.CodeInternal, pbv1.ErrorCode_NONE
+
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		code, errCode = connect.CodeNotFound, pbv1.ErrorCode_NOT_FOUND
+	case errors.Is(err, domain.ErrInvalidArgument):
+		code, errCode = connect.CodeInvalidArgument, pbv1.ErrorCode_VALIDATION_FAILED
+	case errors.Is(err, domain.ErrWrongPassword), errors.Is(err, domain.ErrWalletLocked):
+		code = connect.CodeUnauthenticated
+	case errors.Is(err, domain.ErrAlreadyExists):
+		code, errCode = connect.CodeAlreadyExists, pbv1.ErrorCode_ALREADY_EXISTS
+	case errors.Is(err, domain.ErrInsufficientFunds):
+		code, errCode = connect.CodeFailedPrecondition, pbv1.ErrorCode_INSUFFICIENT_FUNDS
+	case errors.Is(err, domain.ErrFailedPrecondition):
+		code = connect.CodeFailedPrecondition
+	case errors.Is(err, domain.ErrRateLimited):
+		code, errCode = connect.CodeResourceExhausted, pbv1.ErrorCode_RATE_LIMITED
+	case errors.Is(err, domain.ErrTooManyAttempts):
+		code, errCode = connect.CodeResourceExhausted, pbv1.ErrorCode_TOO_MANY_ATTEMPTS
+	}
+
+	details := &pbv1.ErrorDetails{
+		Code:    errCode,
+		Message: err.Error(),
+	}
+
+	var verr *domain.ValidationError
+	if errors.As(err, &verr) {
+		for _, v := range verr.Violations {
+			details.Violations = append(details.Violations, &pbv1.FieldViolation{
+				Field:   v.Field,
+				Message: v.Message,
+			})
+		}
+	}
+
+	var attemptErr *domain.AttemptError
+	if errors.As(err, &attemptErr) {
+		remaining := int32(attemptErr.RemainingAttempts)
+		details.RemainingAttempts = &remaining
+		if !attemptErr.RetryAfter.IsZero() {
+			details.RetryAfter = timestamppb.New(attemptErr.RetryAfter)
+		}
+	}
+
+	connectErr := connect.NewError(code, err)
+	if detail, detailErr := connect.NewErrorDetail(details); detailErr == nil {
+		connectErr.AddDetail(detail)
+	}
+
+	return connectErr
+}
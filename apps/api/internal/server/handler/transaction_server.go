@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/codemaestro64/filament/apps/api/internal/domain"
+	"github.com/codemaestro64/filament/apps/api/internal/service"
+	pbv1 "github.com/codemaestro64/filament/libs/proto/gen/go/v1"
+	"github.com/codemaestro64/filament/libs/proto/gen/go/v1/pbv1connect"
+)
+
+type TransactionServer struct {
+	pbv1connect.UnimplementedTransactionServiceHandler
+	transactionService service.TransactionService
+}
+
+func NewTransactionServer(srvc *service.Service, options connect.Option) (string, http.Handler) {
+	transactionServer := &TransactionServer{
+		transactionService: srvc.Transaction,
+	}
+
+	return pbv1connect.NewTransactionServiceHandler(transactionServer, options)
+}
+
+func (s *TransactionServer) SendTransaction(
+	ctx context.Context,
+	req *Request[pbv1.SendTransactionRequest],
+) (*Response[pbv1.SendTransactionResponse], error) {
+
+	sendReq := domain.SendTransactionRequest{
+		SourceWalletID:     int(req.Msg.GetSourceWalletId()),
+		DestinationAddress: req.Msg.GetDestinationAddress(),
+		Amount:             fromPbAmount(req.Msg.GetAmount()),
+		Note:               req.Msg.GetNote(),
+	}
+
+	if req.Msg.MaxFee != nil {
+		maxFee := fromPbAmount(req.Msg.GetMaxFee())
+		sendReq.MaxFee = &maxFee
+	}
+
+	result, err := s.transactionService.SendTransaction(ctx, sendReq)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	resp := &pbv1.SendTransactionResponse{
+		Transaction: toPbTransaction(result.Transaction),
+	}
+
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,422 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/codemaestro64/filament/apps/api/internal/domain"
+	"github.com/codemaestro64/filament/apps/api/internal/service"
+	pbv1 "github.com/codemaestro64/filament/libs/proto/gen/go/v1"
+	"github.com/codemaestro64/filament/libs/proto/gen/go/v1/pbv1connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type WalletServer struct {
+	pbv1connect.UnimplementedWalletServiceHandler
+	walletService service.WalletService
+}
+
+func NewWalletServer(srvc *service.Service, options connect.Option) (string, http.Handler) {
+	walletServer := &WalletServer{
+		walletService: srvc.Wallet,
+	}
+
+	return pbv1connect.NewWalletServiceHandler(walletServer, options)
+}
+
+func (s *WalletServer) GetWallet(
+	ctx context.Context,
+	req *Request[pbv1.GetWalletRequest],
+) (*Response[pbv1.GetWalletResponse], error) {
+
+	result, err := s.walletService.GetWallet(ctx, domain.GetWalletRequest{
+		WalletID: int(req.Msg.GetWalletId()),
+	})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	w := result.Wallet
+	resp := &pbv1.GetWalletResponse{
+		WalletId:  int64(w.ID),
+		IsDefault: w.IsDefault,
+		Name:      w.Name,
+		ActorId:   w.ActorID,
+		Addresses: toPbAddressMap(w.Addresses),
+		Balance:   toPbBalance(w.Balance),
+		CreatedAt: timestamppb.New(w.CreatedAt),
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+func (s *WalletServer) GetWallets(
+	ctx context.Context,
+	_ *Request[pbv1.GetWalletsRequest],
+) (*Response[pbv1.GetWalletsResponse], error) {
+
+	result, err := s.walletService.GetWallets(ctx, domain.GetWalletsRequest{})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	resp := &pbv1.GetWalletsResponse{
+		Wallets: make([]*pbv1.Wallet, 0, len(result.Wallets)),
+	}
+	for _, w := range result.Wallets {
+		resp.Wallets = append(resp.Wallets, toPbWallet(w))
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+func (s *WalletServer) UnlockWallets(
+	ctx context.Context,
+	req *Request[pbv1.UnlockWalletsRequest],
+) (*Response[pbv1.UnlockWalletsResponse], error) {
+
+	result, err := s.walletService.UnlockWallets(ctx, domain.UnlockWalletsRequest{
+		Password: req.Msg.GetPassword(),
+	})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	resp := &pbv1.UnlockWalletsResponse{
+		Results: make([]*pbv1.WalletUnlockResult, 0, len(result.Results)),
+	}
+	for _, r := range result.Results {
+		result := &pbv1.WalletUnlockResult{
+			WalletId: int64(r.WalletID),
+			Status:   toPbUnlockStatus(r.Status),
+		}
+		if !r.RetryAfter.IsZero() {
+			result.RetryAfter = timestamppb.New(r.RetryAfter)
+		}
+
+		resp.Results = append(resp.Results, result)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+func (s *WalletServer) LockWallet(
+	ctx context.Context,
+	req *Request[pbv1.LockWalletRequest],
+) (*Response[pbv1.LockWalletResponse], error) {
+
+	err := s.walletService.LockWallet(ctx, domain.LockWalletRequest{
+		WalletID: int(req.Msg.GetWalletId()),
+	})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&pbv1.LockWalletResponse{}), nil
+}
+
+func (s *WalletServer) LockAll(
+	ctx context.Context,
+	_ *Request[pbv1.LockAllRequest],
+) (*Response[pbv1.LockAllResponse], error) {
+
+	if err := s.walletService.LockAll(ctx); err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&pbv1.LockAllResponse{}), nil
+}
+
+func (s *WalletServer) GetSessionStatus(
+	ctx context.Context,
+	_ *Request[pbv1.GetSessionStatusRequest],
+) (*Response[pbv1.GetSessionStatusResponse], error) {
+
+	result, err := s.walletService.GetSessionStatus(ctx)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	resp := &pbv1.GetSessionStatusResponse{
+		Sessions: make([]*pbv1.WalletSession, 0, len(result.Sessions)),
+	}
+	for _, ws := range result.Sessions {
+		resp.Sessions = append(resp.Sessions, &pbv1.WalletSession{
+			WalletId:   int64(ws.WalletID),
+			UnlockedAt: timestamppb.New(ws.UnlockedAt),
+			ExpiresAt:  timestamppb.New(ws.ExpiresAt),
+			Deadline:   timestamppb.New(ws.Deadline),
+		})
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+func (s *WalletServer) CreateWallet(
+	ctx context.Context,
+	req *Request[pbv1.CreateWalletRequest],
+) (*Response[pbv1.CreateWalletResponse], error) {
+
+	result, err := s.walletService.CreateWallet(ctx, domain.CreateWalletRequest{
+		Name:            req.Msg.GetName(),
+		Password:        req.Msg.GetPassword(),
+		ConfirmPassword: req.Msg.GetConfirmPassword(),
+		Passphrase:      req.Msg.GetPassphrase(),
+		WordCount:       int(req.Msg.GetWordCount()),
+		Language:        fromPbMnemonicLanguage(req.Msg.GetLanguage()),
+	})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&pbv1.CreateWalletResponse{
+		Id:         int64(result.WalletID),
+		SeedPhrase: result.SeedPhrase,
+		Addresses:  toPbAddressMap(result.Addresses),
+	}), nil
+}
+
+func (s *WalletServer) RecoverWallet(
+	ctx context.Context,
+	req *Request[pbv1.RecoverWalletRequest],
+) (*Response[pbv1.RecoverWalletResponse], error) {
+
+	result, err := s.walletService.RecoverWallet(ctx, domain.RecoverWalletRequest{
+		Name:            req.Msg.GetWalletName(),
+		SeedPhrase:      req.Msg.GetSeedPhrase(),
+		Password:        req.Msg.GetPassword(),
+		ConfirmPassword: req.Msg.GetConfirmPassword(),
+		Passphrase:      req.Msg.GetPassphrase(),
+		Language:        fromPbMnemonicLanguage(req.Msg.GetLanguage()),
+	})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&pbv1.RecoverWalletResponse{
+		WalletId: int64(result.WalletID),
+	}), nil
+}
+
+func (s *WalletServer) CreateShareBackup(
+	ctx context.Context,
+	req *Request[pbv1.CreateShareBackupRequest],
+) (*Response[pbv1.CreateShareBackupResponse], error) {
+
+	result, err := s.walletService.CreateShareBackup(ctx, domain.CreateShareBackupRequest{
+		WalletID:  int(req.Msg.GetWalletId()),
+		Password:  req.Msg.GetPassword(),
+		Threshold: int(req.Msg.GetThreshold()),
+		Count:     int(req.Msg.GetShareCount()),
+	})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&pbv1.CreateShareBackupResponse{
+		Shares:        result.Shares,
+		Language:      toPbMnemonicLanguage(result.Language),
+		HasPassphrase: result.HasPassphrase,
+	}), nil
+}
+
+func (s *WalletServer) RecoverWalletFromShares(
+	ctx context.Context,
+	req *Request[pbv1.RecoverWalletFromSharesRequest],
+) (*Response[pbv1.RecoverWalletFromSharesResponse], error) {
+
+	result, err := s.walletService.RecoverWalletFromShares(ctx, domain.RecoverWalletFromSharesRequest{
+		Name:            req.Msg.GetWalletName(),
+		Shares:          req.Msg.GetShares(),
+		Password:        req.Msg.GetPassword(),
+		ConfirmPassword: req.Msg.GetConfirmPassword(),
+		Passphrase:      req.Msg.GetPassphrase(),
+		Language:        fromPbMnemonicLanguage(req.Msg.GetLanguage()),
+	})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&pbv1.RecoverWalletFromSharesResponse{
+		WalletId: int64(result.WalletID),
+	}), nil
+}
+
+func (s *WalletServer) RevealSeedPhrase(
+	ctx context.Context,
+	req *Request[pbv1.RevealSeedPhraseRequest],
+) (*Response[pbv1.RevealSeedPhraseResponse], error) {
+
+	result, err := s.walletService.RevealSeedPhrase(ctx, domain.RevealSeedPhraseRequest{
+		WalletID: int(req.Msg.GetWalletId()),
+		Password: req.Msg.GetPassword(),
+	})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&pbv1.RevealSeedPhraseResponse{
+		Words:         result.Words,
+		Language:      toPbMnemonicLanguage(result.Language),
+		HasPassphrase: result.HasPassphrase,
+	}), nil
+}
+
+func (s *WalletServer) StartBackupVerification(
+	ctx context.Context,
+	req *Request[pbv1.StartBackupVerificationRequest],
+) (*Response[pbv1.StartBackupVerificationResponse], error) {
+
+	result, err := s.walletService.StartBackupVerification(ctx, domain.StartBackupVerificationRequest{
+		WalletID: int(req.Msg.GetWalletId()),
+		Password: req.Msg.GetPassword(),
+	})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	resp := &pbv1.StartBackupVerificationResponse{
+		Positions: make([]uint32, 0, len(result.Positions)),
+		ExpiresAt: timestamppb.New(result.ExpiresAt),
+	}
+	for _, pos := range result.Positions {
+		resp.Positions = append(resp.Positions, uint32(pos))
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+func (s *WalletServer) VerifyBackup(
+	ctx context.Context,
+	req *Request[pbv1.VerifyBackupRequest],
+) (*Response[pbv1.VerifyBackupResponse], error) {
+
+	result, err := s.walletService.VerifyBackup(ctx, domain.VerifyBackupRequest{
+		WalletID: int(req.Msg.GetWalletId()),
+		Words:    req.Msg.GetWords(),
+	})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&pbv1.VerifyBackupResponse{
+		BackupVerifiedAt: timestamppb.New(result.BackupVerifiedAt),
+	}), nil
+}
+
+func (s *WalletServer) ImportKey(
+	ctx context.Context,
+	req *Request[pbv1.ImportKeyRequest],
+) (*Response[pbv1.ImportKeyResponse], error) {
+
+	result, err := s.walletService.ImportKey(ctx, domain.ImportKeyRequest{
+		Name:            req.Msg.GetName(),
+		PrivateKey:      req.Msg.GetPrivateKey(),
+		Password:        req.Msg.GetPassword(),
+		ConfirmPassword: req.Msg.GetConfirmPassword(),
+	})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&pbv1.ImportKeyResponse{
+		WalletId:  int64(result.WalletID),
+		Addresses: toPbAddressMap(result.Addresses),
+	}), nil
+}
+
+func (s *WalletServer) ImportKeystore(
+	ctx context.Context,
+	req *Request[pbv1.ImportKeystoreRequest],
+) (*Response[pbv1.ImportKeystoreResponse], error) {
+
+	result, err := s.walletService.ImportKeystore(ctx, domain.ImportKeystoreRequest{
+		Name:             req.Msg.GetName(),
+		KeystoreJSON:     req.Msg.GetKeystoreJson(),
+		KeystorePassword: req.Msg.GetKeystorePassword(),
+		Password:         req.Msg.GetPassword(),
+		ConfirmPassword:  req.Msg.GetConfirmPassword(),
+	})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&pbv1.ImportKeystoreResponse{
+		WalletId:  int64(result.WalletID),
+		Addresses: toPbAddressMap(result.Addresses),
+	}), nil
+}
+
+func (s *WalletServer) ChangePassword(
+	ctx context.Context,
+	req *Request[pbv1.ChangePasswordRequest],
+) (*Response[pbv1.ChangePasswordResponse], error) {
+
+	result, err := s.walletService.ChangePassword(ctx, domain.ChangePasswordRequest{
+		WalletID:        int(req.Msg.GetWalletId()),
+		OldPassword:     req.Msg.GetOldPassword(),
+		NewPassword:     req.Msg.GetNewPassword(),
+		ConfirmPassword: req.Msg.GetConfirmPassword(),
+		AllWallets:      req.Msg.GetAllWallets(),
+	})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	resp := &pbv1.ChangePasswordResponse{
+		WalletIds: make([]int64, 0, len(result.WalletIDs)),
+	}
+	for _, id := range result.WalletIDs {
+		resp.WalletIds = append(resp.WalletIds, int64(id))
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+func (s *WalletServer) ExportKey(
+	ctx context.Context,
+	req *Request[pbv1.ExportKeyRequest],
+) (*Response[pbv1.ExportKeyResponse], error) {
+
+	format := domain.KeyExportFormatKeyInfo
+	if req.Msg.GetFormat() == pbv1.KeyExportFormat_KEY_EXPORT_FORMAT_KEYSTORE {
+		format = domain.KeyExportFormatKeystore
+	}
+
+	result, err := s.walletService.ExportKey(ctx, domain.ExportKeyRequest{
+		WalletID:       int(req.Msg.GetWalletId()),
+		AccountIndex:   req.Msg.GetAccountIndex(),
+		Password:       req.Msg.GetPassword(),
+		Format:         format,
+		ExportPassword: req.Msg.GetExportPassword(),
+	})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&pbv1.ExportKeyResponse{
+		Key: result.Key,
+	}), nil
+}
+
+func (s *WalletServer) ConvertAddress(
+	ctx context.Context,
+	req *Request[pbv1.ConvertAddressRequest],
+) (*Response[pbv1.ConvertAddressResponse], error) {
+
+	result, err := s.walletService.ConvertAddress(ctx, domain.ConvertAddressRequest{
+		Address: req.Msg.GetAddress(),
+	})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&pbv1.ConvertAddressResponse{
+		Id:        result.ID,
+		Robust:    result.Robust,
+		Delegated: result.Delegated,
+		Eth:       result.Eth,
+		MaskedId:  result.MaskedID,
+	}), nil
+}
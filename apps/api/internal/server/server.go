@@ -82,6 +82,9 @@ func registerHandlers(mux *http.ServeMux, srvc *service.Service) {
 	)
 
 	mux.Handle(handler.NewUserServer(srvc, opts))
+	mux.Handle(handler.NewAdminServer(srvc, opts))
+	mux.Handle(handler.NewTransactionServer(srvc, opts))
+	mux.Handle(handler.NewWalletServer(srvc, opts))
 }
 
 func (s *Server) Shutdown(ctx context.Context) error {
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+
+	"github.com/codemaestro64/filament/apps/api/internal/domain"
+	"github.com/codemaestro64/filament/libs/filwallet"
+	"github.com/codemaestro64/filament/libs/filwallet/address"
+	"github.com/filecoin-project/lotus/chain/types"
+)
+
+type AdminService interface {
+	MigrateWallet(ctx context.Context, req domain.MigrateWalletRequest) (*domain.MigrateWalletResponse, error)
+	GetEndpointHealth(ctx context.Context, req domain.GetEndpointHealthRequest) (*domain.GetEndpointHealthResponse, error)
+}
+
+type adminService struct {
+	walletMgr *filwallet.Manager
+}
+
+func newAdminService(walletMgr *filwallet.Manager) AdminService {
+	return &adminService{
+		walletMgr: walletMgr,
+	}
+}
+
+func (s *adminService) MigrateWallet(ctx context.Context, req domain.MigrateWalletRequest) (*domain.MigrateWalletResponse, error) {
+	migration, err := s.walletMgr.MigrateWallet(ctx, req.WalletID, req.Password, req.Commit, req.BuildSweep)
+	if err != nil {
+		return nil, walletError(err, "error migrating wallet")
+	}
+
+	resp := &domain.MigrateWalletResponse{
+		WalletID:         migration.WalletID,
+		DerivationScheme: migration.DerivationScheme,
+		LegacyAddresses:  migration.LegacyAddresses,
+		NewAddresses:     migration.NewAddresses,
+	}
+
+	for _, sweep := range migration.Sweeps {
+		resp.Sweeps = append(resp.Sweeps, s.toSignedMessage(sweep))
+	}
+
+	return resp, nil
+}
+
+func (s *adminService) GetEndpointHealth(ctx context.Context, req domain.GetEndpointHealthRequest) (*domain.GetEndpointHealthResponse, error) {
+	health := s.walletMgr.RPCHealth()
+
+	resp := &domain.GetEndpointHealthResponse{
+		Endpoints: make([]domain.EndpointHealth, 0, len(health)),
+	}
+	for _, h := range health {
+		resp.Endpoints = append(resp.Endpoints, domain.EndpointHealth{
+			URL:                 h.URL,
+			Healthy:             h.Healthy,
+			InSync:              h.InSync,
+			HeadHeight:          h.HeadHeight,
+			Latency:             h.Latency,
+			ConsecutiveFailures: h.ConsecutiveFailures,
+			LastError:           h.LastError,
+			CheckedAt:           h.CheckedAt,
+			RetryAt:             h.RetryAt,
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *adminService) toSignedMessage(smsg *types.SignedMessage) domain.SignedMessage {
+	return domain.SignedMessage{
+		Message:   s.toUnsignedMessage(&smsg.Message),
+		Cid:       smsg.Cid().String(),
+		Signature: smsg.Signature.Data,
+	}
+}
+
+func (s *adminService) toUnsignedMessage(msg *types.Message) domain.UnsignedMessage {
+	network := s.walletMgr.Network()
+
+	return domain.UnsignedMessage{
+		From:       address.Encode(msg.From, network),
+		To:         address.Encode(msg.To, network),
+		Value:      newAmount(network, msg.Value),
+		Nonce:      msg.Nonce,
+		GasLimit:   msg.GasLimit,
+		GasFeeCap:  msg.GasFeeCap.String(),
+		GasPremium: msg.GasPremium.String(),
+	}
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/codemaestro64/filament/apps/api/internal/domain"
+	"github.com/codemaestro64/filament/apps/api/pkg/util"
+	"github.com/filecoin-project/lotus/chain/types"
+)
+
+// newAmount expresses an attoFIL value in the currency of network.
+func newAmount(network util.Network, value types.BigInt) domain.Amount {
+	return domain.Amount{
+		Value:    value.String(),
+		Ticker:   network.Ticker(),
+		Decimals: util.FILDecimals,
+	}
+}
+
+// parseAmount reads an amount given in attoFIL.
+func parseAmount(a domain.Amount) (types.BigInt, error) {
+	if a.Decimals != 0 && a.Decimals != util.FILDecimals {
+		return types.EmptyInt, fmt.Errorf("must have %d decimals", util.FILDecimals)
+	}
+
+	value, err := types.BigFromString(a.Value)
+	if err != nil || value.Sign() < 0 {
+		return types.EmptyInt, fmt.Errorf("%q is not a non-negative attoFIL amount", a.Value)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/codemaestro64/filament/apps/api/internal/domain"
+	"github.com/codemaestro64/filament/libs/filwallet"
+	"github.com/codemaestro64/filament/libs/filwallet/slip39"
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
+	"github.com/rs/zerolog/log"
+)
+
+// walletErrors maps errors of the wallet library to the domain error that is
+// reported to clients.
+var walletErrors = []struct {
+	err    error
+	domain error
+}{
+	{filwallet.ErrNotFound, domain.ErrNotFound},
+	{filwallet.ErrInvalidPassword, domain.ErrInvalidArgument},
+	{filwallet.ErrInvalidSeedPhrase, domain.ErrInvalidArgument},
+	{filwallet.ErrInvalidWordCount, domain.ErrInvalidArgument},
+	{filwallet.ErrUnsupportedLanguage, domain.ErrInvalidArgument},
+	{filwallet.ErrInvalidWalletName, domain.ErrInvalidArgument},
+	{filwallet.ErrSessionExpired, domain.ErrWalletLocked},
+	{filwallet.ErrInvalidAmount, domain.ErrInvalidArgument},
+	{filwallet.ErrInvalidAddress, domain.ErrInvalidArgument},
+	{filwallet.ErrAddressNotInWallet, domain.ErrInvalidArgument},
+	{filwallet.ErrInsufficientFunds, domain.ErrInsufficientFunds},
+	{filwallet.ErrFeeExceedsMax, domain.ErrFailedPrecondition},
+	{filwallet.ErrLegacyBalance, domain.ErrFailedPrecondition},
+	{filwallet.ErrAccountNotFound, domain.ErrNotFound},
+	{filwallet.ErrRateLimited, domain.ErrRateLimited},
+	{filwallet.ErrTooManyAttempts, domain.ErrTooManyAttempts},
+	{filwallet.ErrInvalidExportFormat, domain.ErrInvalidArgument},
+	{filwallet.ErrNoBackupChallenge, domain.ErrFailedPrecondition},
+	{filwallet.ErrBackupMismatch, domain.ErrInvalidArgument},
+	{filwallet.ErrWalletChanged, domain.ErrFailedPrecondition},
+	{wallet.ErrWrongPassword, domain.ErrWrongPassword},
+	{wallet.ErrWalletAlreadyExists, domain.ErrAlreadyExists},
+	{wallet.ErrNotHDWallet, domain.ErrFailedPrecondition},
+	{wallet.ErrLegacyDerivation, domain.ErrFailedPrecondition},
+	{wallet.ErrAlreadyMigrated, domain.ErrFailedPrecondition},
+	{wallet.ErrUnsupportedKey, domain.ErrInvalidArgument},
+	{slip39.ErrInvalidThreshold, domain.ErrInvalidArgument},
+}
+
+// shareErrors are the errors of SLIP-39 shares that cannot be combined.
+var shareErrors = []error{
+	slip39.ErrInvalidShare,
+	slip39.ErrInvalidChecksum,
+	slip39.ErrMismatchedShares,
+	slip39.ErrInsufficientShares,
+	slip39.ErrInvalidDigest,
+	filwallet.ErrInvalidSeedPhrase,
+}
+
+func isShareError(err error) bool {
+	for _, e := range shareErrors {
+		if errors.Is(err, e) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// walletError translates a wallet library error into a domain error. Unknown
+// errors are logged with msg and hidden behind domain.ErrInternalServer.
+func walletError(err error, msg string) error {
+	var attemptErr *filwallet.AttemptError
+	if errors.As(err, &attemptErr) {
+		return &domain.AttemptError{
+			Err:               walletError(attemptErr.Err, msg),
+			RemainingAttempts: attemptErr.RemainingAttempts,
+			RetryAfter:        attemptErr.RetryAfter,
+		}
+	}
+
+	for _, e := range walletErrors {
+		if errors.Is(err, e.err) {
+			return fmt.Errorf("%w: %w", e.domain, e.err)
+		}
+	}
+
+	log.Error().Err(err).Msg(msg)
+	return domain.ErrInternalServer
+}
@@ -6,7 +6,10 @@ import (
 )
 
 type Service struct {
-	User UserService
+	User        UserService
+	Admin       AdminService
+	Transaction TransactionService
+	Wallet      WalletService
 }
 
 func New(
@@ -14,6 +17,9 @@ func New(
 	walletMgr *filwallet.Manager,
 ) *Service {
 	return &Service{
-		User: newUserService(repo, walletMgr),
+		User:        newUserService(repo, walletMgr),
+		Admin:       newAdminService(walletMgr),
+		Transaction: newTransactionService(walletMgr),
+		Wallet:      newWalletService(repo, walletMgr),
 	}
 }
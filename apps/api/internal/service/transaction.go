@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/codemaestro64/filament/apps/api/internal/domain"
+	"github.com/codemaestro64/filament/apps/api/pkg/util"
+	"github.com/codemaestro64/filament/libs/filwallet"
+	"github.com/codemaestro64/filament/libs/filwallet/address"
+	goaddress "github.com/filecoin-project/go-address"
+	"github.com/rs/zerolog/log"
+)
+
+type TransactionService interface {
+	SendTransaction(ctx context.Context, req domain.SendTransactionRequest) (*domain.SendTransactionResponse, error)
+}
+
+type transactionService struct {
+	walletMgr *filwallet.Manager
+}
+
+func newTransactionService(walletMgr *filwallet.Manager) TransactionService {
+	return &transactionService{
+		walletMgr: walletMgr,
+	}
+}
+
+func (s *transactionService) SendTransaction(ctx context.Context, req domain.SendTransactionRequest) (*domain.SendTransactionResponse, error) {
+	network := s.walletMgr.Network()
+
+	var verr domain.ValidationError
+	params := filwallet.SendParams{
+		WalletID: req.SourceWalletID,
+	}
+
+	to, err := parseDestination(req.DestinationAddress, network)
+	if err != nil {
+		verr.Add("destination_address", err.Error())
+	}
+	params.To = to
+
+	params.Value, err = parseAmount(req.Amount)
+	if err != nil {
+		verr.Add("amount", err.Error())
+	}
+
+	if req.MaxFee != nil {
+		maxFee, err := parseAmount(*req.MaxFee)
+		if err != nil {
+			verr.Add("max_fee", err.Error())
+		}
+		params.MaxFee = &maxFee
+	}
+
+	if err := verr.ErrOrNil(); err != nil {
+		return nil, err
+	}
+
+	sent, err := s.walletMgr.Send(ctx, params)
+	if err != nil {
+		return nil, walletError(err, "error sending transaction")
+	}
+
+	log.Info().
+		Int("wallet_id", req.SourceWalletID).
+		Stringer("cid", sent.Cid).
+		Int("note_length", len(req.Note)).
+		Msg("transaction sent")
+
+	msg := sent.Message.Message
+
+	return &domain.SendTransactionResponse{
+		Transaction: domain.Transaction{
+			ID:                 sent.Cid.String(),
+			Type:               domain.TransactionTypeSend,
+			Status:             domain.TransactionStatusPending,
+			Amount:             newAmount(network, msg.Value),
+			SourceAddress:      toAddress(msg.From, network),
+			DestinationAddress: toAddress(msg.To, network),
+			Fee:                newAmount(network, sent.MaxFee),
+			CreatedAt:          time.Now(),
+		},
+	}, nil
+}
+
+// parseDestination validates a destination address, including its checksum
+// and that it belongs to network.
+func parseDestination(raw string, network util.Network) (goaddress.Address, error) {
+	addr, err := address.ParseOnNetwork(raw, network)
+	if err != nil {
+		return goaddress.Undef, err
+	}
+
+	if addr.Type == address.Type0X {
+		return goaddress.Undef, fmt.Errorf("0x destinations are not supported, use the f410 form")
+	}
+
+	return goaddress.NewFromString(addr.Value)
+}
+
+func toAddress(addr goaddress.Address, network util.Network) address.Address {
+	encoded := address.Encode(addr, network)
+	parsed, err := address.Parse(encoded)
+	if err != nil {
+		return address.Address{Value: encoded}
+	}
+
+	return parsed
+}
@@ -0,0 +1,561 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/codemaestro64/filament/apps/api/internal/domain"
+	"github.com/codemaestro64/filament/apps/api/internal/repository"
+	"github.com/codemaestro64/filament/libs/filwallet"
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
+	"github.com/rs/zerolog/log"
+)
+
+type WalletService interface {
+	GetWallet(ctx context.Context, req domain.GetWalletRequest) (*domain.GetWalletResponse, error)
+	GetWallets(ctx context.Context, req domain.GetWalletsRequest) (*domain.GetWalletsResponse, error)
+	UnlockWallets(ctx context.Context, req domain.UnlockWalletsRequest) (*domain.UnlockWalletsResponse, error)
+	LockWallet(ctx context.Context, req domain.LockWalletRequest) error
+	LockAll(ctx context.Context) error
+	GetSessionStatus(ctx context.Context) (*domain.GetSessionStatusResponse, error)
+	CreateWallet(ctx context.Context, req domain.CreateWalletRequest) (*domain.CreateWalletResponse, error)
+	RecoverWallet(ctx context.Context, req domain.RecoverWalletRequest) (*domain.RecoverWalletResponse, error)
+	CreateShareBackup(ctx context.Context, req domain.CreateShareBackupRequest) (*domain.CreateShareBackupResponse, error)
+	RecoverWalletFromShares(ctx context.Context, req domain.RecoverWalletFromSharesRequest) (*domain.RecoverWalletFromSharesResponse, error)
+	RevealSeedPhrase(ctx context.Context, req domain.RevealSeedPhraseRequest) (*domain.RevealSeedPhraseResponse, error)
+	StartBackupVerification(ctx context.Context, req domain.StartBackupVerificationRequest) (*domain.StartBackupVerificationResponse, error)
+	VerifyBackup(ctx context.Context, req domain.VerifyBackupRequest) (*domain.VerifyBackupResponse, error)
+	ImportKey(ctx context.Context, req domain.ImportKeyRequest) (*domain.ImportKeyResponse, error)
+	ImportKeystore(ctx context.Context, req domain.ImportKeystoreRequest) (*domain.ImportKeystoreResponse, error)
+	ChangePassword(ctx context.Context, req domain.ChangePasswordRequest) (*domain.ChangePasswordResponse, error)
+	ExportKey(ctx context.Context, req domain.ExportKeyRequest) (*domain.ExportKeyResponse, error)
+	ConvertAddress(ctx context.Context, req domain.ConvertAddressRequest) (*domain.ConvertAddressResponse, error)
+}
+
+type walletService struct {
+	walletMgr  *filwallet.Manager
+	walletRepo repository.WalletRepo
+}
+
+func newWalletService(repo *repository.Repository, walletMgr *filwallet.Manager) WalletService {
+	return &walletService{
+		walletMgr:  walletMgr,
+		walletRepo: repo.Wallet,
+	}
+}
+
+func (s *walletService) GetWallet(ctx context.Context, req domain.GetWalletRequest) (*domain.GetWalletResponse, error) {
+	w, err := s.walletRepo.FindWallet(ctx, req.WalletID)
+	if err != nil {
+		return nil, walletError(err, "error fetching wallet")
+	}
+
+	return &domain.GetWalletResponse{
+		Wallet: s.toWallet(ctx, w),
+	}, nil
+}
+
+func (s *walletService) GetWallets(ctx context.Context, req domain.GetWalletsRequest) (*domain.GetWalletsResponse, error) {
+	wallets, err := s.walletRepo.GetWallets(ctx)
+	if err != nil {
+		return nil, walletError(err, "error fetching wallets")
+	}
+
+	resp := &domain.GetWalletsResponse{
+		Wallets: make([]domain.Wallet, 0, len(wallets)),
+	}
+	for _, w := range wallets {
+		resp.Wallets = append(resp.Wallets, s.toWallet(ctx, w))
+	}
+
+	return resp, nil
+}
+
+func (s *walletService) UnlockWallets(ctx context.Context, req domain.UnlockWalletsRequest) (*domain.UnlockWalletsResponse, error) {
+	if req.Password == "" {
+		verr := &domain.ValidationError{}
+		verr.Add("password", "is required")
+		return nil, verr
+	}
+
+	results, err := s.walletMgr.UnlockAllWallets(ctx, req.Password)
+	if err != nil {
+		return nil, walletError(err, "error unlocking wallets")
+	}
+
+	resp := &domain.UnlockWalletsResponse{
+		Results: make([]domain.WalletUnlockResult, 0, len(results)),
+	}
+	for _, r := range results {
+		status := domain.UnlockStatusUnlocked
+		switch r.Status {
+		case filwallet.UnlockStatusWrongPassword:
+			status = domain.UnlockStatusWrongPassword
+		case filwallet.UnlockStatusCorrupted:
+			status = domain.UnlockStatusCorrupted
+			log.Error().Err(r.Err).Int("wallet_id", r.WalletID).Msg("wallet key could not be decrypted")
+		case filwallet.UnlockStatusLockedOut:
+			status = domain.UnlockStatusLockedOut
+		}
+
+		resp.Results = append(resp.Results, domain.WalletUnlockResult{
+			WalletID:   r.WalletID,
+			Status:     status,
+			RetryAfter: r.RetryAfter,
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *walletService) LockWallet(ctx context.Context, req domain.LockWalletRequest) error {
+	if _, err := s.walletRepo.FindWallet(ctx, req.WalletID); err != nil {
+		return walletError(err, "error fetching wallet")
+	}
+
+	s.walletMgr.LockWallet(req.WalletID)
+	log.Info().Int("wallet_id", req.WalletID).Msg("wallet locked")
+
+	return nil
+}
+
+func (s *walletService) LockAll(_ context.Context) error {
+	s.walletMgr.LockAll()
+	log.Info().Msg("all wallets locked")
+
+	return nil
+}
+
+func (s *walletService) GetSessionStatus(_ context.Context) (*domain.GetSessionStatusResponse, error) {
+	statuses := s.walletMgr.SessionStatus()
+
+	resp := &domain.GetSessionStatusResponse{
+		Sessions: make([]domain.WalletSession, 0, len(statuses)),
+	}
+	for _, st := range statuses {
+		resp.Sessions = append(resp.Sessions, domain.WalletSession{
+			WalletID:   st.WalletID,
+			UnlockedAt: st.UnlockedAt,
+			ExpiresAt:  st.ExpiresAt,
+			Deadline:   st.Deadline,
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *walletService) CreateWallet(ctx context.Context, req domain.CreateWalletRequest) (*domain.CreateWalletResponse, error) {
+	var verr domain.ValidationError
+	if req.Name == "" {
+		verr.Add("name", "is required")
+	}
+	if req.Password == "" {
+		verr.Add("password", "is required")
+	}
+	if req.Password != req.ConfirmPassword {
+		verr.Add("confirm_password", "does not match password")
+	}
+	if _, err := filwallet.WordCountBits(req.WordCount); err != nil {
+		verr.Add("word_count", err.Error())
+	}
+	if lang := filwallet.Language(req.Language); lang != "" && !lang.Valid() {
+		verr.Add("language", filwallet.ErrUnsupportedLanguage.Error())
+	}
+	if err := verr.ErrOrNil(); err != nil {
+		return nil, err
+	}
+
+	w, mnemonic, err := s.walletMgr.CreateWallet(ctx, filwallet.CreateWalletParams{
+		Name:       req.Name,
+		Password:   req.Password,
+		Passphrase: req.Passphrase,
+		WordCount:  req.WordCount,
+		Language:   filwallet.Language(req.Language),
+	})
+	if err != nil {
+		return nil, walletError(err, "error creating wallet")
+	}
+
+	return &domain.CreateWalletResponse{
+		WalletID:   w.ID,
+		SeedPhrase: mnemonic,
+		Addresses:  w.Addresses(),
+	}, nil
+}
+
+func (s *walletService) RecoverWallet(ctx context.Context, req domain.RecoverWalletRequest) (*domain.RecoverWalletResponse, error) {
+	var verr domain.ValidationError
+	if req.Name == "" {
+		verr.Add("wallet_name", "is required")
+	}
+	if req.SeedPhrase == "" {
+		verr.Add("seed_phrase", "is required")
+	}
+	if req.Password == "" {
+		verr.Add("password", "is required")
+	}
+	if req.Password != req.ConfirmPassword {
+		verr.Add("confirm_password", "does not match password")
+	}
+	if lang := filwallet.Language(req.Language); lang != "" && !lang.Valid() {
+		verr.Add("language", filwallet.ErrUnsupportedLanguage.Error())
+	}
+	if err := verr.ErrOrNil(); err != nil {
+		return nil, err
+	}
+
+	w, err := s.walletMgr.RecoverWallet(ctx, filwallet.RecoverWalletParams{
+		SeedPhrase: req.SeedPhrase,
+		Passphrase: req.Passphrase,
+		Language:   filwallet.Language(req.Language),
+		Name:       req.Name,
+		Password:   req.Password,
+	})
+	if err != nil {
+		if errors.Is(err, filwallet.ErrInvalidSeedPhrase) {
+			verr.Add("seed_phrase", err.Error())
+			return nil, &verr
+		}
+		return nil, walletError(err, "error recovering wallet")
+	}
+
+	return &domain.RecoverWalletResponse{
+		WalletID: w.ID,
+	}, nil
+}
+
+func (s *walletService) CreateShareBackup(ctx context.Context, req domain.CreateShareBackupRequest) (*domain.CreateShareBackupResponse, error) {
+	var verr domain.ValidationError
+	if req.Password == "" {
+		verr.Add("password", "is required")
+	}
+	if req.Count < 1 || req.Count > 16 {
+		verr.Add("share_count", "must be between 1 and 16")
+	}
+	if req.Threshold < 1 || req.Threshold > req.Count {
+		verr.Add("threshold", "must be between 1 and share_count")
+	} else if req.Threshold == 1 && req.Count > 1 {
+		verr.Add("threshold", "must be at least 2 for more than one share")
+	}
+	if err := verr.ErrOrNil(); err != nil {
+		return nil, err
+	}
+
+	backup, err := s.walletMgr.CreateShareBackup(ctx, filwallet.CreateShareBackupParams{
+		WalletID:  req.WalletID,
+		Password:  req.Password,
+		Threshold: req.Threshold,
+		Count:     req.Count,
+	})
+	if err != nil {
+		return nil, walletError(err, "error creating share backup")
+	}
+
+	log.Warn().
+		Int("wallet_id", req.WalletID).
+		Int("threshold", req.Threshold).
+		Int("share_count", req.Count).
+		Msg("seed share backup created")
+
+	return &domain.CreateShareBackupResponse{
+		Shares:        backup.Shares,
+		Language:      string(backup.Language),
+		HasPassphrase: backup.HasPassphrase,
+	}, nil
+}
+
+func (s *walletService) RecoverWalletFromShares(ctx context.Context, req domain.RecoverWalletFromSharesRequest) (*domain.RecoverWalletFromSharesResponse, error) {
+	var verr domain.ValidationError
+	if req.Name == "" {
+		verr.Add("wallet_name", "is required")
+	}
+	if len(req.Shares) == 0 {
+		verr.Add("shares", "is required")
+	}
+	if req.Password == "" {
+		verr.Add("password", "is required")
+	}
+	if req.Password != req.ConfirmPassword {
+		verr.Add("confirm_password", "does not match password")
+	}
+	if lang := filwallet.Language(req.Language); lang != "" && !lang.Valid() {
+		verr.Add("language", filwallet.ErrUnsupportedLanguage.Error())
+	}
+	if err := verr.ErrOrNil(); err != nil {
+		return nil, err
+	}
+
+	w, err := s.walletMgr.RecoverWalletFromShares(ctx, filwallet.RecoverFromSharesParams{
+		Shares:     req.Shares,
+		Passphrase: req.Passphrase,
+		Language:   filwallet.Language(req.Language),
+		Name:       req.Name,
+		Password:   req.Password,
+	})
+	if err != nil {
+		if isShareError(err) {
+			verr.Add("shares", err.Error())
+			return nil, &verr
+		}
+		return nil, walletError(err, "error recovering wallet from shares")
+	}
+
+	return &domain.RecoverWalletFromSharesResponse{
+		WalletID: w.ID,
+	}, nil
+}
+
+// RevealSeedPhrase returns the words of a wallet seed. They are secret and
+// must never be logged.
+func (s *walletService) RevealSeedPhrase(ctx context.Context, req domain.RevealSeedPhraseRequest) (*domain.RevealSeedPhraseResponse, error) {
+	if req.Password == "" {
+		verr := &domain.ValidationError{}
+		verr.Add("password", "is required")
+		return nil, verr
+	}
+
+	seed, err := s.walletMgr.RevealSeedPhrase(ctx, req.WalletID, req.Password)
+	if err != nil {
+		return nil, walletError(err, "error revealing seed phrase")
+	}
+
+	log.Warn().Int("wallet_id", req.WalletID).Msg("seed phrase revealed")
+
+	return &domain.RevealSeedPhraseResponse{
+		Words:         seed.Words,
+		Language:      string(seed.Language),
+		HasPassphrase: seed.HasPassphrase,
+	}, nil
+}
+
+func (s *walletService) StartBackupVerification(ctx context.Context, req domain.StartBackupVerificationRequest) (*domain.StartBackupVerificationResponse, error) {
+	if req.Password == "" {
+		verr := &domain.ValidationError{}
+		verr.Add("password", "is required")
+		return nil, verr
+	}
+
+	challenge, err := s.walletMgr.StartBackupVerification(ctx, req.WalletID, req.Password)
+	if err != nil {
+		return nil, walletError(err, "error starting backup verification")
+	}
+
+	return &domain.StartBackupVerificationResponse{
+		Positions: challenge.Positions,
+		ExpiresAt: challenge.ExpiresAt,
+	}, nil
+}
+
+func (s *walletService) VerifyBackup(ctx context.Context, req domain.VerifyBackupRequest) (*domain.VerifyBackupResponse, error) {
+	var verr domain.ValidationError
+	if len(req.Words) == 0 {
+		verr.Add("words", "is required")
+		return nil, &verr
+	}
+
+	verifiedAt, err := s.walletMgr.VerifyBackup(ctx, req.WalletID, req.Words)
+	if err != nil {
+		if errors.Is(err, filwallet.ErrBackupMismatch) {
+			verr.Add("words", err.Error())
+			return nil, &verr
+		}
+		return nil, walletError(err, "error verifying backup")
+	}
+
+	log.Info().Int("wallet_id", req.WalletID).Msg("seed phrase backup verified")
+
+	return &domain.VerifyBackupResponse{
+		BackupVerifiedAt: verifiedAt,
+	}, nil
+}
+
+func (s *walletService) ImportKey(ctx context.Context, req domain.ImportKeyRequest) (*domain.ImportKeyResponse, error) {
+	var verr domain.ValidationError
+	if req.Name == "" {
+		verr.Add("name", "is required")
+	}
+	if req.PrivateKey == "" {
+		verr.Add("private_key", "is required")
+	}
+	if req.Password == "" {
+		verr.Add("password", "is required")
+	}
+	if req.Password != req.ConfirmPassword {
+		verr.Add("confirm_password", "does not match password")
+	}
+	if err := verr.ErrOrNil(); err != nil {
+		return nil, err
+	}
+
+	w, err := s.walletMgr.ImportPrivateKey(ctx, req.PrivateKey, req.Name, req.Password)
+	if err != nil {
+		if errors.Is(err, wallet.ErrInvalidPrivateKey) || errors.Is(err, wallet.ErrInvalidKeyInfo) ||
+			errors.Is(err, wallet.ErrUnsupportedKey) {
+			verr.Add("private_key", err.Error())
+			return nil, &verr
+		}
+		return nil, walletError(err, "error importing key")
+	}
+
+	return &domain.ImportKeyResponse{
+		WalletID:  w.ID,
+		Addresses: w.Addresses(),
+	}, nil
+}
+
+func (s *walletService) ImportKeystore(ctx context.Context, req domain.ImportKeystoreRequest) (*domain.ImportKeystoreResponse, error) {
+	var verr domain.ValidationError
+	if req.Name == "" {
+		verr.Add("name", "is required")
+	}
+	if req.KeystoreJSON == "" {
+		verr.Add("keystore_json", "is required")
+	}
+	if req.Password == "" {
+		verr.Add("password", "is required")
+	}
+	if req.Password != req.ConfirmPassword {
+		verr.Add("confirm_password", "does not match password")
+	}
+	if err := verr.ErrOrNil(); err != nil {
+		return nil, err
+	}
+
+	w, err := s.walletMgr.ImportKeystore(ctx, []byte(req.KeystoreJSON), req.KeystorePassword, req.Name, req.Password)
+	if err != nil {
+		switch {
+		case errors.Is(err, wallet.ErrInvalidKeystore):
+			verr.Add("keystore_json", err.Error())
+			return nil, &verr
+		case errors.Is(err, wallet.ErrWrongKeystorePassword):
+			verr.Add("keystore_password", err.Error())
+			return nil, &verr
+		}
+		return nil, walletError(err, "error importing keystore")
+	}
+
+	return &domain.ImportKeystoreResponse{
+		WalletID:  w.ID,
+		Addresses: w.Addresses(),
+	}, nil
+}
+
+func (s *walletService) ChangePassword(ctx context.Context, req domain.ChangePasswordRequest) (*domain.ChangePasswordResponse, error) {
+	var verr domain.ValidationError
+	if req.OldPassword == "" {
+		verr.Add("old_password", "is required")
+	}
+	if req.NewPassword == "" {
+		verr.Add("new_password", "is required")
+	}
+	if req.NewPassword != req.ConfirmPassword {
+		verr.Add("confirm_password", "does not match new password")
+	}
+	if err := verr.ErrOrNil(); err != nil {
+		return nil, err
+	}
+
+	if !req.AllWallets {
+		if err := s.walletMgr.ChangePassword(ctx, req.WalletID, req.OldPassword, req.NewPassword); err != nil {
+			return nil, walletError(err, "error changing wallet password")
+		}
+
+		return &domain.ChangePasswordResponse{WalletIDs: []int{req.WalletID}}, nil
+	}
+
+	ids, err := s.walletMgr.ChangePasswordAll(ctx, req.OldPassword, req.NewPassword)
+	if err != nil {
+		return nil, walletError(err, "error changing wallet passwords")
+	}
+
+	return &domain.ChangePasswordResponse{WalletIDs: ids}, nil
+}
+
+func (s *walletService) ExportKey(ctx context.Context, req domain.ExportKeyRequest) (*domain.ExportKeyResponse, error) {
+	var verr domain.ValidationError
+	if req.Password == "" {
+		verr.Add("password", "is required")
+	}
+
+	format := filwallet.ExportFormatKeyInfo
+	switch req.Format {
+	case domain.KeyExportFormatKeyInfo:
+	case domain.KeyExportFormatKeystore:
+		format = filwallet.ExportFormatKeystore
+		if req.ExportPassword == "" {
+			verr.Add("export_password", "is required for keystore exports")
+		}
+	default:
+		verr.Add("format", "is not supported")
+	}
+
+	if err := verr.ErrOrNil(); err != nil {
+		return nil, err
+	}
+
+	key, err := s.walletMgr.ExportKey(ctx, filwallet.ExportKeyParams{
+		WalletID:       req.WalletID,
+		AccountIndex:   req.AccountIndex,
+		Password:       req.Password,
+		Format:         format,
+		ExportPassword: req.ExportPassword,
+	})
+	if err != nil {
+		return nil, walletError(err, "error exporting key")
+	}
+
+	log.Warn().
+		Int("wallet_id", req.WalletID).
+		Uint32("account_index", req.AccountIndex).
+		Str("format", string(format)).
+		Msg("private key exported")
+
+	return &domain.ExportKeyResponse{
+		Key: key,
+	}, nil
+}
+
+func (s *walletService) ConvertAddress(ctx context.Context, req domain.ConvertAddressRequest) (*domain.ConvertAddressResponse, error) {
+	eq, err := s.walletMgr.ConvertAddress(ctx, req.Address)
+	if err != nil {
+		if errors.Is(err, filwallet.ErrInvalidAddress) {
+			verr := &domain.ValidationError{}
+			verr.Add("address", err.Error())
+			return nil, verr
+		}
+		return nil, walletError(err, "error converting address")
+	}
+
+	return &domain.ConvertAddressResponse{
+		ID:        eq.ID,
+		Robust:    eq.Robust,
+		Delegated: eq.Delegated,
+		Eth:       eq.Eth,
+		MaskedID:  eq.MaskedID,
+	}, nil
+}
+
+// toWallet converts w and attaches its balance. A failed balance lookup is
+// logged and leaves the balance unset rather than failing the request.
+func (s *walletService) toWallet(ctx context.Context, w *wallet.Wallet) domain.Wallet {
+	result := domain.Wallet{
+		ID:               w.ID,
+		IsDefault:        w.IsDefault,
+		Name:             w.Name,
+		ActorID:          w.ActorID,
+		Addresses:        w.Addresses(),
+		CreatedAt:        w.CreatedAt,
+		BackupVerifiedAt: w.BackupVerifiedAt,
+	}
+
+	balance, err := s.walletMgr.WalletBalance(ctx, w)
+	if err != nil {
+		log.Warn().Err(err).Int("wallet_id", w.ID).Msg("error fetching wallet balance")
+		return result
+	}
+
+	amount := newAmount(s.walletMgr.Network(), balance)
+	result.Balance = &amount
+
+	return result
+}
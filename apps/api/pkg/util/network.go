@@ -7,10 +7,22 @@ const (
 	CalibrationNet Network = "calibration"
 )
 
+// FILDecimals is the number of decimal places between FIL and attoFIL.
+const FILDecimals = 18
+
 func (n Network) IsMainnet() bool {
 	return n == Mainnet
 }
 
+// Ticker returns the currency symbol of the network.
+func (n Network) Ticker() string {
+	if n.IsMainnet() {
+		return "FIL"
+	}
+
+	return "tFIL"
+}
+
 func (n Network) String() string {
 	return string(n)
 }
@@ -0,0 +1,77 @@
+package filwallet
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/codemaestro64/filament/libs/filwallet/address"
+	goaddress "github.com/filecoin-project/go-address"
+)
+
+// actorResolveInterval is how often addresses without an actor ID are looked
+// up again. Actors only appear once an address first receives funds.
+const actorResolveInterval = 2 * time.Minute
+
+// startActorResolver resolves missing actor IDs until ctx is done.
+func (m *Manager) startActorResolver(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(actorResolveInterval)
+		defer ticker.Stop()
+
+		for {
+			// Failures are retried on the next tick.
+			_ = m.ResolveActorIDs(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// ResolveActorIDs looks up the f0 ID of every f1, f3 and f4 address that does
+// not have one yet and stores those whose actor exists on chain.
+func (m *Manager) ResolveActorIDs(ctx context.Context) error {
+	wallets, err := m.store.GetWallets(ctx)
+	if err != nil {
+		return fmt.Errorf("get wallets: %w", err)
+	}
+
+	for _, w := range wallets {
+		actorIDs := make(map[string]string)
+		for _, addr := range w.Addresses() {
+			if addr.ActorID != "" || (addr.Type != address.TypeF1 && addr.Type != address.TypeF3 && addr.Type != address.TypeF4) {
+				continue
+			}
+
+			filAddr, err := goaddress.NewFromString(addr.Value)
+			if err != nil {
+				return fmt.Errorf("parse address %s: %w", addr, err)
+			}
+
+			// Lookups fail until the actor exists, so errors are not fatal.
+			id, err := m.rpcClient.StateLookupID(ctx, filAddr)
+			if err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				continue
+			}
+
+			actorIDs[addr.Value] = address.Encode(id, m.cfg.Network)
+		}
+
+		if len(actorIDs) == 0 {
+			continue
+		}
+
+		if err := m.store.UpdateActorIDs(ctx, w.ID, actorIDs); err != nil {
+			return fmt.Errorf("update actor ids of wallet %d: %w", w.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -2,9 +2,13 @@ package address
 
 import (
 	"crypto/ecdsa"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/codemaestro64/filament/apps/api/pkg/util"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/filecoin-project/go-address"
 )
@@ -13,32 +17,104 @@ const (
 	evmNamespace uint64 = 10
 )
 
-// Parse takes a raw string and returns an Address struct.
+// Parse fully decodes raw and verifies its checksum. Filecoin addresses may
+// use the mainnet f or testnet t prefix. 0x addresses must be 20 bytes of hex;
+// mixed-case input must carry a valid EIP-55 checksum.
 func Parse(raw string) (Address, error) {
-	if len(raw) < 3 {
-		return Address{}, errors.New("address too short")
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return Address{}, ErrEmpty
+	}
+
+	if strings.HasPrefix(raw, "0x") || strings.HasPrefix(raw, "0X") {
+		return parseEthAddress(raw)
+	}
+
+	if raw[0] != address.MainnetPrefix[0] && raw[0] != address.TestnetPrefix[0] {
+		return Address{}, fmt.Errorf("%w: %q", ErrUnknownNetwork, raw[:1])
+	}
+
+	// Undef decodes without error, it is never a valid destination.
+	if raw == address.UndefAddressString {
+		return Address{}, ErrEmpty
+	}
+
+	addr, err := address.NewFromString(raw)
+	if err != nil {
+		return Address{}, decodeError(err)
 	}
 
 	var t Type
-	switch raw[:2] {
-	case "f1":
+	switch addr.Protocol() {
+	case address.ID:
+		t = TypeF0
+	case address.SECP256K1:
 		t = TypeF1
-	case "f3":
+	case address.Actor:
+		t = TypeF2
+	case address.BLS:
 		t = TypeF3
-	case "f4":
+	case address.Delegated:
 		t = TypeF4
-	case "0x":
-		t = Type0X
 	default:
-		return Address{}, fmt.Errorf("unknown prefix")
+		return Address{}, ErrUnknownProtocol
 	}
+
 	return Address{Type: t, Value: raw}, nil
 }
 
-// DeriveAddressesFromPrivateKey returns all standard Filecoin address formats
-func DeriveAddressesFromPrivateKey(privKey *ecdsa.PrivateKey) ([]Address, error) {
-	// f1 address (legacy secp256k1)
-	pubBytes := crypto.CompressPubkey(&privKey.PublicKey)
+func parseEthAddress(raw string) (Address, error) {
+	hexPart := raw[2:]
+	if len(hexPart) != 2*common.AddressLength {
+		return Address{}, fmt.Errorf("%w: 0x address must have %d hex digits", ErrInvalidLength, 2*common.AddressLength)
+	}
+
+	if _, err := hex.DecodeString(hexPart); err != nil {
+		return Address{}, fmt.Errorf("%w: not hexadecimal", ErrInvalidPayload)
+	}
+
+	checksummed := common.HexToAddress(hexPart).Hex()
+	mixedCase := strings.ToLower(hexPart) != hexPart && strings.ToUpper(hexPart) != hexPart
+	if mixedCase && "0x"+hexPart != checksummed {
+		return Address{}, fmt.Errorf("%w: EIP-55 checksum mismatch", ErrInvalidChecksum)
+	}
+
+	return Address{Type: Type0X, Value: checksummed}, nil
+}
+
+// decodeError maps go-address errors to the errors of this package.
+func decodeError(err error) error {
+	switch {
+	case errors.Is(err, address.ErrUnknownNetwork):
+		return ErrUnknownNetwork
+	case errors.Is(err, address.ErrUnknownProtocol):
+		return ErrUnknownProtocol
+	case errors.Is(err, address.ErrInvalidLength):
+		return ErrInvalidLength
+	case errors.Is(err, address.ErrInvalidChecksum):
+		return ErrInvalidChecksum
+	case errors.Is(err, address.ErrInvalidPayload):
+		return ErrInvalidPayload
+	default:
+		return fmt.Errorf("%w: %w", ErrInvalidPayload, err)
+	}
+}
+
+// DeriveBLSAddress returns the f3 address of a compressed BLS public key.
+func DeriveBLSAddress(pubKey []byte, network util.Network) (Address, error) {
+	f3Addr, err := address.NewBLSAddress(pubKey)
+	if err != nil {
+		return Address{}, fmt.Errorf("derive f3 address: %w", err)
+	}
+
+	return Address{Type: TypeF3, Value: Encode(f3Addr, network)}, nil
+}
+
+// DeriveAddressesFromPrivateKey returns all standard Filecoin address formats,
+// prefixed for network.
+func DeriveAddressesFromPrivateKey(privKey *ecdsa.PrivateKey, network util.Network) ([]Address, error) {
+	// f1 address (secp256k1), the hash of the uncompressed public key
+	pubBytes := crypto.FromECDSAPub(&privKey.PublicKey)
 	f1Addr, err := address.NewSecp256k1Address(pubBytes)
 	if err != nil {
 		return nil, fmt.Errorf("derive f1 address: %w", err)
@@ -52,8 +128,8 @@ func DeriveAddressesFromPrivateKey(privKey *ecdsa.PrivateKey) ([]Address, error)
 	}
 
 	return []Address{
-		{Type: TypeF1, Value: f1Addr.String()},
-		{Type: TypeF4, Value: f4Addr.String()},
+		{Type: TypeF1, Value: Encode(f1Addr, network)},
+		{Type: TypeF4, Value: Encode(f4Addr, network)},
 		{Type: Type0X, Value: ethAddr.Hex()},
 	}, nil
 }
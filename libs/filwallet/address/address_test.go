@@ -0,0 +1,124 @@
+package address
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/codemaestro64/filament/apps/api/pkg/util"
+)
+
+// Addresses of the same actors, encoded without a network prefix.
+const (
+	idPayload   = "01234"
+	f1Payload   = "1izzn6pmgcz6nejjb7xdwkmivpi6evtl7i5u37la"
+	f2Payload   = "2v33d7lvwzzbi4gc4godxslx7bljd7eb2pye74ga"
+	f3Payload   = "3uaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaab2uf6gia"
+	f410Payload = "410fkkiiiaajqutyq3qpoayangcx2lsbnhxhe25pnua"
+	ethAddress  = "0x52908400098527886E0F7030069857D2E4169EE7"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    Address
+		wantErr error
+	}{
+		{name: "f0", raw: "f" + idPayload, want: Address{Type: TypeF0, Value: "f" + idPayload}},
+		{name: "t0", raw: "t" + idPayload, want: Address{Type: TypeF0, Value: "t" + idPayload}},
+		{name: "f1", raw: "f" + f1Payload, want: Address{Type: TypeF1, Value: "f" + f1Payload}},
+		{name: "t1", raw: "t" + f1Payload, want: Address{Type: TypeF1, Value: "t" + f1Payload}},
+		{name: "f2", raw: "f" + f2Payload, want: Address{Type: TypeF2, Value: "f" + f2Payload}},
+		{name: "t2", raw: "t" + f2Payload, want: Address{Type: TypeF2, Value: "t" + f2Payload}},
+		{name: "f3", raw: "f" + f3Payload, want: Address{Type: TypeF3, Value: "f" + f3Payload}},
+		{name: "t3", raw: "t" + f3Payload, want: Address{Type: TypeF3, Value: "t" + f3Payload}},
+		{name: "f410", raw: "f" + f410Payload, want: Address{Type: TypeF4, Value: "f" + f410Payload}},
+		{name: "t410", raw: "t" + f410Payload, want: Address{Type: TypeF4, Value: "t" + f410Payload}},
+		{name: "surrounding space", raw: "  f" + idPayload + "\n", want: Address{Type: TypeF0, Value: "f" + idPayload}},
+		{name: "0x checksummed", raw: ethAddress, want: Address{Type: Type0X, Value: ethAddress}},
+		{name: "0x lowercase", raw: "0x52908400098527886e0f7030069857d2e4169ee7", want: Address{Type: Type0X, Value: ethAddress}},
+		{name: "0x uppercase", raw: "0x52908400098527886E0F7030069857D2E4169EE7", want: Address{Type: Type0X, Value: ethAddress}},
+		{name: "0X prefix", raw: "0X52908400098527886e0f7030069857d2e4169ee7", want: Address{Type: Type0X, Value: ethAddress}},
+
+		{name: "empty", raw: "", wantErr: ErrEmpty},
+		{name: "blank", raw: "   ", wantErr: ErrEmpty},
+		{name: "undef", raw: "<empty>", wantErr: ErrUnknownNetwork},
+		{name: "unknown network", raw: "x" + idPayload, wantErr: ErrUnknownNetwork},
+		{name: "unknown protocol", raw: "f9abcdef", wantErr: ErrUnknownProtocol},
+		{name: "f1 bad checksum", raw: "f1izzn6pmgcz6nejjb7xdwkmivpi6evtl7i5u3ala", wantErr: ErrInvalidChecksum},
+		{name: "f410 bad checksum", raw: "f410fkkiiiaajqutyq3qpoayangcx2lsbnhxhe25pbua", wantErr: ErrInvalidChecksum},
+		{name: "f1 truncated", raw: "f1izzn6pmgcz6nejjb7xdwkmivpi6evtl7", wantErr: ErrInvalidLength},
+		{name: "f1 truncated mid character", raw: "f1izzn6pmgcz6nejjb7xdwkmivpi6evtl", wantErr: ErrInvalidPayload},
+		{name: "f410 without subaddress", raw: "f410f", wantErr: ErrInvalidLength},
+		{name: "f0 without id", raw: "f0", wantErr: ErrInvalidLength},
+		{name: "0x mixed case bad EIP-55", raw: "0x52908400098527886e0F7030069857D2E4169EE7", wantErr: ErrInvalidChecksum},
+		{name: "0x truncated", raw: "0x52908400098527886E0F7030069857D2E4169E", wantErr: ErrInvalidLength},
+		{name: "0x too long", raw: ethAddress + "00", wantErr: ErrInvalidLength},
+		{name: "0x not hex", raw: "0x52908400098527886E0F7030069857D2E4169EZZ", wantErr: ErrInvalidPayload},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.raw)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Parse(%q) error = %v, want %v", tt.raw, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse(%q): %v", tt.raw, err)
+			}
+			if got != tt.want {
+				t.Errorf("Parse(%q) = %+v, want %+v", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseOnNetwork(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		network util.Network
+		wantErr error
+	}{
+		{name: "f0 on mainnet", raw: "f" + idPayload, network: util.Mainnet},
+		{name: "f1 on mainnet", raw: "f" + f1Payload, network: util.Mainnet},
+		{name: "f2 on mainnet", raw: "f" + f2Payload, network: util.Mainnet},
+		{name: "f3 on mainnet", raw: "f" + f3Payload, network: util.Mainnet},
+		{name: "f410 on mainnet", raw: "f" + f410Payload, network: util.Mainnet},
+		{name: "0x on mainnet", raw: ethAddress, network: util.Mainnet},
+		{name: "t0 on calibration", raw: "t" + idPayload, network: util.CalibrationNet},
+		{name: "t1 on calibration", raw: "t" + f1Payload, network: util.CalibrationNet},
+		{name: "t2 on calibration", raw: "t" + f2Payload, network: util.CalibrationNet},
+		{name: "t3 on calibration", raw: "t" + f3Payload, network: util.CalibrationNet},
+		{name: "t410 on calibration", raw: "t" + f410Payload, network: util.CalibrationNet},
+		{name: "0x on calibration", raw: ethAddress, network: util.CalibrationNet},
+
+		{name: "t1 on mainnet", raw: "t" + f1Payload, network: util.Mainnet, wantErr: ErrNetworkMismatch},
+		{name: "t410 on mainnet", raw: "t" + f410Payload, network: util.Mainnet, wantErr: ErrNetworkMismatch},
+		{name: "f1 on calibration", raw: "f" + f1Payload, network: util.CalibrationNet, wantErr: ErrNetworkMismatch},
+		{name: "f0 on calibration", raw: "f" + idPayload, network: util.CalibrationNet, wantErr: ErrNetworkMismatch},
+		{name: "bad checksum", raw: "t1izzn6pmgcz6nejjb7xdwkmivpi6evtl7i5u3ala", network: util.CalibrationNet, wantErr: ErrInvalidChecksum},
+		{name: "truncated", raw: "t1izzn6pmgcz6nejjb7xdwkmivpi6evtl7", network: util.CalibrationNet, wantErr: ErrInvalidLength},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseOnNetwork(tt.raw, tt.network)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("ParseOnNetwork(%q, %s) error = %v, want %v", tt.raw, tt.network, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseOnNetwork(%q, %s): %v", tt.raw, tt.network, err)
+			}
+			if got.Value != tt.raw {
+				t.Errorf("ParseOnNetwork(%q, %s) = %q", tt.raw, tt.network, got.Value)
+			}
+		})
+	}
+}
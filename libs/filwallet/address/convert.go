@@ -0,0 +1,140 @@
+package address
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	"github.com/codemaestro64/filament/apps/api/pkg/util"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/filecoin-project/go-address"
+)
+
+// maskedIDPrefix starts the 0x form of an ID address. The actor ID fills the
+// last 8 bytes, big endian.
+const maskedIDPrefix byte = 0xff
+
+// Equivalents holds the representations of a single actor. Fields are empty
+// when the form does not exist or cannot be derived from the input alone.
+type Equivalents struct {
+	// ID is the f0 address.
+	ID string
+	// Robust is the f1, f2 or f3 address.
+	Robust string
+	// Delegated is the f410 address.
+	Delegated string
+	// Eth is the 0x form of the f410 address.
+	Eth string
+	// MaskedID is the 0xff form of the f0 address.
+	MaskedID string
+}
+
+// Convert parses raw and returns every representation that follows from it
+// without chain state: f410 and 0x addresses map onto each other, and f0
+// addresses onto the masked 0xff form.
+func Convert(raw string, network util.Network) (Equivalents, error) {
+	addr, err := ParseOnNetwork(raw, network)
+	if err != nil {
+		return Equivalents{}, err
+	}
+
+	var eq Equivalents
+	switch addr.Type {
+	case TypeF0:
+		err = eq.SetID(addr.Value, network)
+	case TypeF1, TypeF2, TypeF3:
+		eq.Robust = addr.Value
+	case TypeF4:
+		err = eq.SetDelegated(addr.Value, network)
+	case Type0X:
+		err = eq.setEth(common.HexToAddress(addr.Value), network)
+	default:
+		err = ErrUnknownProtocol
+	}
+	if err != nil {
+		return Equivalents{}, err
+	}
+
+	return eq, nil
+}
+
+// SetID records the f0 address id and its masked 0xff form.
+func (eq *Equivalents) SetID(id string, network util.Network) error {
+	filAddr, err := address.NewFromString(id)
+	if err != nil {
+		return decodeError(err)
+	}
+
+	actorID, err := address.IDFromAddress(filAddr)
+	if err != nil {
+		return fmt.Errorf("%w: not an ID address", ErrUnknownProtocol)
+	}
+
+	var masked common.Address
+	masked[0] = maskedIDPrefix
+	binary.BigEndian.PutUint64(masked[common.AddressLength-8:], actorID)
+
+	eq.ID = Encode(filAddr, network)
+	eq.MaskedID = masked.Hex()
+
+	return nil
+}
+
+// SetDelegated records the f410 address delegated and its 0x form. Delegated
+// addresses of namespaces other than the EVM have no 0x form.
+func (eq *Equivalents) SetDelegated(delegated string, network util.Network) error {
+	filAddr, err := address.NewFromString(delegated)
+	if err != nil {
+		return decodeError(err)
+	}
+
+	if filAddr.Protocol() != address.Delegated {
+		return fmt.Errorf("%w: not a delegated address", ErrUnknownProtocol)
+	}
+	eq.Delegated = Encode(filAddr, network)
+
+	namespace, n := binary.Uvarint(filAddr.Payload())
+	if n <= 0 {
+		return ErrInvalidPayload
+	}
+
+	subAddr := filAddr.Payload()[n:]
+	if namespace == evmNamespace && len(subAddr) == common.AddressLength {
+		eq.Eth = common.BytesToAddress(subAddr).Hex()
+	}
+
+	return nil
+}
+
+// setEth records a 0x address, which is either a masked ID or the EVM
+// subaddress of an f410 address.
+func (eq *Equivalents) setEth(eth common.Address, network util.Network) error {
+	if isMaskedID(eth) {
+		actorID := binary.BigEndian.Uint64(eth[common.AddressLength-8:])
+		return eq.SetID(Prefix(network)+"0"+strconv.FormatUint(actorID, 10), network)
+	}
+
+	delegated, err := address.NewDelegatedAddress(evmNamespace, eth.Bytes())
+	if err != nil {
+		return fmt.Errorf("create f4 address: %w", err)
+	}
+
+	eq.Delegated = Encode(delegated, network)
+	eq.Eth = eth.Hex()
+
+	return nil
+}
+
+func isMaskedID(eth common.Address) bool {
+	if eth[0] != maskedIDPrefix {
+		return false
+	}
+
+	for _, b := range eth[1 : common.AddressLength-8] {
+		if b != 0 {
+			return false
+		}
+	}
+
+	return true
+}
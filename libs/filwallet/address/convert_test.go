@@ -0,0 +1,90 @@
+package address
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/codemaestro64/filament/apps/api/pkg/util"
+)
+
+// maskedID is the 0xff form of f01234.
+const maskedID = "0xFF000000000000000000000000000000000004d2"
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		network util.Network
+		want    Equivalents
+		wantErr error
+	}{
+		{
+			name:    "f0 to masked ID",
+			raw:     "f" + idPayload,
+			network: util.Mainnet,
+			want:    Equivalents{ID: "f" + idPayload, MaskedID: maskedID},
+		},
+		{
+			name:    "masked ID to f0",
+			raw:     maskedID,
+			network: util.Mainnet,
+			want:    Equivalents{ID: "f" + idPayload, MaskedID: maskedID},
+		},
+		{
+			name:    "lowercase masked ID to t0",
+			raw:     "0xff000000000000000000000000000000000004d2",
+			network: util.CalibrationNet,
+			want:    Equivalents{ID: "t" + idPayload, MaskedID: maskedID},
+		},
+		{
+			name:    "f410 to 0x",
+			raw:     "f" + f410Payload,
+			network: util.Mainnet,
+			want:    Equivalents{Delegated: "f" + f410Payload, Eth: ethAddress},
+		},
+		{
+			name:    "0x to f410",
+			raw:     ethAddress,
+			network: util.Mainnet,
+			want:    Equivalents{Delegated: "f" + f410Payload, Eth: ethAddress},
+		},
+		{
+			name:    "0x to t410",
+			raw:     "0x52908400098527886e0f7030069857d2e4169ee7",
+			network: util.CalibrationNet,
+			want:    Equivalents{Delegated: "t" + f410Payload, Eth: ethAddress},
+		},
+		{
+			name:    "delegated outside the EVM",
+			raw:     "f432faebagbddn25us",
+			network: util.Mainnet,
+			want:    Equivalents{Delegated: "f432faebagbddn25us"},
+		},
+		{
+			name:    "f1 needs chain state",
+			raw:     "f" + f1Payload,
+			network: util.Mainnet,
+			want:    Equivalents{Robust: "f" + f1Payload},
+		},
+		{name: "wrong network", raw: "t" + idPayload, network: util.Mainnet, wantErr: ErrNetworkMismatch},
+		{name: "bad checksum", raw: "0x52908400098527886e0F7030069857D2E4169EE7", network: util.Mainnet, wantErr: ErrInvalidChecksum},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Convert(tt.raw, tt.network)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Convert(%q) error = %v, want %v", tt.raw, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Convert(%q): %v", tt.raw, err)
+			}
+			if got != tt.want {
+				t.Errorf("Convert(%q) = %+v, want %+v", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
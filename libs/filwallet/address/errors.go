@@ -0,0 +1,14 @@
+package address
+
+import "errors"
+
+// Errors returned by Parse and ParseOnNetwork. They are wrapped with details of the failure.
+var (
+	ErrEmpty           = errors.New("address is empty")
+	ErrUnknownNetwork  = errors.New("unknown address network")
+	ErrUnknownProtocol = errors.New("unknown address protocol")
+	ErrInvalidLength   = errors.New("invalid address length")
+	ErrInvalidPayload  = errors.New("invalid address payload")
+	ErrInvalidChecksum = errors.New("invalid address checksum")
+	ErrNetworkMismatch = errors.New("address belongs to another network")
+)
@@ -0,0 +1,55 @@
+package address
+
+import (
+	"fmt"
+
+	"github.com/codemaestro64/filament/apps/api/pkg/util"
+	"github.com/filecoin-project/go-address"
+)
+
+// Prefix returns the address prefix of network, f on mainnet and t elsewhere.
+func Prefix(network util.Network) string {
+	if network.IsMainnet() {
+		return address.MainnetPrefix
+	}
+
+	return address.TestnetPrefix
+}
+
+// Encode formats addr with the prefix of network. go-address would use its
+// process wide CurrentNetwork instead.
+func Encode(addr address.Address, network util.Network) string {
+	if addr == address.Undef {
+		return address.UndefAddressString
+	}
+
+	return Prefix(network) + addr.String()[1:]
+}
+
+// WithNetwork returns a with its value re-encoded for network. 0x addresses
+// carry no network and are returned unchanged.
+func WithNetwork(a Address, network util.Network) Address {
+	if a.Type != Type0X && len(a.Value) > 0 {
+		a.Value = Prefix(network) + a.Value[1:]
+	}
+	if len(a.ActorID) > 0 {
+		a.ActorID = Prefix(network) + a.ActorID[1:]
+	}
+
+	return a
+}
+
+// ParseOnNetwork parses raw like Parse and additionally requires Filecoin
+// addresses to carry the prefix of network.
+func ParseOnNetwork(raw string, network util.Network) (Address, error) {
+	addr, err := Parse(raw)
+	if err != nil {
+		return Address{}, err
+	}
+
+	if addr.Type != Type0X && addr.Value[:1] != Prefix(network) {
+		return Address{}, fmt.Errorf("%w: %s address used on %s", ErrNetworkMismatch, addr.Value[:1], network)
+	}
+
+	return addr, nil
+}
@@ -1,20 +1,45 @@
 package address
 
-// Type defines the Filecoin address protocol (f1, f4, Ox).
+// Type defines the Filecoin address protocol (f0, f1, f2, f3, f4, 0x).
 type Type int32
 
+// The values are persisted, so new types are appended.
 const (
 	TypeUnknown Type = 0
 	TypeF1      Type = 1
 	TypeF3      Type = 2
 	TypeF4      Type = 3
 	Type0X      Type = 4
+	TypeF0      Type = 5
+	TypeF2      Type = 6
 )
 
+// String returns the address prefix of the protocol.
+func (t Type) String() string {
+	switch t {
+	case TypeF0:
+		return "f0"
+	case TypeF1:
+		return "f1"
+	case TypeF2:
+		return "f2"
+	case TypeF3:
+		return "f3"
+	case TypeF4:
+		return "f4"
+	case Type0X:
+		return "0x"
+	default:
+		return "unknown"
+	}
+}
+
 // Address represents a concrete Filecoin address instance.
 type Address struct {
 	Type  Type
 	Value string
+	// ActorID is the f0 ID address of the actor, once it exists on chain.
+	ActorID string
 }
 
 // String provides a human-readable representation of the address.
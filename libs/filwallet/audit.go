@@ -0,0 +1,19 @@
+package filwallet
+
+// AuditAction identifies a sensitive operation recorded in the audit trail.
+type AuditAction string
+
+const (
+	AuditKeyExport   AuditAction = "key_export"
+	AuditShareBackup AuditAction = "share_backup"
+	AuditSeedReveal  AuditAction = "seed_reveal"
+)
+
+// AuditEvent is a single entry of the audit trail. Failed attempts are
+// recorded as well, with the reason in Detail.
+type AuditEvent struct {
+	WalletID int
+	Action   AuditAction
+	Detail   string
+	Success  bool
+}
@@ -0,0 +1,205 @@
+package filwallet
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/awnumar/memguard"
+)
+
+// A backup challenge asks for backupChallengeWords words of the seed phrase
+// and expires after backupChallengeTTL.
+const (
+	backupChallengeWords = 3
+	backupChallengeTTL   = 10 * time.Minute
+)
+
+// SeedPhrase is the revealed mnemonic of a wallet.
+type SeedPhrase struct {
+	// Words are in the form of the wordlist, for display.
+	Words    []string
+	Language Language
+	// HasPassphrase reports whether the seed also needs a BIP39 passphrase,
+	// which is never revealed.
+	HasPassphrase bool
+}
+
+// RevealSeedPhrase returns the mnemonic of an HD wallet. Every attempt is
+// written to the audit trail; the words are withheld when the attempt cannot
+// be recorded.
+func (m *Manager) RevealSeedPhrase(ctx context.Context, walletID int, password string) (*SeedPhrase, error) {
+	seed, err := m.revealSeedPhrase(ctx, walletID, password)
+
+	event := AuditEvent{
+		WalletID: walletID,
+		Action:   AuditSeedReveal,
+		Success:  err == nil,
+	}
+	if err != nil {
+		event.Detail = err.Error()
+	}
+
+	if auditErr := m.recordAudit(ctx, event); auditErr != nil && err == nil {
+		return nil, auditErr
+	}
+
+	return seed, err
+}
+
+func (m *Manager) revealSeedPhrase(ctx context.Context, walletID int, password string) (*SeedPhrase, error) {
+	if password == "" {
+		return nil, ErrInvalidPassword
+	}
+
+	mnemonic, passphrase, err := m.DecryptSeedPhrase(ctx, walletID, password)
+	if err != nil {
+		return nil, err
+	}
+
+	words, lang, err := displayWords(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SeedPhrase{Words: words, Language: lang, HasPassphrase: passphrase != ""}, nil
+}
+
+// displayWords maps a stored, normalized mnemonic back to the words of its
+// wordlist.
+func displayWords(mnemonic string) ([]string, Language, error) {
+	lang, err := DetectLanguage(mnemonic)
+	if err != nil {
+		return nil, "", err
+	}
+
+	index := wordIndexes()[lang]
+	words := strings.Fields(NormalizeMnemonic(mnemonic))
+	for i, word := range words {
+		words[i] = wordlistsByLanguage[lang][index[word]]
+	}
+
+	return words, lang, nil
+}
+
+// backupChallenge holds the words a backup verification expects, sealed
+// until they are compared.
+type backupChallenge struct {
+	positions []int
+	expected  *memguard.Enclave
+	expiresAt time.Time
+}
+
+// BackupChallenge lists the 1-based positions of the seed phrase words that
+// VerifyBackup expects, in order.
+type BackupChallenge struct {
+	Positions []int
+	ExpiresAt time.Time
+}
+
+// StartBackupVerification picks random word positions of the seed phrase of
+// a wallet for the user to fill in. It replaces any pending challenge of the
+// wallet.
+func (m *Manager) StartBackupVerification(ctx context.Context, walletID int, password string) (*BackupChallenge, error) {
+	if password == "" {
+		return nil, ErrInvalidPassword
+	}
+
+	mnemonic, _, err := m.DecryptSeedPhrase(ctx, walletID, password)
+	if err != nil {
+		return nil, err
+	}
+
+	words := strings.Fields(mnemonic)
+	positions, err := randomPositions(len(words), backupChallengeWords)
+	if err != nil {
+		return nil, fmt.Errorf("pick backup challenge: %w", err)
+	}
+	slices.Sort(positions)
+
+	expected := make([]string, len(positions))
+	for i, pos := range positions {
+		expected[i] = words[pos]
+		positions[i] = pos + 1
+	}
+
+	challenge := &backupChallenge{
+		positions: positions,
+		expected:  memguard.NewEnclave([]byte(strings.Join(expected, " "))),
+		expiresAt: time.Now().Add(backupChallengeTTL),
+	}
+
+	m.mu.Lock()
+	m.backupChallenges[walletID] = challenge
+	m.mu.Unlock()
+
+	return &BackupChallenge{
+		Positions: slices.Clone(positions),
+		ExpiresAt: challenge.expiresAt,
+	}, nil
+}
+
+// randomPositions picks k distinct positions below n by a Fisher-Yates
+// shuffle drawing from crypto/rand, as the positions reveal which words of
+// the seed phrase are asked for.
+func randomPositions(n, k int) ([]int, error) {
+	positions := make([]int, n)
+	for i := range positions {
+		positions[i] = i
+	}
+
+	for i := n - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return nil, err
+		}
+		positions[i], positions[j.Int64()] = positions[j.Int64()], positions[i]
+	}
+
+	return positions[:k], nil
+}
+
+// VerifyBackup checks words against the pending challenge of a wallet and
+// records when the backup was verified. A challenge can be answered once,
+// a wrong answer needs a new one.
+func (m *Manager) VerifyBackup(ctx context.Context, walletID int, words []string) (time.Time, error) {
+	m.mu.Lock()
+	challenge, ok := m.backupChallenges[walletID]
+	delete(m.backupChallenges, walletID)
+	m.mu.Unlock()
+
+	if !ok || time.Now().After(challenge.expiresAt) {
+		return time.Time{}, ErrNoBackupChallenge
+	}
+
+	if len(words) != len(challenge.positions) {
+		return time.Time{}, fmt.Errorf("%w: expected %d words", ErrBackupMismatch, len(challenge.positions))
+	}
+
+	normalized := make([]string, len(words))
+	for i, word := range words {
+		normalized[i] = NormalizeMnemonic(word)
+	}
+
+	expected, err := challenge.expected.Open()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("open backup challenge: %w", err)
+	}
+	defer expected.Destroy()
+
+	if subtle.ConstantTimeCompare(expected.Bytes(), []byte(strings.Join(normalized, " "))) != 1 {
+		return time.Time{}, ErrBackupMismatch
+	}
+
+	verifiedAt := time.Now()
+	if err := m.store.UpdateBackupVerified(ctx, walletID, verifiedAt); err != nil {
+		return time.Time{}, fmt.Errorf("update backup verified: %w", err)
+	}
+
+	return verifiedAt, nil
+}
@@ -0,0 +1,27 @@
+package filwallet
+
+import "testing"
+
+func TestRandomPositions(t *testing.T) {
+	for range 100 {
+		positions, err := randomPositions(12, backupChallengeWords)
+		if err != nil {
+			t.Fatalf("random positions: %v", err)
+		}
+
+		if len(positions) != backupChallengeWords {
+			t.Fatalf("got %d positions, want %d", len(positions), backupChallengeWords)
+		}
+
+		seen := make(map[int]bool)
+		for _, pos := range positions {
+			if pos < 0 || pos >= 12 {
+				t.Fatalf("position %d out of range", pos)
+			}
+			if seen[pos] {
+				t.Fatalf("position %d picked twice: %v", pos, positions)
+			}
+			seen[pos] = true
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package filwallet
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/codemaestro64/filament/libs/filwallet/address"
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
+	goaddress "github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/lotus/chain/types"
+)
+
+// balanceCacheTTL bounds how stale a reported balance can be. It is short
+// enough to follow the chain, which produces a tipset every 30 seconds.
+const balanceCacheTTL = 15 * time.Second
+
+type cachedBalance struct {
+	value     types.BigInt
+	fetchedAt time.Time
+}
+
+// balanceCache keeps recently fetched address balances.
+type balanceCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[goaddress.Address]cachedBalance
+}
+
+func newBalanceCache(ttl time.Duration) *balanceCache {
+	return &balanceCache{
+		ttl:     ttl,
+		entries: make(map[goaddress.Address]cachedBalance),
+	}
+}
+
+func (c *balanceCache) get(addr goaddress.Address) (types.BigInt, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[addr]
+	if !ok || time.Since(entry.fetchedAt) > c.ttl {
+		return types.EmptyInt, false
+	}
+
+	return entry.value, true
+}
+
+func (c *balanceCache) set(addr goaddress.Address, value types.BigInt) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[addr] = cachedBalance{value: value, fetchedAt: time.Now()}
+}
+
+// WalletBalance returns the sum of the balances of every actor address of w,
+// in attoFIL.
+func (m *Manager) WalletBalance(ctx context.Context, w *wallet.Wallet) (types.BigInt, error) {
+	total := types.NewInt(0)
+	for _, addr := range w.Addresses() {
+		// 0x addresses share the actor of their f4 counterpart.
+		if addr.Type == address.Type0X {
+			continue
+		}
+
+		filAddr, err := goaddress.NewFromString(addr.Value)
+		if err != nil {
+			return types.EmptyInt, fmt.Errorf("parse address %s: %w", addr, err)
+		}
+
+		balance, err := m.addressBalance(ctx, filAddr)
+		if err != nil {
+			return types.EmptyInt, err
+		}
+
+		total = types.BigAdd(total, balance)
+	}
+
+	return total, nil
+}
+
+func (m *Manager) addressBalance(ctx context.Context, addr goaddress.Address) (types.BigInt, error) {
+	if balance, ok := m.balances.get(addr); ok {
+		return balance, nil
+	}
+
+	balance, err := m.rpcClient.WalletBalance(ctx, addr)
+	if err != nil {
+		return types.EmptyInt, err
+	}
+	m.balances.set(addr, balance)
+
+	return balance, nil
+}
@@ -2,16 +2,38 @@ package filwallet
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/codemaestro64/filament/apps/api/pkg/util"
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
 )
 
+// RPCEndpoint is a Lotus JSON-RPC endpoint and its optional auth token.
+type RPCEndpoint struct {
+	URL   string
+	Token string
+}
+
 type Config struct {
-	Network        util.Network
+	Network util.Network
+	// SessionTimeout locks a wallet after it has not been used for this many
+	// minutes.
 	SessionTimeout int64
-	RPCEndpoint    string
-	RPCToken       string
-	DataDir        string
+	// SessionMaxLifetime locks a wallet this many minutes after it was
+	// unlocked, however often it is used.
+	SessionMaxLifetime int64
+	// MaxFailedAttempts is the number of wrong passwords in a row after which
+	// a wallet refuses password checks for LockoutDuration minutes.
+	MaxFailedAttempts int
+	LockoutDuration   int64
+	// KDF hashes the passwords of new wallets, wallet.DefaultKDFParams when
+	// zero. See wallet.CalibrateKDF to pick its passes for this machine.
+	KDF wallet.KDFParams
+	// RPCEndpoints are tried in order; later endpoints are failovers.
+	RPCEndpoints []RPCEndpoint
+	// DataDir is where earlier versions kept keystore files, see
+	// Manager.RemoveKeystoreFiles.
+	DataDir string
 }
 
 func (c *Config) Validate() error {
@@ -19,9 +41,30 @@ func (c *Config) Validate() error {
 		return errors.New("missing app data directory")
 	}
 
-	if c.RPCEndpoint == "" {
+	if len(c.RPCEndpoints) == 0 {
 		return errors.New("missing api endpoint")
 	}
 
+	for i, endpoint := range c.RPCEndpoints {
+		if endpoint.URL == "" {
+			return fmt.Errorf("missing url of api endpoint %d", i)
+		}
+	}
+
+	if c.KDF != (wallet.KDFParams{}) {
+		if err := c.KDF.Validate(); err != nil {
+			return fmt.Errorf("invalid kdf: %w", err)
+		}
+	}
+
 	return nil
 }
+
+// kdfParams resolves the KDF parameters of new wallets.
+func (c *Config) kdfParams() wallet.KDFParams {
+	if c.KDF != (wallet.KDFParams{}) {
+		return c.KDF
+	}
+
+	return wallet.DefaultKDFParams
+}
@@ -0,0 +1,98 @@
+package filwallet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/codemaestro64/filament/libs/filwallet/address"
+	goaddress "github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/manifest"
+	"github.com/filecoin-project/lotus/build"
+	"github.com/filecoin-project/lotus/chain/actors/builtin"
+	"github.com/ipfs/go-cid"
+)
+
+// ConvertAddress returns every representation of the actor behind raw. Forms
+// that need chain state, such as the f0 of an f410 address or the public key
+// address of an account, are looked up and stay empty while the actor does not
+// exist on chain.
+func (m *Manager) ConvertAddress(ctx context.Context, raw string) (address.Equivalents, error) {
+	eq, err := address.Convert(raw, m.cfg.Network)
+	if err != nil {
+		return address.Equivalents{}, fmt.Errorf("%w: %w", ErrInvalidAddress, err)
+	}
+
+	if eq.ID == "" {
+		known := eq.Delegated
+		if known == "" {
+			known = eq.Robust
+		}
+
+		filAddr, err := goaddress.NewFromString(known)
+		if err != nil {
+			return address.Equivalents{}, fmt.Errorf("%w: %w", ErrInvalidAddress, err)
+		}
+
+		id, err := m.rpcClient.StateLookupID(ctx, filAddr)
+		if errors.Is(err, ErrActorNotFound) {
+			return eq, nil
+		}
+		if err != nil {
+			return address.Equivalents{}, err
+		}
+
+		if err := eq.SetID(address.Encode(id, m.cfg.Network), m.cfg.Network); err != nil {
+			return address.Equivalents{}, err
+		}
+	}
+
+	if eq.Delegated != "" && eq.Robust != "" {
+		return eq, nil
+	}
+
+	idAddr, err := goaddress.NewFromString(eq.ID)
+	if err != nil {
+		return address.Equivalents{}, fmt.Errorf("%w: %w", ErrInvalidAddress, err)
+	}
+
+	actor, err := m.rpcClient.StateGetActor(ctx, idAddr)
+	if errors.Is(err, ErrActorNotFound) {
+		return eq, nil
+	}
+	if err != nil {
+		return address.Equivalents{}, err
+	}
+
+	// Only actors created through the EAM have a delegated address.
+	if eq.Delegated == "" && actor.DelegatedAddress != nil {
+		delegated := address.Encode(*actor.DelegatedAddress, m.cfg.Network)
+		if err := eq.SetDelegated(delegated, m.cfg.Network); err != nil {
+			return address.Equivalents{}, err
+		}
+	}
+
+	// Only account actors have a public key address.
+	if eq.Robust == "" && isAccountActor(actor.Code) {
+		key, err := m.rpcClient.StateAccountKey(ctx, idAddr)
+		if err != nil {
+			return address.Equivalents{}, err
+		}
+		eq.Robust = address.Encode(key, m.cfg.Network)
+	}
+
+	return eq, nil
+}
+
+// isAccountActor reports whether code is the account actor of any network
+// bundle. Lotus' builtin.IsAccountActor only knows the network it was built
+// for.
+func isAccountActor(code cid.Cid) bool {
+	for _, meta := range build.EmbeddedBuiltinActorsMetadata {
+		if meta.Actors[manifest.AccountKey] == code {
+			return true
+		}
+	}
+
+	return builtin.IsAccountActor(code)
+}
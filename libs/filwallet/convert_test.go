@@ -0,0 +1,123 @@
+package filwallet
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/codemaestro64/filament/apps/api/pkg/util"
+	"github.com/codemaestro64/filament/libs/filwallet/address"
+	goaddress "github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/manifest"
+	"github.com/filecoin-project/lotus/build"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/ipfs/go-cid"
+)
+
+// calibrationActorCode returns the code of the named actor in the newest
+// calibration network bundle.
+func calibrationActorCode(t *testing.T, name string) cid.Cid {
+	t.Helper()
+
+	var code cid.Cid
+	var version uint64
+	for _, meta := range build.EmbeddedBuiltinActorsMetadata {
+		if meta.Network == "calibrationnet" && uint64(meta.Version) >= version {
+			code, version = meta.Actors[name], uint64(meta.Version)
+		}
+	}
+	if !code.Defined() {
+		t.Fatalf("no calibration code for %s actor", name)
+	}
+
+	return code
+}
+
+func mustAddress(t *testing.T, raw string) goaddress.Address {
+	t.Helper()
+
+	addr, err := goaddress.NewFromString(raw)
+	if err != nil {
+		t.Fatalf("parse %s: %v", raw, err)
+	}
+
+	return addr
+}
+
+func maskedIDOf(t *testing.T, id string) string {
+	t.Helper()
+
+	eq, err := address.Convert(id, util.CalibrationNet)
+	if err != nil {
+		t.Fatalf("convert %s: %v", id, err)
+	}
+
+	return eq.MaskedID
+}
+
+func TestConvertAddress(t *testing.T) {
+	const (
+		accountID  = "t01234"
+		accountKey = "t1izzn6pmgcz6nejjb7xdwkmivpi6evtl7i5u37la"
+		evmID      = "t01235"
+		delegated  = "t410fkkiiiaajqutyq3qpoayangcx2lsbnhxhe25pnua"
+		eth        = "0x52908400098527886E0F7030069857D2E4169EE7"
+		unknownID  = "t09999"
+		unknownKey = "t2v33d7lvwzzbi4gc4godxslx7bljd7eb2pye74ga"
+	)
+
+	delegatedAddr := mustAddress(t, delegated)
+	node := newTestNode()
+	node.ids = map[goaddress.Address]goaddress.Address{
+		mustAddress(t, accountKey): mustAddress(t, accountID),
+		delegatedAddr:              mustAddress(t, evmID),
+	}
+	node.actors = map[goaddress.Address]*types.Actor{
+		mustAddress(t, accountID): {Code: calibrationActorCode(t, manifest.AccountKey)},
+		mustAddress(t, evmID):     {Code: calibrationActorCode(t, manifest.EvmKey), DelegatedAddress: &delegatedAddr},
+	}
+	node.accountKeys = map[goaddress.Address]goaddress.Address{
+		mustAddress(t, accountID): mustAddress(t, accountKey),
+	}
+	m := newTestManager(t, newMemStore(), node)
+
+	account := address.Equivalents{ID: accountID, Robust: accountKey, MaskedID: maskedIDOf(t, accountID)}
+	evm := address.Equivalents{ID: evmID, Delegated: delegated, Eth: eth, MaskedID: maskedIDOf(t, evmID)}
+
+	tests := []struct {
+		name    string
+		raw     string
+		want    address.Equivalents
+		wantErr error
+	}{
+		{name: "f0 of an account", raw: accountID, want: account},
+		{name: "masked ID of an account", raw: maskedIDOf(t, accountID), want: account},
+		{name: "key of an account", raw: accountKey, want: account},
+		{name: "f0 of an EVM actor", raw: evmID, want: evm},
+		{name: "masked ID of an EVM actor", raw: maskedIDOf(t, evmID), want: evm},
+		{name: "f410 of an EVM actor", raw: delegated, want: evm},
+		{name: "0x of an EVM actor", raw: eth, want: evm},
+		{name: "unknown f0", raw: unknownID, want: address.Equivalents{ID: unknownID, MaskedID: maskedIDOf(t, unknownID)}},
+		{name: "unknown robust", raw: unknownKey, want: address.Equivalents{Robust: unknownKey}},
+		{name: "invalid", raw: "t1izzn6pmgcz6nejjb7xdwkmivpi6evtl7", wantErr: ErrInvalidAddress},
+		{name: "wrong network", raw: "f01234", wantErr: ErrInvalidAddress},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := m.ConvertAddress(context.Background(), tt.raw)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("ConvertAddress(%q) error = %v, want %v", tt.raw, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ConvertAddress(%q): %v", tt.raw, err)
+			}
+			if got != tt.want {
+				t.Errorf("ConvertAddress(%q) = %+v, want %+v", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
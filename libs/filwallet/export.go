@@ -0,0 +1,124 @@
+package filwallet
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
+)
+
+// Key exports are limited per wallet, failed attempts included, to slow down
+// password guessing.
+const (
+	exportLimit  = 3
+	exportWindow = 15 * time.Minute
+)
+
+// ExportFormat selects how an exported key is encoded.
+type ExportFormat string
+
+const (
+	// ExportFormatKeyInfo is the hex KeyInfo of `lotus wallet import`.
+	ExportFormatKeyInfo ExportFormat = "keyinfo"
+	// ExportFormatKeystore is an Ethereum V3 keystore JSON.
+	ExportFormatKeystore ExportFormat = "keystore"
+)
+
+type ExportKeyParams struct {
+	WalletID     int
+	AccountIndex uint32
+	Password     string
+	Format       ExportFormat
+	// ExportPassword encrypts the keystore. It is required for
+	// ExportFormatKeystore and ignored otherwise.
+	ExportPassword string
+}
+
+// ExportKey returns the private key of a wallet account in the requested
+// format. Every attempt is written to the audit trail; the export is refused
+// when the attempt cannot be recorded.
+func (m *Manager) ExportKey(ctx context.Context, p ExportKeyParams) (key string, err error) {
+	if retryAfter, ok := m.exportLimiter.allow(p.WalletID); !ok {
+		err = fmt.Errorf("%w: retry in %s", ErrRateLimited, retryAfter.Round(time.Second))
+		_ = m.recordAudit(ctx, AuditEvent{WalletID: p.WalletID, Action: AuditKeyExport, Detail: err.Error()})
+		return "", err
+	}
+
+	key, err = m.exportKey(ctx, p)
+
+	event := AuditEvent{
+		WalletID: p.WalletID,
+		Action:   AuditKeyExport,
+		Detail:   fmt.Sprintf("account %d, format %s", p.AccountIndex, p.Format),
+		Success:  err == nil,
+	}
+	if err != nil {
+		event.Detail = err.Error()
+	}
+
+	if auditErr := m.recordAudit(ctx, event); auditErr != nil && err == nil {
+		return "", auditErr
+	}
+
+	return key, err
+}
+
+func (m *Manager) exportKey(ctx context.Context, p ExportKeyParams) (string, error) {
+	if p.Password == "" {
+		return "", ErrInvalidPassword
+	}
+
+	if p.Format == ExportFormatKeystore && p.ExportPassword == "" {
+		return "", fmt.Errorf("%w: export password required", ErrInvalidPassword)
+	}
+
+	var w *wallet.Wallet
+	var keyring wallet.Keyring
+	err := m.withPassword(ctx, p.WalletID, func(found *wallet.Wallet) (err error) {
+		w = found
+		keyring, err = w.Unlock(p.Password)
+		return err
+	})
+	if err != nil {
+		return "", fmt.Errorf("unlock wallet: %w", err)
+	}
+
+	enclave, ok := keyring[p.AccountIndex]
+	if !ok {
+		return "", fmt.Errorf("%w: account %d", ErrAccountNotFound, p.AccountIndex)
+	}
+
+	keyType := wallet.KeyTypeSecp256k1
+	for _, acc := range w.Accounts {
+		if acc.Index == p.AccountIndex && acc.IsBLS() {
+			keyType = wallet.KeyTypeBLS
+		}
+	}
+
+	switch p.Format {
+	case ExportFormatKeyInfo:
+		return wallet.ExportKeyInfo(enclave, keyType)
+	case ExportFormatKeystore:
+		// Keystore JSON only holds secp256k1 keys.
+		if keyType != wallet.KeyTypeSecp256k1 {
+			return "", fmt.Errorf("%w: %s keys cannot be exported as keystore", wallet.ErrUnsupportedKey, keyType)
+		}
+
+		keyJSON, err := wallet.ExportKeystore(enclave, p.ExportPassword)
+		if err != nil {
+			return "", err
+		}
+		return string(keyJSON), nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrInvalidExportFormat, p.Format)
+	}
+}
+
+func (m *Manager) recordAudit(ctx context.Context, event AuditEvent) error {
+	if err := m.store.RecordAuditEvent(ctx, event); err != nil {
+		return fmt.Errorf("record audit event: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package filwallet
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/codemaestro64/filament/libs/filwallet/address"
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
+)
+
+// addressesByType indexes the addresses of the first account of w.
+func addressesByType(w *wallet.Wallet) map[address.Type]string {
+	addrs := make(map[address.Type]string)
+	for _, addr := range w.Accounts[0].Addresses {
+		addrs[addr.Type] = addr.Value
+	}
+
+	return addrs
+}
+
+func TestExportImportRoundTrip(t *testing.T) {
+	const exportPassword = "export password"
+
+	w, err := testWallet()
+	if err != nil {
+		t.Fatalf("create wallet: %v", err)
+	}
+	want := addressesByType(w)
+
+	tests := []struct {
+		format ExportFormat
+		// importKey imports the exported key into m as a new wallet.
+		importKey func(m *Manager, key string) (*wallet.Wallet, error)
+	}{
+		{
+			format: ExportFormatKeyInfo,
+			importKey: func(m *Manager, key string) (*wallet.Wallet, error) {
+				return m.ImportPrivateKey(context.Background(), key, "imported", testPassword)
+			},
+		},
+		{
+			format: ExportFormatKeystore,
+			importKey: func(m *Manager, key string) (*wallet.Wallet, error) {
+				return m.ImportKeystore(context.Background(), []byte(key), exportPassword, "imported", testPassword)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		m := newTestManager(t, newMemStore(w), newTestNode())
+
+		key, err := m.ExportKey(context.Background(), ExportKeyParams{
+			WalletID:       w.ID,
+			Password:       testPassword,
+			Format:         tt.format,
+			ExportPassword: exportPassword,
+		})
+		if err != nil {
+			t.Fatalf("%s: export: %v", tt.format, err)
+		}
+
+		imported, err := tt.importKey(m, key)
+		if err != nil {
+			t.Fatalf("%s: import: %v", tt.format, err)
+		}
+
+		got := addressesByType(imported)
+		for _, typ := range []address.Type{address.TypeF1, address.TypeF4, address.Type0X} {
+			if got[typ] != want[typ] {
+				t.Errorf("%s: imported %s address %s, want %s", tt.format, typ, got[typ], want[typ])
+			}
+		}
+	}
+}
+
+func TestExportKeyRateLimited(t *testing.T) {
+	w, err := testWallet()
+	if err != nil {
+		t.Fatalf("create wallet: %v", err)
+	}
+
+	store := newMemStore(w)
+	m := newTestManager(t, store, newTestNode())
+	ctx := context.Background()
+
+	// Failed attempts count towards the limit, whether the password was
+	// checked or refused by the backoff.
+	for i := 0; i < exportLimit; i++ {
+		_, err := m.ExportKey(ctx, ExportKeyParams{WalletID: w.ID, Password: "wrong", Format: ExportFormatKeyInfo})
+		if !errors.Is(err, wallet.ErrWrongPassword) && !errors.Is(err, ErrTooManyAttempts) {
+			t.Fatalf("export %d: error = %v, want a wrong password", i, err)
+		}
+	}
+
+	_, err = m.ExportKey(ctx, ExportKeyParams{WalletID: w.ID, Password: testPassword, Format: ExportFormatKeyInfo})
+	if !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("export over the limit: error = %v, want %v", err, ErrRateLimited)
+	}
+
+	if n := len(store.events); n != exportLimit+1 || store.events[n-1].Action != AuditKeyExport || store.events[n-1].Success {
+		t.Errorf("audit events %+v, want %d failed key exports", store.events, exportLimit+1)
+	}
+
+	// The limit is per wallet.
+	_, err = m.ExportKey(ctx, ExportKeyParams{WalletID: w.ID + 1, Password: testPassword, Format: ExportFormatKeyInfo})
+	if errors.Is(err, ErrRateLimited) {
+		t.Errorf("export of another wallet was rate limited")
+	}
+}
@@ -0,0 +1,242 @@
+package filwallet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
+)
+
+// Defaults for a zero Config.MaxFailedAttempts and Config.LockoutDuration.
+const (
+	defaultMaxFailedAttempts = 5
+	defaultLockoutDuration   = 30 * time.Minute
+)
+
+// Wrong passwords below the lockout threshold delay the next attempt by
+// attemptBackoffBase, doubled per failure up to attemptBackoffMax.
+const (
+	attemptBackoffBase = time.Second
+	attemptBackoffMax  = 5 * time.Minute
+)
+
+// AttemptError reports a refused or failed password check. Err is
+// ErrTooManyAttempts while the wallet is backing off and
+// wallet.ErrWrongPassword after a wrong password.
+type AttemptError struct {
+	Err               error
+	RemainingAttempts int
+	// RetryAfter is when the next attempt is allowed. It is zero when there
+	// is no delay.
+	RetryAfter time.Time
+}
+
+func (e *AttemptError) Error() string {
+	if e.RetryAfter.IsZero() {
+		return fmt.Sprintf("%v: %d attempts remaining", e.Err, e.RemainingAttempts)
+	}
+
+	return fmt.Sprintf("%v: %d attempts remaining, retry after %s",
+		e.Err, e.RemainingAttempts, e.RetryAfter.Format(time.RFC3339))
+}
+
+func (e *AttemptError) Unwrap() error {
+	return e.Err
+}
+
+func (m *Manager) maxFailedAttempts() int {
+	if m.cfg.MaxFailedAttempts <= 0 {
+		return defaultMaxFailedAttempts
+	}
+
+	return m.cfg.MaxFailedAttempts
+}
+
+func (m *Manager) lockoutDuration() time.Duration {
+	if m.cfg.LockoutDuration <= 0 {
+		return defaultLockoutDuration
+	}
+
+	return time.Duration(m.cfg.LockoutDuration) * time.Minute
+}
+
+// attemptLock returns the mutex serializing the password checks of walletID.
+func (m *Manager) attemptLock(walletID int) *sync.Mutex {
+	mu, _ := m.attemptLocks.LoadOrStore(walletID, new(sync.Mutex))
+	return mu.(*sync.Mutex)
+}
+
+// withPassword loads a wallet and runs check, which verifies a password
+// against it. Failures are counted and throttle further checks.
+func (m *Manager) withPassword(ctx context.Context, walletID int, check func(w *wallet.Wallet) error) error {
+	mu := m.attemptLock(walletID)
+	mu.Lock()
+	defer mu.Unlock()
+
+	w, err := m.store.FindWallet(ctx, walletID)
+	if err != nil {
+		return fmt.Errorf("find wallet: %w", err)
+	}
+
+	if err := m.attemptAllowed(w); err != nil {
+		return err
+	}
+
+	err = check(w)
+	switch {
+	case errors.Is(err, wallet.ErrWrongPassword):
+		return m.recordFailure(ctx, w)
+	case err != nil:
+		return err
+	}
+
+	return m.recordSuccess(ctx, w)
+}
+
+// attemptAllowed refuses a password check while w is backing off.
+func (m *Manager) attemptAllowed(w *wallet.Wallet) error {
+	if !time.Now().Before(w.RetryAfter) {
+		return nil
+	}
+
+	return &AttemptError{
+		Err:               ErrTooManyAttempts,
+		RemainingAttempts: max(m.maxFailedAttempts()-w.FailedAttempts, 0),
+		RetryAfter:        w.RetryAfter,
+	}
+}
+
+// recordFailure counts a wrong password for w and returns the AttemptError
+// to report.
+func (m *Manager) recordFailure(ctx context.Context, w *wallet.Wallet) error {
+	attempts := w.FailedAttempts + 1
+	remaining := max(m.maxFailedAttempts()-attempts, 0)
+
+	delay := m.lockoutDuration()
+	if remaining > 0 {
+		delay = min(attemptBackoffBase<<min(attempts-1, 30), attemptBackoffMax)
+	}
+	retryAfter := time.Now().Add(delay)
+
+	if err := m.store.UpdateFailedAttempts(ctx, w.ID, attempts, retryAfter); err != nil {
+		return fmt.Errorf("record failed attempt: %w", err)
+	}
+
+	return &AttemptError{
+		Err:               wallet.ErrWrongPassword,
+		RemainingAttempts: remaining,
+		RetryAfter:        retryAfter,
+	}
+}
+
+// recordSuccess resets the wrong password counter of w.
+func (m *Manager) recordSuccess(ctx context.Context, w *wallet.Wallet) error {
+	if w.FailedAttempts == 0 && w.RetryAfter.IsZero() {
+		return nil
+	}
+
+	if err := m.store.UpdateFailedAttempts(ctx, w.ID, 0, time.Time{}); err != nil {
+		return fmt.Errorf("reset failed attempts: %w", err)
+	}
+
+	return nil
+}
+
+// passwordOutcome is the result of a password check against one wallet.
+type passwordOutcome struct {
+	wallet *wallet.Wallet
+	err    error
+}
+
+// withPasswordAll runs check against every wallet that is not backing off.
+// Wrong passwords are only counted when the password matches none of the
+// wallets, so using the password of some wallets does not lock out the others.
+// Each wallet is locked only while it is checked, wallets deleted meanwhile
+// are skipped.
+func (m *Manager) withPasswordAll(ctx context.Context, check func(w *wallet.Wallet) error) ([]passwordOutcome, error) {
+	wallets, err := m.store.GetWallets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get wallets: %w", err)
+	}
+
+	outcomes := make([]passwordOutcome, 0, len(wallets))
+	matched := false
+	for _, w := range wallets {
+		o, err := m.checkWallet(ctx, w.ID, check)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		outcomes = append(outcomes, o)
+		if o.err == nil {
+			matched = true
+		}
+	}
+
+	if matched {
+		return outcomes, nil
+	}
+
+	for i, o := range outcomes {
+		if !errors.Is(o.err, wallet.ErrWrongPassword) {
+			continue
+		}
+
+		outcomes[i].err = m.countFailure(ctx, o.wallet.ID)
+		var attemptErr *AttemptError
+		if !errors.As(outcomes[i].err, &attemptErr) {
+			return nil, outcomes[i].err
+		}
+	}
+
+	return outcomes, nil
+}
+
+// checkWallet runs check against the current state of a wallet under its
+// lock and resets its counter on success. Wrong passwords are left to the
+// caller to count.
+func (m *Manager) checkWallet(ctx context.Context, walletID int, check func(w *wallet.Wallet) error) (passwordOutcome, error) {
+	mu := m.attemptLock(walletID)
+	mu.Lock()
+	defer mu.Unlock()
+
+	w, err := m.store.FindWallet(ctx, walletID)
+	if err != nil {
+		return passwordOutcome{}, fmt.Errorf("find wallet: %w", err)
+	}
+
+	o := passwordOutcome{wallet: w}
+	if o.err = m.attemptAllowed(w); o.err != nil {
+		return o, nil
+	}
+
+	o.err = check(w)
+	if o.err == nil {
+		if err := m.recordSuccess(ctx, w); err != nil {
+			return o, err
+		}
+	}
+
+	return o, nil
+}
+
+// countFailure counts a wrong password against the current state of a wallet
+// under its lock.
+func (m *Manager) countFailure(ctx context.Context, walletID int) error {
+	mu := m.attemptLock(walletID)
+	mu.Lock()
+	defer mu.Unlock()
+
+	w, err := m.store.FindWallet(ctx, walletID)
+	if err != nil {
+		return fmt.Errorf("find wallet: %w", err)
+	}
+
+	return m.recordFailure(ctx, w)
+}
@@ -0,0 +1,127 @@
+package filwallet
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
+)
+
+func TestWithPasswordDoesNotBlockOtherWallets(t *testing.T) {
+	m := newTestManager(t, newMemStore(&wallet.Wallet{ID: 1}, &wallet.Wallet{ID: 2}), newTestNode())
+	ctx := context.Background()
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- m.withPassword(ctx, 1, func(*wallet.Wallet) error {
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+	<-started
+
+	checked := make(chan error)
+	go func() {
+		checked <- m.withPassword(ctx, 2, func(*wallet.Wallet) error { return nil })
+	}()
+
+	select {
+	case err := <-checked:
+		if err != nil {
+			t.Fatalf("check wallet 2: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("check of wallet 2 waited for wallet 1")
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("check wallet 1: %v", err)
+	}
+}
+
+func TestWithPasswordCountsConcurrentFailuresOnce(t *testing.T) {
+	store := newMemStore(&wallet.Wallet{ID: 1})
+	m := newTestManager(t, store, newTestNode())
+
+	const attempts = 10
+
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		wrong int
+	)
+	for range attempts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			err := m.withPassword(context.Background(), 1, func(*wallet.Wallet) error {
+				return wallet.ErrWrongPassword
+			})
+			if errors.Is(err, wallet.ErrWrongPassword) {
+				mu.Lock()
+				wrong++
+				mu.Unlock()
+			} else if !errors.Is(err, ErrTooManyAttempts) {
+				t.Errorf("check: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// The first wrong password starts a backoff that refuses the others
+	// uncounted.
+	if wrong != 1 {
+		t.Errorf("wrong password reported %d times, want 1", wrong)
+	}
+
+	w, _ := store.FindWallet(context.Background(), 1)
+	if w.FailedAttempts != 1 {
+		t.Errorf("failed attempts %d, want 1", w.FailedAttempts)
+	}
+}
+
+func TestWithPasswordAllCountsOnlyWhenNoneMatch(t *testing.T) {
+	store := newMemStore(&wallet.Wallet{ID: 1}, &wallet.Wallet{ID: 2})
+	m := newTestManager(t, store, newTestNode())
+	ctx := context.Background()
+
+	matchOne := func(w *wallet.Wallet) error {
+		if w.ID == 1 {
+			return nil
+		}
+		return wallet.ErrWrongPassword
+	}
+	if _, err := m.withPasswordAll(ctx, matchOne); err != nil {
+		t.Fatalf("check all: %v", err)
+	}
+
+	w, _ := store.FindWallet(ctx, 2)
+	if w.FailedAttempts != 0 {
+		t.Fatalf("failed attempts of wallet 2: %d, want 0", w.FailedAttempts)
+	}
+
+	outcomes, err := m.withPasswordAll(ctx, func(*wallet.Wallet) error { return wallet.ErrWrongPassword })
+	if err != nil {
+		t.Fatalf("check all: %v", err)
+	}
+
+	for _, o := range outcomes {
+		var attemptErr *AttemptError
+		if !errors.As(o.err, &attemptErr) || !errors.Is(o.err, wallet.ErrWrongPassword) {
+			t.Errorf("wallet %d: error %v, want a counted wrong password", o.wallet.ID, o.err)
+		}
+
+		w, _ := store.FindWallet(ctx, o.wallet.ID)
+		if w.FailedAttempts != 1 {
+			t.Errorf("failed attempts of wallet %d: %d, want 1", w.ID, w.FailedAttempts)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package filwallet
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// maxKeystoreFileSize bounds the files inspected as keystore candidates.
+// Keystore JSON is well under a kilobyte.
+const maxKeystoreFileSize = 64 << 10
+
+// RemoveKeystoreFiles securely deletes the UTC-- keystore files that earlier
+// versions wrote to the data directory for every wallet. Their key JSON is
+// also in the database, which is the only copy kept now. It returns how many
+// files were removed.
+func (m *Manager) RemoveKeystoreFiles() (int, error) {
+	entries, err := os.ReadDir(m.cfg.DataDir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("read data dir: %w", err)
+	}
+
+	removed := 0
+	var errs []error
+	for _, entry := range entries {
+		// Interrupted writes leave hidden temporary files behind.
+		name := strings.TrimPrefix(entry.Name(), ".")
+		if !entry.Type().IsRegular() || !strings.HasPrefix(name, "UTC--") {
+			continue
+		}
+
+		path := filepath.Join(m.cfg.DataDir, entry.Name())
+		if !isKeystoreFile(path) {
+			continue
+		}
+
+		if err := shredFile(path); err != nil {
+			errs = append(errs, fmt.Errorf("remove %s: %w", entry.Name(), err))
+			continue
+		}
+		removed++
+	}
+
+	return removed, errors.Join(errs...)
+}
+
+// isKeystoreFile reports whether path holds an Ethereum V3 keystore.
+func isKeystoreFile(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil || info.Size() > maxKeystoreFileSize {
+		return false
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false
+	}
+
+	var key struct {
+		Version int             `json:"version"`
+		Crypto  json.RawMessage `json:"crypto"`
+	}
+	return json.Unmarshal(data, &key) == nil && key.Version == 3 && len(key.Crypto) > 0
+}
+
+// shredFile overwrites a file with random bytes before removing it. On
+// journaling or copy-on-write filesystems and on SSDs the old blocks may
+// survive, so this is a best effort on top of the keys being encrypted.
+func shredFile(path string) error {
+	f, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+
+	info, err := f.Stat()
+	if err == nil {
+		_, err = io.CopyN(f, rand.Reader, info.Size())
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("overwrite: %w", err)
+	}
+
+	return os.Remove(path)
+}
@@ -0,0 +1,64 @@
+package filwallet
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestRemoveKeystoreFiles(t *testing.T) {
+	const keystore = `{"address":"008aeeda4d805471df9b2a5b0f38a0c3bcba786b","crypto":{"cipher":"aes-128-ctr"},"version":3}`
+
+	m := newTestManager(t, newMemStore(), newTestNode())
+	dir := m.cfg.DataDir
+
+	files := map[string]string{
+		"UTC--2024-01-01T00-00-00.000000000Z--008aeeda4d805471df9b2a5b0f38a0c3bcba786b": keystore,
+		".UTC--2024-01-01T00-00-00.000000000Z--tmp":                                     keystore,
+		"UTC--notes":      "not a keystore",
+		"backup.json":     keystore,
+		"filament-db.db":  "database",
+		"UTC--version-1":  `{"crypto":{},"version":1}`,
+		"UTC--no-crypto":  `{"version":3}`,
+		"UTC--large-file": keystore + string(make([]byte, maxKeystoreFileSize)),
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Mkdir(filepath.Join(dir, "UTC--directory"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+
+	removed, err := m.RemoveKeystoreFiles()
+	if err != nil {
+		t.Fatalf("remove keystore files: %v", err)
+	}
+	if removed != 2 {
+		t.Errorf("removed %d files, want 2", removed)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var left []string
+	for _, entry := range entries {
+		left = append(left, entry.Name())
+	}
+	want := []string{"UTC--directory", "UTC--large-file", "UTC--no-crypto", "UTC--notes", "UTC--version-1", "backup.json", "filament-db.db"}
+	if !slices.Equal(left, want) {
+		t.Errorf("left %v, want %v", left, want)
+	}
+
+	if removed, err := m.RemoveKeystoreFiles(); removed != 0 || err != nil {
+		t.Errorf("second run removed %d files, error %v", removed, err)
+	}
+
+	m.cfg.DataDir = filepath.Join(dir, "missing")
+	if removed, err := m.RemoveKeystoreFiles(); removed != 0 || err != nil {
+		t.Errorf("missing data dir: removed %d files, error %v", removed, err)
+	}
+}
@@ -2,25 +2,37 @@ package filwallet
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/awnumar/memguard"
+	"github.com/codemaestro64/filament/apps/api/pkg/util"
+	"github.com/codemaestro64/filament/libs/filwallet/address"
 	"github.com/codemaestro64/filament/libs/filwallet/wallet"
+	"github.com/rs/zerolog/log"
 )
 
-type sessionState struct {
-	vault     map[int]*memguard.Enclave
-	expiresAt time.Time
-}
-
 type Manager struct {
 	cfg       *Config
 	store     Store
 	rpcClient *RPCClient
+	balances  *balanceCache
 	session   *sessionState
-	mu        sync.RWMutex
+	// kdf hashes the passwords of new wallets. Wallets with weaker
+	// parameters are upgraded when unlocked.
+	kdf wallet.KDFParams
+	// exportLimiter throttles key exports per wallet.
+	exportLimiter *rateLimiter
+	// backupChallenges are the pending backup verifications, by wallet.
+	backupChallenges map[int]*backupChallenge
+	// attemptLocks holds a *sync.Mutex per wallet ID, serializing the
+	// password checks of a wallet so that its failed attempt counter cannot
+	// be raced. Checks of different wallets run concurrently.
+	attemptLocks sync.Map
+	mu           sync.RWMutex
 }
 
 func NewManager(ctx context.Context, store Store, cfg *Config) (*Manager, error) {
@@ -28,18 +40,44 @@ func NewManager(ctx context.Context, store Store, cfg *Config) (*Manager, error)
 		return nil, fmt.Errorf("initialize wallet manager: %w", err)
 	}
 
-	rpcClient, err := NewRPCClient(ctx, cfg.RPCEndpoint, cfg.RPCToken)
+	rpcClient, err := NewRPCClient(ctx, cfg.RPCEndpoints)
 	if err != nil {
 		return nil, fmt.Errorf("initialize rpc client: %w", err)
 	}
 
+	m, err := NewManagerWithClient(ctx, store, cfg, rpcClient)
+	if err != nil {
+		rpcClient.Close()
+		return nil, err
+	}
+
+	if err := m.normalizeAddressPrefixes(ctx); err != nil {
+		rpcClient.Close()
+		return nil, fmt.Errorf("initialize wallet manager: %w", err)
+	}
+	rpcClient.StartHealthChecks(ctx)
+	m.startActorResolver(ctx)
+
+	return m, nil
+}
+
+// NewManagerWithClient creates a manager that talks to the chain through an
+// existing RPC client.
+func NewManagerWithClient(ctx context.Context, store Store, cfg *Config, rpcClient *RPCClient) (*Manager, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("initialize wallet manager: %w", err)
+	}
+
 	m := &Manager{
-		cfg:       cfg,
-		rpcClient: rpcClient,
-		store:     store,
+		cfg:              cfg,
+		kdf:              cfg.kdfParams(),
+		rpcClient:        rpcClient,
+		balances:         newBalanceCache(balanceCacheTTL),
+		store:            store,
+		exportLimiter:    newRateLimiter(exportLimit, exportWindow),
+		backupChallenges: make(map[int]*backupChallenge),
 		session: &sessionState{
-			vault:     make(map[int]*memguard.Enclave),
-			expiresAt: time.Now().Add(30 * time.Minute),
+			vault: make(map[int]*walletSession),
 		},
 	}
 
@@ -48,18 +86,116 @@ func NewManager(ctx context.Context, store Store, cfg *Config) (*Manager, error)
 	return m, nil
 }
 
-func (m *Manager) importWallet(ctx context.Context, mnemonic, walletName, password string) (*wallet.Wallet, error) {
-	newWallet, err := wallet.CreateNew(m.cfg.DataDir, mnemonic, walletName, password)
+// Network returns the network the manager derives keys and addresses for.
+func (m *Manager) Network() util.Network {
+	return m.cfg.Network
+}
+
+// normalizeAddressPrefixes re-encodes stored addresses that carry the prefix
+// of another network, such as f addresses stored for a calibration wallet.
+func (m *Manager) normalizeAddressPrefixes(ctx context.Context) error {
+	wallets, err := m.store.GetWallets(ctx)
+	if err != nil {
+		return fmt.Errorf("get wallets: %w", err)
+	}
+
+	for _, w := range wallets {
+		renames := make(map[string]string)
+		for _, addr := range w.Addresses() {
+			normalized := address.WithNetwork(addr, m.cfg.Network)
+			if normalized.Value != addr.Value {
+				renames[addr.Value] = normalized.Value
+			}
+			if normalized.ActorID != addr.ActorID {
+				renames[addr.ActorID] = normalized.ActorID
+			}
+		}
+
+		if len(renames) == 0 {
+			continue
+		}
+
+		if err := m.store.RenameAddresses(ctx, w.ID, renames); err != nil {
+			return fmt.Errorf("rename addresses of wallet %d: %w", w.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// repairF1Addresses replaces the f1 addresses of w that were derived from the
+// compressed public key with the ones its keys own. Actor IDs looked up for
+// the stale addresses are cleared so that they are resolved again. It is best
+// effort, on failure the repair is retried on the next unlock.
+func (m *Manager) repairF1Addresses(ctx context.Context, w *wallet.Wallet, keyring wallet.Keyring) {
+	stale, err := w.StaleF1Addresses(keyring, m.cfg.Network)
+	if err != nil {
+		log.Error().Err(err).Int("wallet_id", w.ID).Msg("error re-deriving f1 addresses")
+		return
+	}
+
+	if len(stale) == 0 {
+		return
+	}
+
+	renames := make(map[string]string, len(stale)*2)
+	for _, addr := range w.Addresses() {
+		derived, ok := stale[addr.Value]
+		if !ok {
+			continue
+		}
+
+		renames[addr.Value] = derived
+		if addr.ActorID != "" {
+			renames[addr.ActorID] = ""
+		}
+	}
+
+	if err := m.store.RenameAddresses(ctx, w.ID, renames); err != nil {
+		log.Error().Err(err).Int("wallet_id", w.ID).Msg("error replacing stale f1 addresses")
+		return
+	}
+
+	log.Info().Int("wallet_id", w.ID).Int("count", len(stale)).Msg("replaced stale f1 addresses")
+}
+
+// KDFParams returns the password hashing parameters of new wallets.
+func (m *Manager) KDFParams() wallet.KDFParams {
+	return m.kdf
+}
+
+// RPCHealth returns the health of the configured RPC endpoints.
+func (m *Manager) RPCHealth() []EndpointHealth {
+	return m.rpcClient.Health()
+}
+
+// importWallet creates and saves a wallet from mnemonic. Recovered wallets
+// count as backed up, their seed was just entered from the backup.
+func (m *Manager) importWallet(ctx context.Context, mnemonic, passphrase, walletName, password string, recovered bool) (*wallet.Wallet, error) {
+	newWallet, err := wallet.CreateNew(mnemonic, passphrase, walletName, password, m.cfg.Network, m.kdf)
 	if err != nil {
 		return nil, fmt.Errorf("create wallet: %w", err)
 	}
 
+	if recovered {
+		newWallet.BackupVerifiedAt = time.Now()
+	}
+
+	return m.saveWallet(ctx, newWallet, password)
+}
+
+// saveWallet persists a newly created wallet and unlocks it.
+func (m *Manager) saveWallet(ctx context.Context, newWallet *wallet.Wallet, password string) (*wallet.Wallet, error) {
 	dbWallet, err := m.store.SaveWallet(ctx, SaveWalletParams{
-		KeyJSON:       newWallet.EncryptedKeyJSON,
-		EncryptedSeed: newWallet.EncryptedMnemonic,
-		Addresses:     newWallet.Addresses,
-		Name:          newWallet.Name,
-		Salt:          newWallet.Salt,
+		KeyJSON:             newWallet.EncryptedKeyJSON,
+		EncryptedSeed:       newWallet.EncryptedMnemonic,
+		EncryptedPassphrase: newWallet.EncryptedPassphrase,
+		Accounts:            newWallet.Accounts,
+		Name:                newWallet.Name,
+		Salt:                newWallet.Salt,
+		KDF:                 newWallet.KDF,
+		DerivationScheme:    newWallet.DerivationScheme,
+		BackupVerifiedAt:    newWallet.BackupVerifiedAt,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("save wallet: %w", err)
@@ -76,58 +212,100 @@ func (m *Manager) importWallet(ctx context.Context, mnemonic, walletName, passwo
 
 func (m *Manager) UnlockWallet(ctx context.Context, walletID int, password string) error {
 	m.mu.RLock()
-	if _, exists := m.session.vault[walletID]; exists {
+	if ws, exists := m.session.vault[walletID]; exists && !ws.expired(time.Now()) {
 		m.mu.RUnlock()
 		return nil // Already unlocked, no work needed
 	}
 	m.mu.RUnlock()
 
-	wallet, err := m.store.FindWallet(ctx, walletID)
-	if err != nil {
-		return fmt.Errorf("find wallet: %w", err)
-	}
-
-	enclave, err := wallet.Unlock(password)
+	var keyring wallet.Keyring
+	err := m.withPassword(ctx, walletID, func(w *wallet.Wallet) (err error) {
+		keyring, err = w.Unlock(password)
+		if err == nil {
+			m.upgradeKDF(ctx, w, password)
+			m.repairF1Addresses(ctx, w, keyring)
+		}
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("unlock wallet: %w", err)
 	}
 
 	m.mu.Lock()
-	m.session.vault[wallet.ID] = enclave
+	m.session.vault[walletID] = m.newSession(keyring)
 	m.mu.Unlock()
 
-	expireDuration := time.Minute * time.Duration(m.cfg.SessionTimeout)
-	m.session.expiresAt = time.Now().Add(expireDuration)
-
 	return nil
 }
 
-func (m *Manager) UnlockAllWallets(ctx context.Context, password string) error {
-	wallets, err := m.store.GetWallets(ctx)
+// UnlockStatus is the outcome of unlocking a single wallet.
+type UnlockStatus string
+
+const (
+	UnlockStatusUnlocked      UnlockStatus = "unlocked"
+	UnlockStatusWrongPassword UnlockStatus = "wrong_password"
+	// UnlockStatusCorrupted means the password was not the problem, the
+	// stored key could not be decrypted.
+	UnlockStatusCorrupted UnlockStatus = "corrupted"
+	// UnlockStatusLockedOut wallets were not tried, they are backing off
+	// after too many wrong passwords.
+	UnlockStatusLockedOut UnlockStatus = "locked_out"
+)
+
+type UnlockResult struct {
+	WalletID int
+	Status   UnlockStatus
+	// RetryAfter is set for locked out wallets.
+	RetryAfter time.Time
+	// Err is set for corrupted wallets.
+	Err error
+}
+
+// UnlockAllWallets tries password on every wallet and unlocks the ones it
+// matches. Wallets with another password keep their current session.
+func (m *Manager) UnlockAllWallets(ctx context.Context, password string) ([]UnlockResult, error) {
+	tempVault := make(map[int]wallet.Keyring)
+	outcomes, err := m.withPasswordAll(ctx, func(w *wallet.Wallet) error {
+		keyring, err := w.Unlock(password)
+		if err == nil {
+			tempVault[w.ID] = keyring
+			m.upgradeKDF(ctx, w, password)
+			m.repairF1Addresses(ctx, w, keyring)
+		}
+		return err
+	})
 	if err != nil {
-		return fmt.Errorf("get wallets: %w", err)
+		return nil, err
 	}
 
-	tempVault := make(map[int]*memguard.Enclave)
-	for _, wallet := range wallets {
-		enclave, err := wallet.Unlock(password)
-		if err != nil {
-			return fmt.Errorf("unlock wallet %d: %w", wallet.ID, err)
+	results := make([]UnlockResult, 0, len(outcomes))
+	for _, o := range outcomes {
+		result := UnlockResult{WalletID: o.wallet.ID, Status: UnlockStatusUnlocked}
+
+		var attemptErr *AttemptError
+		switch {
+		case o.err == nil:
+		case errors.Is(o.err, ErrTooManyAttempts) && errors.As(o.err, &attemptErr):
+			result.Status = UnlockStatusLockedOut
+			result.RetryAfter = attemptErr.RetryAfter
+		case errors.Is(o.err, wallet.ErrWrongPassword):
+			result.Status = UnlockStatusWrongPassword
+		default:
+			result.Status = UnlockStatusCorrupted
+			result.Err = fmt.Errorf("unlock wallet %d: %w", o.wallet.ID, o.err)
 		}
-		tempVault[wallet.ID] = enclave
+
+		results = append(results, result)
 	}
 
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	for id, enclave := range tempVault {
-		m.session.vault[id] = enclave
+	for id, keyring := range tempVault {
+		m.session.vault[id] = m.newSession(keyring)
 	}
 
-	expireDuration := time.Minute * time.Duration(m.cfg.SessionTimeout)
-	m.session.expiresAt = time.Now().Add(expireDuration)
-
-	return nil
+	return results, nil
 }
 
 func (m *Manager) WalletsCount(ctx context.Context) (int, error) {
@@ -139,37 +317,78 @@ func (m *Manager) WalletsCount(ctx context.Context) (int, error) {
 	return count, nil
 }
 
-func (m *Manager) RecoverWallet(ctx context.Context, seedWords, walletName, password string) (*wallet.Wallet, error) {
-	if !ValidateMnemonic(seedWords) {
+// RecoverWalletParams are the inputs of RecoverWallet.
+type RecoverWalletParams struct {
+	SeedPhrase string
+	// Passphrase is the optional BIP39 passphrase the seed was created with.
+	Passphrase string
+	// Language restricts the seed phrase to one wordlist. It is detected
+	// when empty.
+	Language Language
+	Name     string
+	Password string
+}
+
+// RecoverWallet restores a wallet from its seed words, which are normalized
+// first so that spacing and Unicode forms don't matter.
+func (m *Manager) RecoverWallet(ctx context.Context, p RecoverWalletParams) (*wallet.Wallet, error) {
+	if p.Language != "" && !p.Language.Valid() {
+		return nil, ErrUnsupportedLanguage
+	}
+
+	seedWords := NormalizeMnemonic(p.SeedPhrase)
+	if p.Language != "" {
+		if !mnemonicValidIn(strings.Fields(seedWords), p.Language) {
+			return nil, ErrInvalidSeedPhrase
+		}
+	} else if !ValidateMnemonic(seedWords) {
 		return nil, ErrInvalidSeedPhrase
 	}
 
-	if password == "" {
+	if p.Password == "" {
 		return nil, ErrInvalidPassword
 	}
 
-	if walletName == "" {
+	if p.Name == "" {
 		return nil, ErrInvalidWalletName
 	}
 
-	return m.importWallet(ctx, seedWords, walletName, password)
+	return m.importWallet(ctx, seedWords, NormalizePassphrase(p.Passphrase), p.Name, p.Password, true)
 }
 
-func (m *Manager) CreateWallet(ctx context.Context, walletName, password string) (*wallet.Wallet, string, error) {
-	if password == "" {
+// CreateWalletParams are the inputs of CreateWallet.
+type CreateWalletParams struct {
+	Name     string
+	Password string
+	// Passphrase is an optional BIP39 passphrase for the new seed.
+	Passphrase string
+	// WordCount is 12 or 24, 12 when zero.
+	WordCount int
+	// Language is the wordlist of the mnemonic, English when empty.
+	Language Language
+}
+
+// CreateWallet creates a wallet from a new mnemonic, which is returned.
+func (m *Manager) CreateWallet(ctx context.Context, p CreateWalletParams) (*wallet.Wallet, string, error) {
+	if p.Password == "" {
 		return nil, "", ErrInvalidPassword
 	}
 
-	if walletName == "" {
+	if p.Name == "" {
 		return nil, "", ErrInvalidWalletName
 	}
 
-	mnemonic, err := GenerateMnemonic(128)
+	bits, err := WordCountBits(p.WordCount)
+	if err != nil {
+		return nil, "", err
+	}
+
+	mnemonic, err := GenerateMnemonic(bits, p.Language)
 	if err != nil {
 		return nil, "", fmt.Errorf("generate seed words: %w", err)
 	}
 
-	wallet, err := m.importWallet(ctx, mnemonic, walletName, password)
+	wallet, err := m.importWallet(ctx, NormalizeMnemonic(mnemonic), NormalizePassphrase(p.Passphrase), p.Name, p.Password, false)
 	if err != nil {
 		return nil, "", fmt.Errorf("create wallet: %w", err)
 	}
@@ -177,32 +396,86 @@ func (m *Manager) CreateWallet(ctx context.Context, walletName, password string)
 	return wallet, mnemonic, nil
 }
 
-func (m *Manager) LockWallets() {
+// ImportPrivateKey creates a wallet from a single secp256k1 or BLS key, given
+// as raw secp256k1 hex or as the hex encoded KeyInfo of `lotus wallet export`.
+func (m *Manager) ImportPrivateKey(ctx context.Context, encodedKey, walletName, password string) (*wallet.Wallet, error) {
+	if password == "" {
+		return nil, ErrInvalidPassword
+	}
+
+	if walletName == "" {
+		return nil, ErrInvalidWalletName
+	}
+
+	newWallet, err := wallet.ImportKey(encodedKey, walletName, password, m.cfg.Network, m.kdf)
+	if err != nil {
+		return nil, fmt.Errorf("import key: %w", err)
+	}
+
+	return m.saveWallet(ctx, newWallet, password)
+}
+
+// ImportKeystore creates a wallet from an Ethereum V3 keystore JSON and the
+// password it is encrypted with.
+func (m *Manager) ImportKeystore(ctx context.Context, keyJSON []byte, keystorePassword, walletName, password string) (*wallet.Wallet, error) {
+	if password == "" {
+		return nil, ErrInvalidPassword
+	}
+
+	if walletName == "" {
+		return nil, ErrInvalidWalletName
+	}
+
+	newWallet, err := wallet.ImportKeystore(keyJSON, keystorePassword, walletName, password, m.cfg.Network, m.kdf)
+	if err != nil {
+		return nil, fmt.Errorf("import keystore: %w", err)
+	}
+
+	return m.saveWallet(ctx, newWallet, password)
+}
+
+// DecryptSeedPhrase returns the mnemonic of a wallet and its BIP39
+// passphrase, empty when there is none. Wrong passwords count towards the
+// wallet's lockout.
+func (m *Manager) DecryptSeedPhrase(ctx context.Context, walletID int, password string) (string, string, error) {
+	var mnemonic, passphrase string
+	err := m.withPassword(ctx, walletID, func(w *wallet.Wallet) (err error) {
+		mnemonic, passphrase, err = w.DecryptSeedPhrase(password)
+		return err
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("decrypt seed phrase: %w", err)
+	}
+
+	return mnemonic, passphrase, nil
+}
+
+// AddAccount derives the next BIP44 account of a wallet and stores its addresses.
+// The new key is added to the session when the wallet is already unlocked.
+func (m *Manager) AddAccount(ctx context.Context, walletID int, password string) (*wallet.Account, error) {
+	if password == "" {
+		return nil, ErrInvalidPassword
+	}
+
+	var account *wallet.Account
+	var enclave *memguard.Enclave
+	err := m.withPassword(ctx, walletID, func(w *wallet.Wallet) (err error) {
+		account, enclave, err = w.DeriveAccount(password, m.cfg.Network)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("derive account: %w", err)
+	}
+
+	if err := m.store.SaveAccount(ctx, walletID, *account); err != nil {
+		return nil, fmt.Errorf("save account: %w", err)
+	}
+
 	m.mu.Lock()
-	defer m.mu.RUnlock()
-
-	m.session.vault = make(map[int]*memguard.Enclave)
-	m.session.expiresAt = time.Time{}
-}
-
-func (m *Manager) startSessionJanitor(ctx context.Context) {
-	ticker := time.NewTicker(1 * time.Minute)
-	go func() {
-		defer ticker.Stop()
-		for {
-			select {
-			case <-ctx.Done():
-				m.LockWallets()
-				return
-			case <-ticker.C:
-				m.mu.Lock()
-				if !m.session.expiresAt.IsZero() && time.Now().After(m.session.expiresAt) {
-					m.mu.Unlock()
-					m.LockWallets()
-				} else {
-					m.mu.Unlock()
-				}
-			}
-		}
-	}()
+	if ws, ok := m.session.vault[walletID]; ok {
+		ws.keyring[account.Index] = enclave
+	}
+	m.mu.Unlock()
+
+	return account, nil
 }
@@ -0,0 +1,244 @@
+package filwallet
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/awnumar/memguard"
+	"github.com/codemaestro64/filament/libs/filwallet/address"
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
+	goaddress "github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/builtin"
+	"github.com/filecoin-project/go-state-types/crypto"
+	"github.com/filecoin-project/lotus/chain/types"
+)
+
+// Migration compares the addresses of a legacy wallet with the ones it gets
+// under BIP44 derivation.
+type Migration struct {
+	WalletID         int
+	DerivationScheme wallet.DerivationScheme
+	LegacyAddresses  []address.Address
+	NewAddresses     []address.Address
+	// Sweeps move the balances of the legacy f1 and f4 addresses to the new
+	// address of the same type, signed with the legacy key. They are empty
+	// when not requested or there is nothing to move, and were pushed to the
+	// message pool when returned by a commit.
+	Sweeps []*types.SignedMessage
+}
+
+// sweepTypes are the types of the legacy addresses whose balance is swept.
+// 0x addresses share the actor of their f4 counterpart.
+var sweepTypes = []address.Type{address.TypeF1, address.TypeF4}
+
+// MigrateWallet re-derives the keys of a legacy wallet with the standard BIP44
+// path. Without commit only a preview is returned, with the sweeps of the
+// legacy balances signed by the still active legacy key when buildSweep is
+// set. A commit with buildSweep pushes the sweeps instead, the migration is
+// persisted by a later commit once they landed. Committing is refused while
+// the legacy addresses hold more than the fee of a sweep.
+func (m *Manager) MigrateWallet(ctx context.Context, walletID int, password string, commit, buildSweep bool) (*Migration, error) {
+	if password == "" {
+		return nil, ErrInvalidPassword
+	}
+
+	var w *wallet.Wallet
+	var account *wallet.Account
+	var legacyKey *memguard.Enclave
+	err := m.withPassword(ctx, walletID, func(found *wallet.Wallet) (err error) {
+		w = found
+		account, err = w.DeriveBIP44Account(password, m.cfg.Network)
+		if err != nil || !buildSweep {
+			return err
+		}
+
+		keyring, err := w.Unlock(password)
+		if err != nil {
+			return err
+		}
+		legacyKey = keyring[0]
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("derive bip44 account: %w", err)
+	}
+
+	migration := &Migration{
+		WalletID:         walletID,
+		DerivationScheme: w.DerivationScheme,
+		LegacyAddresses:  w.Addresses(),
+		NewAddresses:     account.Addresses,
+	}
+
+	if buildSweep {
+		migration.Sweeps, err = m.buildSweeps(ctx, legacyKey, migration.LegacyAddresses, migration.NewAddresses)
+		if err != nil {
+			return nil, fmt.Errorf("build sweep: %w", err)
+		}
+	}
+
+	if !commit {
+		return migration, nil
+	}
+
+	if len(migration.Sweeps) > 0 {
+		for _, sweep := range migration.Sweeps {
+			if _, err := m.rpcClient.MpoolPush(ctx, sweep); err != nil {
+				return nil, fmt.Errorf("push sweep: %w", err)
+			}
+		}
+
+		return migration, nil
+	}
+
+	if err := m.ensureNoBalance(ctx, migration.LegacyAddresses, migration.NewAddresses); err != nil {
+		return nil, err
+	}
+
+	keyJSON, account, err := w.MigrateToBIP44(password, m.cfg.Network)
+	if err != nil {
+		return nil, fmt.Errorf("migrate wallet: %w", err)
+	}
+
+	err = m.store.UpdateWalletDerivation(ctx, walletID, UpdateDerivationParams{
+		Scheme:   wallet.SchemeBIP44,
+		KeyJSON:  keyJSON,
+		Accounts: []wallet.Account{*account},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update wallet derivation: %w", err)
+	}
+
+	// The session still holds the legacy key.
+	m.mu.Lock()
+	delete(m.session.vault, walletID)
+	m.mu.Unlock()
+
+	migration.DerivationScheme = wallet.SchemeBIP44
+
+	return migration, nil
+}
+
+// buildSweeps builds and signs with key the sweeps of the legacy addresses in
+// from to the addresses in to.
+func (m *Manager) buildSweeps(ctx context.Context, key *memguard.Enclave, from, to []address.Address) ([]*types.SignedMessage, error) {
+	var sweeps []*types.SignedMessage
+	for _, t := range sweepTypes {
+		msg, err := m.sweepMessage(ctx, from, to, t)
+		if err != nil {
+			return nil, err
+		}
+		if msg == nil {
+			continue
+		}
+
+		var sig *crypto.Signature
+		if t == address.TypeF4 {
+			sig, err = signDelegatedMessage(key, msg)
+		} else {
+			sig, err = signSecp256k1Message(key, msg)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		sweeps = append(sweeps, &types.SignedMessage{Message: *msg, Signature: *sig})
+	}
+
+	return sweeps, nil
+}
+
+// sweepMessage builds the message transferring the whole balance of the
+// address of type t in from to the one in to, less the maximum fee of the
+// message. It is nil when there is no such address or the balance does not
+// cover the fee; that dust is left behind.
+func (m *Manager) sweepMessage(ctx context.Context, from, to []address.Address, t address.Type) (*types.Message, error) {
+	if !hasType(from, t) || !hasType(to, t) {
+		return nil, nil
+	}
+
+	fromAddr, err := firstOfType(from, t)
+	if err != nil {
+		return nil, err
+	}
+
+	toAddr, err := firstOfType(to, t)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := m.rpcClient.WalletBalance(ctx, fromAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if balance.IsZero() {
+		return nil, nil
+	}
+
+	nonce, err := m.rpcClient.MpoolGetNonce(ctx, fromAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &types.Message{
+		From:  fromAddr,
+		To:    toAddr,
+		Nonce: nonce,
+		Value: types.NewInt(0),
+	}
+
+	// Eth accounts only send by invoking the recipient as a contract, the
+	// placeholder of a new f4 address takes that as a plain transfer.
+	if t == address.TypeF4 {
+		msg.Method = builtin.MethodsEVM.InvokeContract
+	}
+
+	msg, err = m.rpcClient.GasEstimateMessageGas(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	maxFee := types.BigMul(msg.GasFeeCap, types.NewInt(uint64(msg.GasLimit)))
+	msg.Value = types.BigSub(balance, maxFee)
+	if msg.Value.Sign() <= 0 {
+		return nil, nil
+	}
+
+	return msg, nil
+}
+
+// ensureNoBalance refuses a commit while a legacy address holds a balance a
+// sweep could still move.
+func (m *Manager) ensureNoBalance(ctx context.Context, legacy, migrated []address.Address) error {
+	for _, t := range sweepTypes {
+		msg, err := m.sweepMessage(ctx, legacy, migrated, t)
+		if err != nil {
+			return err
+		}
+
+		if msg != nil {
+			return ErrLegacyBalance
+		}
+	}
+
+	return nil
+}
+
+func firstOfType(addrs []address.Address, t address.Type) (goaddress.Address, error) {
+	for _, addr := range addrs {
+		if addr.Type == t {
+			return goaddress.NewFromString(addr.Value)
+		}
+	}
+
+	return goaddress.Undef, fmt.Errorf("no address of type %d", t)
+}
+
+func hasType(addrs []address.Address, t address.Type) bool {
+	return slices.ContainsFunc(addrs, func(addr address.Address) bool {
+		return addr.Type == t
+	})
+}
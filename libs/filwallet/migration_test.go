@@ -0,0 +1,204 @@
+package filwallet
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/codemaestro64/filament/apps/api/pkg/util"
+	"github.com/codemaestro64/filament/libs/filwallet/address"
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
+	goaddress "github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/builtin"
+	"github.com/filecoin-project/go-state-types/crypto"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/filecoin-project/lotus/chain/types/ethtypes"
+	"github.com/filecoin-project/lotus/lib/sigs"
+	_ "github.com/filecoin-project/lotus/lib/sigs/delegated"
+)
+
+// newLegacyWallet returns a wallet whose key, like that of a legacy wallet,
+// is not the one its seed derives at the BIP44 path: the key was derived
+// with a BIP39 passphrase the stored seed no longer has.
+func newLegacyWallet(t *testing.T) *wallet.Wallet {
+	t.Helper()
+
+	w, err := wallet.CreateNew(testMnemonic, "legacy", "legacy", testPassword, util.CalibrationNet, testKDF)
+	if err != nil {
+		t.Fatalf("create wallet: %v", err)
+	}
+	w.ID = 1
+	w.DerivationScheme = wallet.SchemeLegacy
+	w.EncryptedPassphrase = nil
+
+	return w
+}
+
+// newMigrationManager funds the f1 and f4 addresses of a legacy wallet with
+// f1Balance and f4Balance.
+func newMigrationManager(t *testing.T, f1Balance, f4Balance int64) (*Manager, *memStore, *fakeNode, *wallet.Wallet) {
+	t.Helper()
+
+	w := newLegacyWallet(t)
+	node := newTestNode()
+	node.balances = map[goaddress.Address]types.BigInt{
+		mustFirstOfType(t, w.Addresses(), address.TypeF1): types.NewInt(uint64(f1Balance)),
+		mustFirstOfType(t, w.Addresses(), address.TypeF4): types.NewInt(uint64(f4Balance)),
+	}
+
+	store := newMemStore(w)
+	return newTestManager(t, store, node), store, node, w
+}
+
+func mustFirstOfType(t *testing.T, addrs []address.Address, typ address.Type) goaddress.Address {
+	t.Helper()
+
+	addr, err := firstOfType(addrs, typ)
+	if err != nil {
+		t.Fatalf("%s address: %v", typ, err)
+	}
+
+	return addr
+}
+
+// signingBytes returns what Lotus verifies sig against: the CID of msg, or the
+// RLP of its Ethereum transaction for delegated signatures.
+func signingBytes(t *testing.T, sig *crypto.Signature, msg *types.Message) []byte {
+	t.Helper()
+
+	if sig.Type != crypto.SigTypeDelegated {
+		return msg.Cid().Bytes()
+	}
+
+	tx, err := ethtypes.Eth1559TxArgsFromUnsignedFilecoinMessage(msg)
+	if err != nil {
+		t.Fatalf("eth transaction: %v", err)
+	}
+
+	rlp, err := tx.ToRlpUnsignedMsg()
+	if err != nil {
+		t.Fatalf("rlp: %v", err)
+	}
+
+	return rlp
+}
+
+func TestMigrateWalletPreview(t *testing.T) {
+	m, store, node, w := newMigrationManager(t, 1_000_000, 500_000)
+
+	migration, err := m.MigrateWallet(context.Background(), w.ID, testPassword, false, true)
+	if err != nil {
+		t.Fatalf("migrate wallet: %v", err)
+	}
+
+	if migration.DerivationScheme != wallet.SchemeLegacy {
+		t.Errorf("derivation scheme %s, want %s", migration.DerivationScheme, wallet.SchemeLegacy)
+	}
+
+	legacyF1 := mustFirstOfType(t, migration.LegacyAddresses, address.TypeF1)
+	newF1 := mustFirstOfType(t, migration.NewAddresses, address.TypeF1)
+	if legacyF1 == newF1 {
+		t.Fatalf("legacy and new f1 address are both %s", legacyF1)
+	}
+
+	if len(migration.Sweeps) != 2 {
+		t.Fatalf("got %d sweeps, want 2", len(migration.Sweeps))
+	}
+
+	// The max fee of a sweep is gas limit 1000 times fee cap 100.
+	tests := []struct {
+		typ    address.Type
+		value  int64
+		method uint64
+	}{
+		{address.TypeF1, 1_000_000 - 100_000, 0},
+		{address.TypeF4, 500_000 - 100_000, uint64(builtin.MethodsEVM.InvokeContract)},
+	}
+
+	for i, tt := range tests {
+		sweep := migration.Sweeps[i]
+		msg := sweep.Message
+
+		from := mustFirstOfType(t, migration.LegacyAddresses, tt.typ)
+		to := mustFirstOfType(t, migration.NewAddresses, tt.typ)
+		if msg.From != from || msg.To != to {
+			t.Errorf("%s sweep: %s -> %s, want %s -> %s", tt.typ, msg.From, msg.To, from, to)
+		}
+
+		if !msg.Value.Equals(types.NewInt(uint64(tt.value))) {
+			t.Errorf("%s sweep: value %s, want %d", tt.typ, msg.Value, tt.value)
+		}
+
+		if uint64(msg.Method) != tt.method || msg.Nonce != node.nonce {
+			t.Errorf("%s sweep: method %d nonce %d, want %d and %d", tt.typ, msg.Method, msg.Nonce, tt.method, node.nonce)
+		}
+
+		if err := sigs.Verify(&sweep.Signature, from, signingBytes(t, &sweep.Signature, &msg)); err != nil {
+			t.Errorf("%s sweep: signature: %v", tt.typ, err)
+		}
+	}
+
+	if len(node.pushed) != 0 {
+		t.Errorf("preview pushed %d messages", len(node.pushed))
+	}
+
+	stored, _ := store.FindWallet(context.Background(), w.ID)
+	if stored.DerivationScheme != wallet.SchemeLegacy {
+		t.Errorf("preview stored derivation scheme %s", stored.DerivationScheme)
+	}
+}
+
+func TestMigrateWalletCommitRefusedWithBalance(t *testing.T) {
+	m, store, _, w := newMigrationManager(t, 0, 500_000)
+
+	_, err := m.MigrateWallet(context.Background(), w.ID, testPassword, true, false)
+	if !errors.Is(err, ErrLegacyBalance) {
+		t.Fatalf("commit: %v, want %v", err, ErrLegacyBalance)
+	}
+
+	stored, _ := store.FindWallet(context.Background(), w.ID)
+	if stored.DerivationScheme != wallet.SchemeLegacy {
+		t.Errorf("refused commit stored derivation scheme %s", stored.DerivationScheme)
+	}
+}
+
+func TestMigrateWalletCommitPushesSweeps(t *testing.T) {
+	m, store, node, w := newMigrationManager(t, 1_000_000, 500_000)
+	ctx := context.Background()
+
+	migration, err := m.MigrateWallet(ctx, w.ID, testPassword, true, true)
+	if err != nil {
+		t.Fatalf("commit with sweeps: %v", err)
+	}
+
+	if len(node.pushed) != 2 || len(migration.Sweeps) != 2 {
+		t.Fatalf("pushed %d of %d sweeps, want 2", len(node.pushed), len(migration.Sweeps))
+	}
+
+	if migration.DerivationScheme != wallet.SchemeLegacy {
+		t.Errorf("commit with pending sweeps migrated to %s", migration.DerivationScheme)
+	}
+
+	// Once the sweeps landed, only dust below the fee of a sweep is left.
+	for addr := range node.balances {
+		node.balances[addr] = types.NewInt(50_000)
+	}
+
+	migration, err = m.MigrateWallet(ctx, w.ID, testPassword, true, true)
+	if err != nil {
+		t.Fatalf("commit: %v", err)
+	}
+
+	if migration.DerivationScheme != wallet.SchemeBIP44 || len(migration.Sweeps) != 0 {
+		t.Errorf("commit: scheme %s with %d sweeps, want %s without", migration.DerivationScheme, len(migration.Sweeps), wallet.SchemeBIP44)
+	}
+
+	stored, _ := store.FindWallet(ctx, w.ID)
+	if stored.DerivationScheme != wallet.SchemeBIP44 {
+		t.Errorf("stored derivation scheme %s, want %s", stored.DerivationScheme, wallet.SchemeBIP44)
+	}
+
+	if got, want := stored.Addresses(), migration.NewAddresses; mustFirstOfType(t, got, address.TypeF1) != mustFirstOfType(t, want, address.TypeF1) {
+		t.Errorf("stored addresses %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,63 @@
+package filwallet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/codemaestro64/filament/libs/filwallet/address"
+)
+
+func TestRecoverWalletWithPassphrase(t *testing.T) {
+	const passphrase = "25th word"
+
+	w, err := testWallet()
+	if err != nil {
+		t.Fatalf("create wallet: %v", err)
+	}
+	withoutPassphrase := addressesByType(w)[address.TypeF1]
+
+	ctx := context.Background()
+	recoverF1 := func(m *Manager) string {
+		t.Helper()
+
+		recovered, err := m.RecoverWallet(ctx, RecoverWalletParams{
+			SeedPhrase: testMnemonic,
+			Passphrase: passphrase,
+			Name:       "recovered",
+			Password:   testPassword,
+		})
+		if err != nil {
+			t.Fatalf("recover wallet: %v", err)
+		}
+
+		return addressesByType(recovered)[address.TypeF1]
+	}
+
+	m := newTestManager(t, newMemStore(), newTestNode())
+	withPassphrase := recoverF1(m)
+	if withPassphrase == withoutPassphrase {
+		t.Fatalf("passphrase did not change the f1 address %s", withPassphrase)
+	}
+
+	// The passphrase is stored with the seed and revealed as a flag only.
+	seed, err := m.RevealSeedPhrase(ctx, 1, testPassword)
+	if err != nil {
+		t.Fatalf("reveal seed phrase: %v", err)
+	}
+	if !seed.HasPassphrase {
+		t.Error("revealed seed does not report its passphrase")
+	}
+
+	mnemonic, stored, err := m.DecryptSeedPhrase(ctx, 1, testPassword)
+	if err != nil {
+		t.Fatalf("decrypt seed phrase: %v", err)
+	}
+	if mnemonic != testMnemonic || stored != passphrase {
+		t.Errorf("decrypted %q with passphrase %q, want %q with %q", mnemonic, stored, testMnemonic, passphrase)
+	}
+
+	// The same seed and passphrase recover the same wallet elsewhere.
+	if again := recoverF1(newTestManager(t, newMemStore(), newTestNode())); again != withPassphrase {
+		t.Errorf("recovered f1 address %s, then %s", withPassphrase, again)
+	}
+}
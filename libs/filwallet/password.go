@@ -0,0 +1,113 @@
+package filwallet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
+	"github.com/rs/zerolog/log"
+)
+
+// ChangePassword re-encrypts a wallet under newPassword. The session is kept,
+// the keys themselves do not change. The new secrets are written under the
+// wallet lock, so concurrent changes cannot overwrite each other.
+func (m *Manager) ChangePassword(ctx context.Context, walletID int, oldPassword, newPassword string) error {
+	if newPassword == "" {
+		return ErrInvalidPassword
+	}
+
+	err := m.withPassword(ctx, walletID, func(w *wallet.Wallet) error {
+		secrets, err := w.ChangePassword(oldPassword, newPassword, m.kdf)
+		if err != nil {
+			return err
+		}
+
+		err = m.store.UpdateWalletSecrets(ctx, []UpdateSecretsParams{{WalletID: w.ID, OldSalt: w.Salt, Secrets: *secrets}})
+		if err != nil {
+			return fmt.Errorf("update wallet secrets: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("change password: %w", err)
+	}
+
+	return nil
+}
+
+// ChangePasswordAll re-encrypts every wallet that uses oldPassword under
+// newPassword and returns their IDs. Wallets with another password are left
+// untouched. All wallets are updated together or not at all; when any of them
+// was re-encrypted after its check, none is and ErrWalletChanged is returned.
+func (m *Manager) ChangePasswordAll(ctx context.Context, oldPassword, newPassword string) ([]int, error) {
+	if newPassword == "" {
+		return nil, ErrInvalidPassword
+	}
+
+	var updates []UpdateSecretsParams
+	outcomes, err := m.withPasswordAll(ctx, func(w *wallet.Wallet) error {
+		secrets, err := w.ChangePassword(oldPassword, newPassword, m.kdf)
+		if err == nil {
+			updates = append(updates, UpdateSecretsParams{WalletID: w.ID, OldSalt: w.Salt, Secrets: *secrets})
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Report why nothing matched, a lockout takes precedence.
+	var firstErr error
+	for _, o := range outcomes {
+		if o.err != nil && (firstErr == nil || errors.Is(o.err, ErrTooManyAttempts)) {
+			firstErr = o.err
+		}
+		if o.err != nil && !errors.Is(o.err, wallet.ErrWrongPassword) && !errors.Is(o.err, ErrTooManyAttempts) {
+			return nil, fmt.Errorf("change password of wallet %d: %w", o.wallet.ID, o.err)
+		}
+	}
+
+	if len(updates) == 0 {
+		if firstErr == nil {
+			firstErr = wallet.ErrWrongPassword
+		}
+		return nil, firstErr
+	}
+
+	if err := m.store.UpdateWalletSecrets(ctx, updates); err != nil {
+		return nil, fmt.Errorf("update wallet secrets: %w", err)
+	}
+
+	ids := make([]int, 0, len(updates))
+	for _, u := range updates {
+		ids = append(ids, u.WalletID)
+	}
+
+	return ids, nil
+}
+
+// upgradeKDF re-encrypts w under the current kdf parameters when it uses
+// weaker ones. It is best effort, on failure w keeps its parameters and the
+// upgrade is retried on the next unlock. It runs under the wallet lock and
+// does not overwrite a wallet that was re-encrypted meanwhile.
+func (m *Manager) upgradeKDF(ctx context.Context, w *wallet.Wallet, password string) {
+	if !w.KDF.WeakerThan(m.kdf) {
+		return
+	}
+
+	secrets, err := w.ChangePassword(password, password, m.kdf)
+	if err != nil {
+		log.Error().Err(err).Int("wallet_id", w.ID).Msg("error re-encrypting wallet for kdf upgrade")
+		return
+	}
+
+	err = m.store.UpdateWalletSecrets(ctx, []UpdateSecretsParams{{WalletID: w.ID, OldSalt: w.Salt, Secrets: *secrets}})
+	if err != nil {
+		log.Error().Err(err).Int("wallet_id", w.ID).Msg("error saving wallet after kdf upgrade")
+		return
+	}
+
+	log.Info().Int("wallet_id", w.ID).Stringer("from", w.KDF).Stringer("to", m.kdf).Msg("upgraded wallet kdf")
+}
@@ -0,0 +1,136 @@
+package filwallet
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
+)
+
+// unlocksWith reports whether password decrypts the stored wallet, without
+// counting wrong passwords.
+func unlocksWith(t *testing.T, store *memStore, walletID int, password string) bool {
+	t.Helper()
+
+	w, err := store.FindWallet(context.Background(), walletID)
+	if err != nil {
+		t.Fatalf("find wallet: %v", err)
+	}
+
+	_, err = w.Unlock(password)
+	if err != nil && !errors.Is(err, wallet.ErrWrongPassword) {
+		t.Fatalf("unlock wallet: %v", err)
+	}
+
+	return err == nil
+}
+
+func newPasswordManager(t *testing.T, wallets int) (*Manager, *memStore, []int) {
+	t.Helper()
+
+	store := newMemStore()
+	m := newTestManager(t, store, newTestNode())
+
+	ids := make([]int, 0, wallets)
+	for range wallets {
+		w, _, err := m.CreateWallet(context.Background(), CreateWalletParams{Name: "test", Password: testPassword})
+		if err != nil {
+			t.Fatalf("create wallet: %v", err)
+		}
+		ids = append(ids, w.ID)
+	}
+
+	return m, store, ids
+}
+
+func TestChangePassword(t *testing.T) {
+	m, store, ids := newPasswordManager(t, 1)
+	ctx := context.Background()
+
+	if err := m.ChangePassword(ctx, ids[0], testPassword, "new password"); err != nil {
+		t.Fatalf("change password: %v", err)
+	}
+
+	m.LockWallet(ids[0])
+	if err := m.UnlockWallet(ctx, ids[0], "new password"); err != nil {
+		t.Fatalf("unlock with new password: %v", err)
+	}
+
+	if unlocksWith(t, store, ids[0], testPassword) {
+		t.Error("old password still unlocks the wallet")
+	}
+}
+
+func TestChangePasswordConcurrent(t *testing.T) {
+	m, store, ids := newPasswordManager(t, 1)
+
+	passwords := []string{"first", "second"}
+	errs := make([]error, len(passwords))
+
+	var wg sync.WaitGroup
+	for i, password := range passwords {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = m.ChangePassword(context.Background(), ids[0], testPassword, password)
+		}()
+	}
+	wg.Wait()
+
+	// The change that runs second no longer knows the password.
+	var winner string
+	for i, err := range errs {
+		switch {
+		case err == nil && winner == "":
+			winner = passwords[i]
+		case errors.Is(err, wallet.ErrWrongPassword):
+		default:
+			t.Fatalf("change to %q: %v", passwords[i], err)
+		}
+	}
+
+	if winner == "" {
+		t.Fatalf("no change succeeded: %v", errs)
+	}
+
+	if !unlocksWith(t, store, ids[0], winner) {
+		t.Errorf("the successful change to %q does not unlock the wallet", winner)
+	}
+}
+
+func TestChangePasswordAllWalletChanged(t *testing.T) {
+	m, store, ids := newPasswordManager(t, 2)
+	ctx := context.Background()
+
+	// Change the password of the first wallet after ChangePasswordAll
+	// checked it but before it writes.
+	store.beforeUpdate = func() {
+		store.beforeUpdate = nil
+		if err := m.ChangePassword(ctx, ids[0], testPassword, "other"); err != nil {
+			t.Errorf("change password: %v", err)
+		}
+	}
+
+	if _, err := m.ChangePasswordAll(ctx, testPassword, "all"); !errors.Is(err, ErrWalletChanged) {
+		t.Fatalf("change all: %v, want %v", err, ErrWalletChanged)
+	}
+
+	if !unlocksWith(t, store, ids[0], "other") {
+		t.Error("the concurrent change was overwritten")
+	}
+
+	if !unlocksWith(t, store, ids[1], testPassword) {
+		t.Error("a wallet was changed although the update failed")
+	}
+
+	changed, err := m.ChangePasswordAll(ctx, testPassword, "all")
+	if err != nil || len(changed) != 1 || changed[0] != ids[1] {
+		t.Fatalf("change all again: %v, %v, want [%d]", changed, err, ids[1])
+	}
+
+	if !unlocksWith(t, store, ids[1], "all") {
+		t.Error("the new password does not unlock the wallet")
+	}
+}
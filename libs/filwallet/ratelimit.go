@@ -0,0 +1,46 @@
+package filwallet
+
+import (
+	"sync"
+	"time"
+)
+
+// rateLimiter allows a fixed number of attempts per wallet within a sliding
+// window.
+type rateLimiter struct {
+	limit    int
+	window   time.Duration
+	mu       sync.Mutex
+	attempts map[int][]time.Time
+}
+
+func newRateLimiter(limit int, window time.Duration) *rateLimiter {
+	return &rateLimiter{
+		limit:    limit,
+		window:   window,
+		attempts: make(map[int][]time.Time),
+	}
+}
+
+// allow records an attempt for walletID. When the limit is reached the
+// attempt is refused and the time until the next one is allowed is returned.
+func (l *rateLimiter) allow(walletID int) (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	recent := l.attempts[walletID][:0]
+	for _, at := range l.attempts[walletID] {
+		if now.Sub(at) < l.window {
+			recent = append(recent, at)
+		}
+	}
+
+	if len(recent) >= l.limit {
+		l.attempts[walletID] = recent
+		return recent[0].Add(l.window).Sub(now), false
+	}
+
+	l.attempts[walletID] = append(recent, now)
+	return 0, true
+}
@@ -2,41 +2,325 @@ package filwallet
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"sort"
+	"sync"
+	"time"
 
+	goaddress "github.com/filecoin-project/go-address"
 	"github.com/filecoin-project/go-jsonrpc"
 	"github.com/filecoin-project/lotus/api"
-	"github.com/filecoin-project/lotus/api/client"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/ipfs/go-cid"
 )
 
+var ErrNoRPCEndpoint = errors.New("no rpc endpoint available")
+
+// RPCClient routes Lotus calls to the first healthy, in-sync endpoint and
+// fails over to the next one when an endpoint cannot be reached.
 type RPCClient struct {
-	node   api.FullNode
-	closer jsonrpc.ClientCloser
+	endpoints []*rpcEndpoint
 }
 
-func NewRPCClient(ctx context.Context, rpcEndpoint, rpcToken string) (*RPCClient, error) {
-	headers := make(http.Header)
-	if rpcToken != "" {
-		headers.Set("Authorization", "Bearer "+rpcToken)
+// NewRPCClient dials every endpoint. Endpoints that cannot be dialled are
+// marked as failing and dialled again by the health checks; only when none
+// can be dialled is an error returned. Connections live until ctx is done.
+func NewRPCClient(ctx context.Context, endpoints []RPCEndpoint) (*RPCClient, error) {
+	if len(endpoints) == 0 {
+		return nil, ErrNoRPCEndpoint
 	}
 
-	node, closer, err := client.NewFullNodeRPCV1(ctx, rpcEndpoint, headers)
-	if err != nil {
-		return nil, fmt.Errorf("dial rpc %s: %w", rpcEndpoint, err)
+	c := &RPCClient{}
+	var errs []error
+	for _, endpoint := range endpoints {
+		headers := make(http.Header)
+		if endpoint.Token != "" {
+			headers.Set("Authorization", "Bearer "+endpoint.Token)
+		}
+
+		e := &rpcEndpoint{
+			url:     endpoint.URL,
+			headers: headers,
+		}
+		c.endpoints = append(c.endpoints, e)
+
+		if _, err := e.dial(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) == len(c.endpoints) {
+		return nil, fmt.Errorf("%w: %w", ErrNoRPCEndpoint, errors.Join(errs...))
 	}
 
-	return &RPCClient{
-		node:   node,
-		closer: closer,
-	}, nil
+	return c, nil
+}
+
+// NewRPCClientFromNodes wraps existing node connections, such as fakes used
+// in tests. The nodes are used in the given order.
+func NewRPCClientFromNodes(nodes ...api.FullNode) *RPCClient {
+	c := &RPCClient{}
+	for i, node := range nodes {
+		c.endpoints = append(c.endpoints, &rpcEndpoint{
+			url:  fmt.Sprintf("node-%d", i),
+			node: node,
+		})
+	}
+
+	return c
 }
 
 func (c *RPCClient) Close() {
-	if c.closer == nil {
-		return
+	for _, e := range c.endpoints {
+		e.close()
+	}
+}
+
+// StartHealthChecks polls the chain head of every endpoint until ctx is done.
+func (c *RPCClient) StartHealthChecks(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(healthCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			c.CheckHealth(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// CheckHealth fetches the chain head of every endpoint outside of its backoff
+// window and records the head height and latency. Endpoints that could not be
+// dialled yet are dialled first, their connection lives until ctx is done.
+func (c *RPCClient) CheckHealth(ctx context.Context) {
+	now := time.Now()
+
+	var wg sync.WaitGroup
+	for _, e := range c.endpoints {
+		if !e.available(now) {
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			node, err := e.dial(ctx)
+			if err != nil {
+				return
+			}
+
+			checkCtx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+			defer cancel()
+
+			start := time.Now()
+			head, err := node.ChainHead(checkCtx)
+			if err != nil {
+				if ctx.Err() == nil {
+					e.recordFailure(err)
+				}
+				return
+			}
+
+			e.recordHead(int64(head.Height()), time.Since(start))
+		}()
+	}
+	wg.Wait()
+}
+
+// Health returns the state of every endpoint in configured order.
+func (c *RPCClient) Health() []EndpointHealth {
+	now := time.Now()
+	maxHeight := c.maxHeight()
+
+	health := make([]EndpointHealth, 0, len(c.endpoints))
+	for _, e := range c.endpoints {
+		health = append(health, e.health(maxHeight, now))
+	}
+
+	return health
+}
+
+func (c *RPCClient) maxHeight() int64 {
+	var maxHeight int64
+	for _, e := range c.endpoints {
+		maxHeight = max(maxHeight, e.headHeight())
+	}
+
+	return maxHeight
+}
+
+// candidates orders the endpoints by preference: available and in sync,
+// available but lagging, and finally those still backing off.
+func (c *RPCClient) candidates() []*rpcEndpoint {
+	now := time.Now()
+	maxHeight := c.maxHeight()
+
+	rank := func(e *rpcEndpoint) int {
+		switch {
+		case !e.available(now):
+			return 2
+		case !inSync(e.headHeight(), maxHeight):
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	ordered := append([]*rpcEndpoint(nil), c.endpoints...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return rank(ordered[i]) < rank(ordered[j])
+	})
+
+	return ordered
+}
+
+// call runs fn against the preferred endpoint. Transport failures mark the
+// endpoint as failing and the call is retried on the next candidate; errors
+// returned by the node itself are passed through. Endpoints that are not
+// connected are skipped, the health checks dial them.
+func (c *RPCClient) call(ctx context.Context, fn func(node api.FullNode) error) error {
+	var lastErr error
+	for _, e := range c.candidates() {
+		node := e.conn()
+		if node == nil {
+			continue
+		}
+
+		err := fn(node)
+		if err == nil {
+			e.recordSuccess()
+			return nil
+		}
+
+		var clientErr *jsonrpc.ErrClient
+		if !errors.As(err, &clientErr) || ctx.Err() != nil {
+			return err
+		}
+
+		e.recordFailure(err)
+		lastErr = fmt.Errorf("%s: %w", e.url, err)
+	}
+
+	if lastErr == nil {
+		return ErrNoRPCEndpoint
+	}
+
+	return lastErr
+}
+
+// WalletBalance returns the balance of addr in attoFIL.
+func (c *RPCClient) WalletBalance(ctx context.Context, addr goaddress.Address) (types.BigInt, error) {
+	var balance types.BigInt
+	err := c.call(ctx, func(node api.FullNode) (err error) {
+		balance, err = node.WalletBalance(ctx, addr)
+		return err
+	})
+	if err != nil {
+		return types.EmptyInt, fmt.Errorf("rpc: wallet balance %s: %w", addr, err)
+	}
+
+	return balance, nil
+}
+
+// MpoolGetNonce returns the next nonce for addr, including pending messages.
+func (c *RPCClient) MpoolGetNonce(ctx context.Context, addr goaddress.Address) (uint64, error) {
+	var nonce uint64
+	err := c.call(ctx, func(node api.FullNode) (err error) {
+		nonce, err = node.MpoolGetNonce(ctx, addr)
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("rpc: mpool get nonce %s: %w", addr, err)
+	}
+
+	return nonce, nil
+}
+
+// GasEstimateMessageGas fills in the gas limit, fee cap and premium of msg.
+func (c *RPCClient) GasEstimateMessageGas(ctx context.Context, msg *types.Message) (*types.Message, error) {
+	var estimated *types.Message
+	err := c.call(ctx, func(node api.FullNode) (err error) {
+		estimated, err = node.GasEstimateMessageGas(ctx, msg, nil, types.EmptyTSK)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("rpc: gas estimate message gas: %w", err)
+	}
+
+	return estimated, nil
+}
+
+// MpoolPush submits a signed message to the message pool and returns its CID.
+func (c *RPCClient) MpoolPush(ctx context.Context, smsg *types.SignedMessage) (cid.Cid, error) {
+	var msgCid cid.Cid
+	err := c.call(ctx, func(node api.FullNode) (err error) {
+		msgCid, err = node.MpoolPush(ctx, smsg)
+		return err
+	})
+	if err != nil {
+		return cid.Undef, fmt.Errorf("rpc: mpool push: %w", err)
+	}
+
+	return msgCid, nil
+}
+
+// StateLookupID returns the ID address of the actor behind addr.
+func (c *RPCClient) StateLookupID(ctx context.Context, addr goaddress.Address) (goaddress.Address, error) {
+	var id goaddress.Address
+	err := c.call(ctx, func(node api.FullNode) (err error) {
+		id, err = node.StateLookupID(ctx, addr, types.EmptyTSK)
+		return err
+	})
+	if err != nil {
+		return goaddress.Undef, fmt.Errorf("rpc: state lookup id %s: %w", addr, actorError(err))
+	}
+
+	return id, nil
+}
+
+// StateGetActor returns the actor behind addr at the chain head.
+func (c *RPCClient) StateGetActor(ctx context.Context, addr goaddress.Address) (*types.Actor, error) {
+	var actor *types.Actor
+	err := c.call(ctx, func(node api.FullNode) (err error) {
+		actor, err = node.StateGetActor(ctx, addr, types.EmptyTSK)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("rpc: state get actor %s: %w", addr, actorError(err))
+	}
+
+	return actor, nil
+}
+
+// StateAccountKey returns the public key address of the account actor behind
+// addr.
+func (c *RPCClient) StateAccountKey(ctx context.Context, addr goaddress.Address) (goaddress.Address, error) {
+	var key goaddress.Address
+	err := c.call(ctx, func(node api.FullNode) (err error) {
+		key, err = node.StateAccountKey(ctx, addr, types.EmptyTSK)
+		return err
+	})
+	if err != nil {
+		return goaddress.Undef, fmt.Errorf("rpc: state account key %s: %w", addr, actorError(err))
+	}
+
+	return key, nil
+}
+
+// actorError maps the node's actor not found error to ErrActorNotFound.
+func actorError(err error) error {
+	var notFound *api.ErrActorNotFound
+	if errors.As(err, &notFound) {
+		return ErrActorNotFound
 	}
 
-	c.closer()
-	c.closer = nil
+	return err
 }
@@ -0,0 +1,60 @@
+package filwallet
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	goaddress "github.com/filecoin-project/go-address"
+)
+
+// unreachableWS is refused at dial time, unlike http endpoints which are
+// only connected on the first call.
+const unreachableWS = "ws://127.0.0.1:1/rpc/v1"
+
+func TestNewRPCClientDialFailure(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c, err := NewRPCClient(ctx, []RPCEndpoint{{URL: unreachableWS}, {URL: "http://127.0.0.1:1/rpc/v1"}})
+	if err != nil {
+		t.Fatalf("new rpc client: %v", err)
+	}
+	defer c.Close()
+
+	health := c.Health()
+	if health[0].Healthy || health[0].RetryAt.IsZero() || health[0].LastError == "" {
+		t.Errorf("undialled endpoint: %+v, want unhealthy and backing off", health[0])
+	}
+	if !health[1].Healthy {
+		t.Errorf("dialled endpoint: %+v, want healthy", health[1])
+	}
+}
+
+func TestNewRPCClientNoEndpointDialled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, err := NewRPCClient(ctx, []RPCEndpoint{{URL: unreachableWS}, {URL: unreachableWS}})
+	if !errors.Is(err, ErrNoRPCEndpoint) {
+		t.Fatalf("new rpc client: error %v, want %v", err, ErrNoRPCEndpoint)
+	}
+}
+
+func TestRPCClientSkipsUndialledEndpoint(t *testing.T) {
+	node := newTestNode()
+	c := NewRPCClientFromNodes(nil, node)
+
+	addr, err := goaddress.NewIDAddress(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nonce, err := c.MpoolGetNonce(context.Background(), addr)
+	if err != nil {
+		t.Fatalf("mpool get nonce: %v", err)
+	}
+	if nonce != node.nonce {
+		t.Fatalf("nonce %d, want %d", nonce, node.nonce)
+	}
+}
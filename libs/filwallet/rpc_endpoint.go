@@ -0,0 +1,181 @@
+package filwallet
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/filecoin-project/go-jsonrpc"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/api/client"
+)
+
+const (
+	// healthCheckInterval matches the Filecoin block time.
+	healthCheckInterval = 30 * time.Second
+	healthCheckTimeout  = 10 * time.Second
+	// maxHeadLag is how many epochs an endpoint may trail the highest known
+	// head before it is considered out of sync.
+	maxHeadLag = 2
+	minBackoff = time.Second
+	maxBackoff = 2 * time.Minute
+)
+
+// EndpointHealth is a snapshot of the state of an RPC endpoint.
+type EndpointHealth struct {
+	URL        string
+	Healthy    bool
+	InSync     bool
+	HeadHeight int64
+	Latency    time.Duration
+	// ConsecutiveFailures counts failed calls and checks since the last success.
+	ConsecutiveFailures int
+	LastError           string
+	CheckedAt           time.Time
+	// RetryAt is when a failing endpoint is used again.
+	RetryAt time.Time
+}
+
+// rpcEndpoint is a connection to one Lotus node with its observed health.
+// node is nil until the endpoint has been dialled successfully.
+type rpcEndpoint struct {
+	url     string
+	headers http.Header
+
+	mu        sync.Mutex
+	node      api.FullNode
+	closer    jsonrpc.ClientCloser
+	height    int64
+	latency   time.Duration
+	checkedAt time.Time
+	failures  int
+	lastErr   error
+	retryAt   time.Time
+}
+
+// dial connects to the node unless already connected. The connection lives
+// until ctx is done. A failed dial backs the endpoint off like a failed call.
+func (e *rpcEndpoint) dial(ctx context.Context) (api.FullNode, error) {
+	if node := e.conn(); node != nil {
+		return node, nil
+	}
+
+	node, closer, err := client.NewFullNodeRPCV1(ctx, e.url, e.headers)
+	if err != nil {
+		err = fmt.Errorf("dial rpc %s: %w", e.url, err)
+		e.recordFailure(err)
+		return nil, err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	// Another check may have connected in the meantime.
+	if e.node != nil {
+		closer()
+		return e.node, nil
+	}
+	e.node, e.closer = node, closer
+
+	return node, nil
+}
+
+// conn returns the node, nil while the endpoint is not connected.
+func (e *rpcEndpoint) conn() api.FullNode {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.node
+}
+
+func (e *rpcEndpoint) close() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.closer != nil {
+		e.closer()
+		e.closer = nil
+	}
+}
+
+func (e *rpcEndpoint) recordHead(height int64, latency time.Duration) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.height = height
+	e.latency = latency
+	e.checkedAt = time.Now()
+	e.failures = 0
+	e.lastErr = nil
+	e.retryAt = time.Time{}
+}
+
+func (e *rpcEndpoint) recordSuccess() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.failures = 0
+	e.lastErr = nil
+	e.retryAt = time.Time{}
+}
+
+// recordFailure backs the endpoint off exponentially.
+func (e *rpcEndpoint) recordFailure(err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.failures++
+	e.lastErr = err
+	e.checkedAt = time.Now()
+
+	backoff := maxBackoff
+	if shift := e.failures - 1; shift < 8 {
+		backoff = min(minBackoff<<shift, maxBackoff)
+	}
+	e.retryAt = time.Now().Add(backoff)
+}
+
+// available reports whether the endpoint is outside of its backoff window.
+func (e *rpcEndpoint) available(now time.Time) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return !now.Before(e.retryAt)
+}
+
+func (e *rpcEndpoint) headHeight() int64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.height
+}
+
+func (e *rpcEndpoint) health(maxHeight int64, now time.Time) EndpointHealth {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	h := EndpointHealth{
+		URL:                 e.url,
+		Healthy:             e.failures == 0,
+		InSync:              inSync(e.height, maxHeight),
+		HeadHeight:          e.height,
+		Latency:             e.latency,
+		ConsecutiveFailures: e.failures,
+		CheckedAt:           e.checkedAt,
+	}
+	if e.lastErr != nil {
+		h.LastError = e.lastErr.Error()
+	}
+	if now.Before(e.retryAt) {
+		h.RetryAt = e.retryAt
+	}
+
+	return h
+}
+
+// inSync treats an endpoint whose head is not known yet as in sync.
+func inSync(height, maxHeight int64) bool {
+	return height == 0 || height >= maxHeight-maxHeadLag
+}
@@ -0,0 +1,103 @@
+package filwallet
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/codemaestro64/filament/libs/filwallet/address"
+	goaddress "github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/ipfs/go-cid"
+)
+
+type SendParams struct {
+	WalletID int
+	To       goaddress.Address
+	Value    types.BigInt
+	// MaxFee caps GasFeeCap * GasLimit of the message. A nil value means no cap.
+	MaxFee *types.BigInt
+}
+
+// SentMessage is a message that was accepted by the message pool.
+type SentMessage struct {
+	Cid     cid.Cid
+	Message *types.SignedMessage
+	// MaxFee is the most the message can cost in gas, GasFeeCap * GasLimit.
+	MaxFee types.BigInt
+}
+
+// Send transfers funds from the primary address of an unlocked wallet, its
+// f1 address or the f3 address of an imported BLS key.
+func (m *Manager) Send(ctx context.Context, p SendParams) (*SentMessage, error) {
+	if p.Value.Int == nil || p.Value.Sign() <= 0 {
+		return nil, ErrInvalidAmount
+	}
+
+	w, err := m.store.FindWallet(ctx, p.WalletID)
+	if err != nil {
+		return nil, fmt.Errorf("find wallet: %w", err)
+	}
+
+	from, err := firstOfType(w.Addresses(), address.TypeF1)
+	if err != nil {
+		from, err = firstOfType(w.Addresses(), address.TypeF3)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("source address: %w", err)
+	}
+
+	// Fail before touching the chain when the wallet cannot sign.
+	if _, err := m.sessionKey(p.WalletID, 0); err != nil {
+		return nil, err
+	}
+
+	nonce, err := m.rpcClient.MpoolGetNonce(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := m.rpcClient.GasEstimateMessageGas(ctx, &types.Message{
+		From:  from,
+		To:    p.To,
+		Nonce: nonce,
+		Value: p.Value,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	maxFee := types.BigMul(msg.GasFeeCap, types.NewInt(uint64(msg.GasLimit)))
+	if p.MaxFee != nil && maxFee.GreaterThan(*p.MaxFee) {
+		return nil, fmt.Errorf("%w: estimated %s, max %s", ErrFeeExceedsMax, maxFee, p.MaxFee)
+	}
+
+	balance, err := m.rpcClient.WalletBalance(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+
+	if balance.LessThan(types.BigAdd(msg.Value, maxFee)) {
+		return nil, ErrInsufficientFunds
+	}
+
+	sig, err := m.SignMessage(ctx, p.WalletID, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	smsg := &types.SignedMessage{
+		Message:   *msg,
+		Signature: *sig,
+	}
+
+	msgCid, err := m.rpcClient.MpoolPush(ctx, smsg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SentMessage{
+		Cid:     msgCid,
+		Message: smsg,
+		MaxFee:  maxFee,
+	}, nil
+}
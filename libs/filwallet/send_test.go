@@ -0,0 +1,453 @@
+package filwallet
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/codemaestro64/filament/apps/api/pkg/util"
+	"github.com/codemaestro64/filament/libs/filwallet/address"
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
+	goaddress "github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/filecoin-project/lotus/lib/sigs"
+	_ "github.com/filecoin-project/lotus/lib/sigs/secp"
+	"github.com/ipfs/go-cid"
+)
+
+const (
+	testMnemonic = "equip will roof matter pink blind book anxiety banner elbow sun young"
+	testPassword = "correct horse"
+)
+
+// testKDF keeps password hashing cheap in tests.
+var testKDF = wallet.KDFParams{Algorithm: wallet.KDFArgon2id, Time: 1, Memory: 8 * 1024, Threads: 1}
+
+// fakeNode answers the Lotus calls of Send, the migration sweeps and address
+// conversion. Calls it does not implement panic through the nil embedded
+// interface.
+type fakeNode struct {
+	api.FullNode
+
+	nonce      uint64
+	balance    types.BigInt
+	gasLimit   int64
+	gasFeeCap  types.BigInt
+	gasPremium types.BigInt
+	pushErr    error
+	// balances, when set, replace balance per address; missing addresses
+	// are empty.
+	balances map[goaddress.Address]types.BigInt
+	// ids maps robust and delegated addresses to their ID address, actors
+	// and accountKeys are keyed by ID address. Missing actors are not found.
+	ids         map[goaddress.Address]goaddress.Address
+	actors      map[goaddress.Address]*types.Actor
+	accountKeys map[goaddress.Address]goaddress.Address
+
+	pushed []*types.SignedMessage
+}
+
+func (n *fakeNode) MpoolGetNonce(_ context.Context, _ goaddress.Address) (uint64, error) {
+	return n.nonce, nil
+}
+
+func (n *fakeNode) GasEstimateMessageGas(_ context.Context, msg *types.Message, _ *api.MessageSendSpec, _ types.TipSetKey) (*types.Message, error) {
+	estimated := *msg
+	estimated.GasLimit = n.gasLimit
+	estimated.GasFeeCap = n.gasFeeCap
+	estimated.GasPremium = n.gasPremium
+
+	return &estimated, nil
+}
+
+func (n *fakeNode) WalletBalance(_ context.Context, addr goaddress.Address) (types.BigInt, error) {
+	if n.balances != nil {
+		if balance, ok := n.balances[addr]; ok {
+			return balance, nil
+		}
+		return types.NewInt(0), nil
+	}
+
+	return n.balance, nil
+}
+
+func (n *fakeNode) MpoolPush(_ context.Context, smsg *types.SignedMessage) (cid.Cid, error) {
+	if n.pushErr != nil {
+		return cid.Undef, n.pushErr
+	}
+
+	n.pushed = append(n.pushed, smsg)
+	return smsg.Cid(), nil
+}
+
+func (n *fakeNode) StateLookupID(_ context.Context, addr goaddress.Address, _ types.TipSetKey) (goaddress.Address, error) {
+	if addr.Protocol() == goaddress.ID {
+		return addr, nil
+	}
+
+	id, ok := n.ids[addr]
+	if !ok {
+		return goaddress.Undef, &api.ErrActorNotFound{}
+	}
+
+	return id, nil
+}
+
+func (n *fakeNode) StateGetActor(_ context.Context, addr goaddress.Address, _ types.TipSetKey) (*types.Actor, error) {
+	actor, ok := n.actors[addr]
+	if !ok {
+		return nil, &api.ErrActorNotFound{}
+	}
+
+	return actor, nil
+}
+
+func (n *fakeNode) StateAccountKey(_ context.Context, addr goaddress.Address, _ types.TipSetKey) (goaddress.Address, error) {
+	key, ok := n.accountKeys[addr]
+	if !ok {
+		return goaddress.Undef, &api.ErrActorNotFound{}
+	}
+
+	return key, nil
+}
+
+// memStore keeps wallets in memory. Store methods the tests do not need panic
+// through the nil embedded interface.
+type memStore struct {
+	Store
+
+	mu      sync.Mutex
+	wallets map[int]*wallet.Wallet
+	events  []AuditEvent
+	// beforeUpdate, when set, runs before the secrets of wallets are
+	// replaced, to interleave other operations.
+	beforeUpdate func()
+}
+
+// newMemStore returns a store holding copies of wallets, so that tests can
+// share them.
+func newMemStore(wallets ...*wallet.Wallet) *memStore {
+	s := &memStore{wallets: make(map[int]*wallet.Wallet)}
+	for _, w := range wallets {
+		copied := *w
+		s.wallets[w.ID] = &copied
+	}
+
+	return s
+}
+
+func (s *memStore) GetWallets(_ context.Context) ([]*wallet.Wallet, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	wallets := make([]*wallet.Wallet, 0, len(s.wallets))
+	for _, w := range s.wallets {
+		copied := *w
+		wallets = append(wallets, &copied)
+	}
+
+	return wallets, nil
+}
+
+func (s *memStore) FindWallet(_ context.Context, walletID int) (*wallet.Wallet, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w, ok := s.wallets[walletID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	copied := *w
+	return &copied, nil
+}
+
+func (s *memStore) SaveWallet(_ context.Context, p SaveWalletParams) (*wallet.Wallet, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w := &wallet.Wallet{
+		ID:                  len(s.wallets) + 1,
+		Name:                p.Name,
+		DerivationScheme:    p.DerivationScheme,
+		Accounts:            p.Accounts,
+		Salt:                p.Salt,
+		KDF:                 p.KDF,
+		EncryptedKeyJSON:    p.KeyJSON,
+		EncryptedMnemonic:   p.EncryptedSeed,
+		EncryptedPassphrase: p.EncryptedPassphrase,
+		BackupVerifiedAt:    p.BackupVerifiedAt,
+	}
+	s.wallets[w.ID] = w
+
+	copied := *w
+	return &copied, nil
+}
+
+func (s *memStore) UpdateWalletSecrets(_ context.Context, updates []UpdateSecretsParams) error {
+	if s.beforeUpdate != nil {
+		s.beforeUpdate()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, u := range updates {
+		w, ok := s.wallets[u.WalletID]
+		if !ok || !bytes.Equal(w.Salt, u.OldSalt) {
+			return ErrWalletChanged
+		}
+	}
+
+	for _, u := range updates {
+		w := s.wallets[u.WalletID]
+		w.Salt = u.Secrets.Salt
+		w.KDF = u.Secrets.KDF
+		w.EncryptedKeyJSON = u.Secrets.EncryptedKeyJSON
+		if len(u.Secrets.EncryptedMnemonic) > 0 {
+			w.EncryptedMnemonic = u.Secrets.EncryptedMnemonic
+		}
+		if len(u.Secrets.EncryptedPassphrase) > 0 {
+			w.EncryptedPassphrase = u.Secrets.EncryptedPassphrase
+		}
+	}
+
+	return nil
+}
+
+func (s *memStore) UpdateWalletDerivation(_ context.Context, walletID int, p UpdateDerivationParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w, ok := s.wallets[walletID]
+	if !ok {
+		return ErrNotFound
+	}
+	w.DerivationScheme = p.Scheme
+	w.EncryptedKeyJSON = p.KeyJSON
+	w.Accounts = p.Accounts
+
+	return nil
+}
+
+func (s *memStore) RecordAuditEvent(_ context.Context, event AuditEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.events = append(s.events, event)
+	return nil
+}
+
+func (s *memStore) UpdateFailedAttempts(_ context.Context, walletID int, attempts int, retryAfter time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w, ok := s.wallets[walletID]
+	if !ok {
+		return ErrNotFound
+	}
+	w.FailedAttempts = attempts
+	w.RetryAfter = retryAfter
+
+	return nil
+}
+
+var testWallet = sync.OnceValues(func() (*wallet.Wallet, error) {
+	w, err := wallet.CreateNew(testMnemonic, "", "test", testPassword, util.CalibrationNet, testKDF)
+	if err != nil {
+		return nil, err
+	}
+	w.ID = 1
+
+	return w, nil
+})
+
+// newTestManager returns a Manager on the calibration network backed by store
+// and node.
+func newTestManager(t *testing.T, store Store, node *fakeNode) *Manager {
+	t.Helper()
+
+	cfg := &Config{
+		Network:        util.CalibrationNet,
+		DataDir:        t.TempDir(),
+		RPCEndpoints:   []RPCEndpoint{{URL: "fake"}},
+		SessionTimeout: 30,
+		KDF:            testKDF,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	m, err := NewManagerWithClient(ctx, store, cfg, NewRPCClientFromNodes(node))
+	if err != nil {
+		t.Fatalf("new manager: %v", err)
+	}
+
+	return m
+}
+
+// newSendManager returns a manager with the test wallet unlocked, talking to
+// node.
+func newSendManager(t *testing.T, node *fakeNode) (*Manager, *wallet.Wallet) {
+	t.Helper()
+
+	w, err := testWallet()
+	if err != nil {
+		t.Fatalf("create wallet: %v", err)
+	}
+
+	m := newTestManager(t, newMemStore(w), node)
+	if err := m.UnlockWallet(context.Background(), w.ID, testPassword); err != nil {
+		t.Fatalf("unlock wallet: %v", err)
+	}
+
+	return m, w
+}
+
+func newTestNode() *fakeNode {
+	return &fakeNode{
+		nonce:      7,
+		balance:    types.NewInt(1_000_000),
+		gasLimit:   1_000,
+		gasFeeCap:  types.NewInt(100),
+		gasPremium: types.NewInt(10),
+	}
+}
+
+func testDestination(t *testing.T) goaddress.Address {
+	t.Helper()
+
+	to, err := goaddress.NewIDAddress(1234)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return to
+}
+
+func TestSend(t *testing.T) {
+	node := newTestNode()
+	m, w := newSendManager(t, node)
+	to := testDestination(t)
+
+	sent, err := m.Send(context.Background(), SendParams{
+		WalletID: w.ID,
+		To:       to,
+		Value:    types.NewInt(500),
+	})
+	if err != nil {
+		t.Fatalf("send: %v", err)
+	}
+
+	if len(node.pushed) != 1 {
+		t.Fatalf("pushed %d messages, want 1", len(node.pushed))
+	}
+	smsg := node.pushed[0]
+	msg := smsg.Message
+
+	from, err := firstOfType(w.Addresses(), address.TypeF1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if msg.From != from || msg.To != to {
+		t.Errorf("message from %s to %s, want from %s to %s", msg.From, msg.To, from, to)
+	}
+	if msg.Nonce != node.nonce {
+		t.Errorf("nonce %d, want %d", msg.Nonce, node.nonce)
+	}
+	if !msg.Value.Equals(types.NewInt(500)) {
+		t.Errorf("value %s, want 500", msg.Value)
+	}
+	if msg.GasLimit != node.gasLimit || !msg.GasFeeCap.Equals(node.gasFeeCap) || !msg.GasPremium.Equals(node.gasPremium) {
+		t.Errorf("gas %d/%s/%s, want the estimate %d/%s/%s",
+			msg.GasLimit, msg.GasFeeCap, msg.GasPremium, node.gasLimit, node.gasFeeCap, node.gasPremium)
+	}
+
+	// The node accepts the signature only if it recovers to the f1 sender.
+	if err := sigs.Verify(&smsg.Signature, msg.From, msg.Cid().Bytes()); err != nil {
+		t.Errorf("verify signature: %v", err)
+	}
+
+	if sent.Cid != smsg.Cid() {
+		t.Errorf("cid %s, want %s", sent.Cid, smsg.Cid())
+	}
+	if !sent.MaxFee.Equals(types.NewInt(100_000)) {
+		t.Errorf("max fee %s, want 100000", sent.MaxFee)
+	}
+}
+
+func TestSendRejected(t *testing.T) {
+	lowFee := types.NewInt(99_999)
+	pushErr := errors.New("mpool full")
+
+	tests := []struct {
+		name    string
+		params  SendParams
+		node    func(n *fakeNode)
+		wantErr error
+	}{
+		{
+			name:    "zero amount",
+			params:  SendParams{Value: types.NewInt(0)},
+			wantErr: ErrInvalidAmount,
+		},
+		{
+			name:    "fee above max",
+			params:  SendParams{Value: types.NewInt(500), MaxFee: &lowFee},
+			wantErr: ErrFeeExceedsMax,
+		},
+		{
+			name:    "insufficient balance",
+			params:  SendParams{Value: types.NewInt(900_001)},
+			wantErr: ErrInsufficientFunds,
+		},
+		{
+			name:    "push failure",
+			params:  SendParams{Value: types.NewInt(500)},
+			node:    func(n *fakeNode) { n.pushErr = pushErr },
+			wantErr: pushErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := newTestNode()
+			if tt.node != nil {
+				tt.node(node)
+			}
+			m, w := newSendManager(t, node)
+
+			p := tt.params
+			p.WalletID = w.ID
+			p.To = testDestination(t)
+
+			_, err := m.Send(context.Background(), p)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("send: error %v, want %v", err, tt.wantErr)
+			}
+			if len(node.pushed) != 0 {
+				t.Fatalf("pushed %d messages, want none", len(node.pushed))
+			}
+		})
+	}
+}
+
+func TestSendLockedWallet(t *testing.T) {
+	node := newTestNode()
+	m, w := newSendManager(t, node)
+	m.LockWallet(w.ID)
+
+	_, err := m.Send(context.Background(), SendParams{
+		WalletID: w.ID,
+		To:       testDestination(t),
+		Value:    types.NewInt(500),
+	})
+	if !errors.Is(err, ErrSessionExpired) {
+		t.Fatalf("send: error %v, want %v", err, ErrSessionExpired)
+	}
+}
@@ -0,0 +1,181 @@
+package filwallet
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/awnumar/memguard"
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
+)
+
+// Defaults for a zero Config.SessionTimeout and Config.SessionMaxLifetime.
+const (
+	defaultSessionTimeout     = 30 * time.Minute
+	defaultSessionMaxLifetime = 8 * time.Hour
+)
+
+type sessionState struct {
+	vault map[int]*walletSession
+}
+
+// walletSession holds the keys of an unlocked wallet. Every use of a key moves
+// expiresAt forward by the idle timeout, but never past the maximum lifetime
+// counted from unlockedAt.
+type walletSession struct {
+	keyring     wallet.Keyring
+	unlockedAt  time.Time
+	expiresAt   time.Time
+	maxLifetime time.Duration
+}
+
+// SessionStatus describes an unlocked wallet.
+type SessionStatus struct {
+	WalletID   int
+	UnlockedAt time.Time
+	// ExpiresAt is when the wallet locks unless it is used before.
+	ExpiresAt time.Time
+	// Deadline is when the wallet locks regardless of use.
+	Deadline time.Time
+}
+
+func (m *Manager) sessionTimeout() time.Duration {
+	if m.cfg.SessionTimeout <= 0 {
+		return defaultSessionTimeout
+	}
+
+	return time.Duration(m.cfg.SessionTimeout) * time.Minute
+}
+
+func (m *Manager) sessionMaxLifetime() time.Duration {
+	if m.cfg.SessionMaxLifetime <= 0 {
+		return defaultSessionMaxLifetime
+	}
+
+	return time.Duration(m.cfg.SessionMaxLifetime) * time.Minute
+}
+
+func (m *Manager) newSession(keyring wallet.Keyring) *walletSession {
+	now := time.Now()
+	ws := &walletSession{
+		keyring:     keyring,
+		unlockedAt:  now,
+		maxLifetime: m.sessionMaxLifetime(),
+	}
+	ws.renew(now, m.sessionTimeout())
+
+	return ws
+}
+
+func (ws *walletSession) deadline() time.Time {
+	return ws.unlockedAt.Add(ws.maxLifetime)
+}
+
+func (ws *walletSession) renew(now time.Time, timeout time.Duration) {
+	ws.expiresAt = now.Add(timeout)
+	if deadline := ws.deadline(); ws.expiresAt.After(deadline) {
+		ws.expiresAt = deadline
+	}
+}
+
+func (ws *walletSession) expired(now time.Time) bool {
+	return !now.Before(ws.expiresAt)
+}
+
+// sessionKey returns the sealed key of an account of an unlocked wallet and
+// renews the wallet's session.
+func (m *Manager) sessionKey(walletID int, index uint32) (*memguard.Enclave, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ws, ok := m.session.vault[walletID]
+	if !ok {
+		return nil, ErrSessionExpired
+	}
+
+	now := time.Now()
+	if ws.expired(now) {
+		delete(m.session.vault, walletID)
+		return nil, ErrSessionExpired
+	}
+
+	enclave, ok := ws.keyring[index]
+	if !ok {
+		return nil, ErrSessionExpired
+	}
+	ws.renew(now, m.sessionTimeout())
+
+	return enclave, nil
+}
+
+// LockWallet drops the keys of a wallet from the session.
+func (m *Manager) LockWallet(walletID int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.session.vault, walletID)
+}
+
+// LockAll drops the keys of every wallet from the session.
+func (m *Manager) LockAll() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.session.vault = make(map[int]*walletSession)
+}
+
+// SessionStatus lists the unlocked wallets ordered by ID.
+func (m *Manager) SessionStatus() []SessionStatus {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	now := time.Now()
+	statuses := make([]SessionStatus, 0, len(m.session.vault))
+	for id, ws := range m.session.vault {
+		if ws.expired(now) {
+			continue
+		}
+
+		statuses = append(statuses, SessionStatus{
+			WalletID:   id,
+			UnlockedAt: ws.unlockedAt,
+			ExpiresAt:  ws.expiresAt,
+			Deadline:   ws.deadline(),
+		})
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].WalletID < statuses[j].WalletID
+	})
+
+	return statuses
+}
+
+// lockExpired drops the keys of wallets whose session has expired.
+func (m *Manager) lockExpired() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	for id, ws := range m.session.vault {
+		if ws.expired(now) {
+			delete(m.session.vault, id)
+		}
+	}
+}
+
+func (m *Manager) startSessionJanitor(ctx context.Context) {
+	ticker := time.NewTicker(1 * time.Minute)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				m.LockAll()
+				return
+			case <-ticker.C:
+				m.lockExpired()
+			}
+		}
+	}()
+}
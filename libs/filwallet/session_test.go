@@ -0,0 +1,76 @@
+package filwallet
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWalletSessionRenewStopsAtMaxLifetime(t *testing.T) {
+	const timeout = 30 * time.Minute
+	unlockedAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	ws := &walletSession{unlockedAt: unlockedAt, maxLifetime: time.Hour}
+
+	tests := []struct {
+		name    string
+		usedAt  time.Duration
+		expires time.Duration
+	}{
+		{"unlock", 0, 30 * time.Minute},
+		{"use slides the expiry", 20 * time.Minute, 50 * time.Minute},
+		{"use near the deadline", 45 * time.Minute, time.Hour},
+		{"use at the last moment", 59 * time.Minute, time.Hour},
+	}
+
+	for _, tt := range tests {
+		ws.renew(unlockedAt.Add(tt.usedAt), timeout)
+		if want := unlockedAt.Add(tt.expires); !ws.expiresAt.Equal(want) {
+			t.Errorf("%s: expires at %s, want %s", tt.name, ws.expiresAt, want)
+		}
+	}
+
+	if ws.expired(unlockedAt.Add(time.Hour - time.Second)) {
+		t.Error("session expired before its deadline")
+	}
+	if !ws.expired(unlockedAt.Add(time.Hour)) {
+		t.Error("session outlived its max lifetime")
+	}
+}
+
+func TestSessionKeyExpiresAtMaxLifetime(t *testing.T) {
+	m, w := newSendManager(t, newTestNode())
+	m.cfg.SessionMaxLifetime = 60
+
+	// Pretend the wallet was unlocked 50 minutes ago: use moves the expiry
+	// to the deadline, not 30 minutes ahead.
+	m.mu.Lock()
+	ws := m.session.vault[w.ID]
+	ws.unlockedAt = time.Now().Add(-50 * time.Minute)
+	ws.maxLifetime = m.sessionMaxLifetime()
+	m.mu.Unlock()
+
+	if _, err := m.sessionKey(w.ID, 0); err != nil {
+		t.Fatalf("session key: %v", err)
+	}
+
+	status := m.SessionStatus()
+	if len(status) != 1 || !status[0].ExpiresAt.Equal(status[0].Deadline) {
+		t.Fatalf("session status %+v, want expiry at the deadline", status)
+	}
+	if want := ws.unlockedAt.Add(time.Hour); !status[0].Deadline.Equal(want) {
+		t.Errorf("deadline %s, want %s", status[0].Deadline, want)
+	}
+
+	// Past the deadline the keys are gone, however recently they were used.
+	m.mu.Lock()
+	ws.unlockedAt = time.Now().Add(-time.Hour)
+	ws.expiresAt = ws.deadline()
+	m.mu.Unlock()
+
+	if _, err := m.sessionKey(w.ID, 0); !errors.Is(err, ErrSessionExpired) {
+		t.Fatalf("session key after the deadline: error = %v, want %v", err, ErrSessionExpired)
+	}
+	if status := m.SessionStatus(); len(status) != 0 {
+		t.Errorf("session status %+v, want no unlocked wallet", status)
+	}
+}
@@ -0,0 +1,127 @@
+package filwallet
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/codemaestro64/filament/libs/filwallet/slip39"
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
+)
+
+// CreateShareBackupParams are the inputs of CreateShareBackup.
+type CreateShareBackupParams struct {
+	WalletID int
+	Password string
+	// Threshold shares out of Count recover the wallet.
+	Threshold int
+	Count     int
+}
+
+// ShareBackup is a SLIP-39 backup of a wallet seed. The shares hold the
+// entropy of the mnemonic, so recovering the same wallet also takes the
+// wordlist Language and the BIP39 passphrase, which is not part of the
+// shares.
+type ShareBackup struct {
+	Shares   []string
+	Language Language
+	// HasPassphrase reports whether the seed also needs a BIP39 passphrase,
+	// which is not revealed.
+	HasPassphrase bool
+}
+
+// CreateShareBackup splits the seed of an HD wallet into SLIP-39 shares. The
+// attempt is written to the audit trail and the shares are withheld when it
+// cannot be recorded.
+func (m *Manager) CreateShareBackup(ctx context.Context, p CreateShareBackupParams) (*ShareBackup, error) {
+	backup, err := m.createShareBackup(ctx, p)
+
+	event := AuditEvent{
+		WalletID: p.WalletID,
+		Action:   AuditShareBackup,
+		Detail:   fmt.Sprintf("%d of %d shares", p.Threshold, p.Count),
+		Success:  err == nil,
+	}
+	if err != nil {
+		event.Detail = err.Error()
+	}
+
+	if auditErr := m.recordAudit(ctx, event); auditErr != nil && err == nil {
+		return nil, auditErr
+	}
+
+	return backup, err
+}
+
+func (m *Manager) createShareBackup(ctx context.Context, p CreateShareBackupParams) (*ShareBackup, error) {
+	if p.Password == "" {
+		return nil, ErrInvalidPassword
+	}
+
+	mnemonic, passphrase, err := m.DecryptSeedPhrase(ctx, p.WalletID, p.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	lang, err := DetectLanguage(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+
+	entropy, ok := mnemonicToEntropy(strings.Fields(NormalizeMnemonic(mnemonic)), lang)
+	if !ok {
+		return nil, ErrInvalidSeedPhrase
+	}
+
+	shares, err := slip39.Split(entropy, "", p.Threshold, p.Count)
+	if err != nil {
+		return nil, fmt.Errorf("split seed: %w", err)
+	}
+
+	return &ShareBackup{Shares: shares, Language: lang, HasPassphrase: passphrase != ""}, nil
+}
+
+// RecoverFromSharesParams are the inputs of RecoverWalletFromShares.
+type RecoverFromSharesParams struct {
+	Shares []string
+	// Passphrase is the optional BIP39 passphrase the seed was created with.
+	Passphrase string
+	// Language is the wordlist the seed was created with, English when
+	// empty.
+	Language Language
+	Name     string
+	Password string
+}
+
+// RecoverWalletFromShares restores a wallet from enough SLIP-39 shares of
+// its seed.
+func (m *Manager) RecoverWalletFromShares(ctx context.Context, p RecoverFromSharesParams) (*wallet.Wallet, error) {
+	if p.Language == "" {
+		p.Language = LanguageEnglish
+	}
+
+	if !p.Language.Valid() {
+		return nil, ErrUnsupportedLanguage
+	}
+
+	if p.Password == "" {
+		return nil, ErrInvalidPassword
+	}
+
+	if p.Name == "" {
+		return nil, ErrInvalidWalletName
+	}
+
+	entropy, err := slip39.Combine(p.Shares, "")
+	if err != nil {
+		return nil, fmt.Errorf("combine shares: %w", err)
+	}
+
+	if len(entropy) < 16 || len(entropy) > 32 || len(entropy)%4 != 0 {
+		return nil, fmt.Errorf("%w: shares hold %d bytes of entropy", ErrInvalidSeedPhrase, len(entropy))
+	}
+
+	mnemonic := NormalizeMnemonic(entropyToMnemonic(entropy, p.Language))
+
+	return m.importWallet(ctx, mnemonic, NormalizePassphrase(p.Passphrase), p.Name, p.Password, true)
+}
@@ -0,0 +1,116 @@
+package filwallet
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/codemaestro64/filament/libs/filwallet/slip39"
+)
+
+func TestShareBackupRoundTrip(t *testing.T) {
+	tests := []struct {
+		name       string
+		wordCount  int
+		language   Language
+		passphrase string
+	}{
+		{"english 12 words", 12, LanguageEnglish, ""},
+		{"japanese 24 words", 24, LanguageJapanese, "ガバヴァ"},
+	}
+
+	for _, tt := range tests {
+		store := newMemStore()
+		m := newTestManager(t, store, newTestNode())
+		ctx := context.Background()
+
+		created, mnemonic, err := m.CreateWallet(ctx, CreateWalletParams{
+			Name:       "original",
+			Password:   testPassword,
+			Passphrase: tt.passphrase,
+			WordCount:  tt.wordCount,
+			Language:   tt.language,
+		})
+		if err != nil {
+			t.Fatalf("%s: create wallet: %v", tt.name, err)
+		}
+
+		backup, err := m.CreateShareBackup(ctx, CreateShareBackupParams{
+			WalletID:  created.ID,
+			Password:  testPassword,
+			Threshold: 2,
+			Count:     3,
+		})
+		if err != nil {
+			t.Fatalf("%s: create share backup: %v", tt.name, err)
+		}
+
+		if len(backup.Shares) != 3 || backup.Language != tt.language {
+			t.Fatalf("%s: got %d shares in %s, want 3 in %s", tt.name, len(backup.Shares), backup.Language, tt.language)
+		}
+		if want := tt.passphrase != ""; backup.HasPassphrase != want {
+			t.Errorf("%s: has passphrase %v, want %v", tt.name, backup.HasPassphrase, want)
+		}
+
+		if n := len(store.events); n != 1 || store.events[0].Action != AuditShareBackup || !store.events[0].Success {
+			t.Errorf("%s: audit events %+v, want one successful share backup", tt.name, store.events)
+		}
+
+		recovered, err := m.RecoverWalletFromShares(ctx, RecoverFromSharesParams{
+			Shares:     []string{backup.Shares[2], backup.Shares[0]},
+			Passphrase: tt.passphrase,
+			Language:   backup.Language,
+			Name:       "recovered",
+			Password:   testPassword,
+		})
+		if err != nil {
+			t.Fatalf("%s: recover wallet: %v", tt.name, err)
+		}
+
+		if !slices.Equal(recovered.Accounts[0].Addresses, created.Accounts[0].Addresses) {
+			t.Errorf("%s: recovered addresses %v, want %v", tt.name, recovered.Accounts[0].Addresses, created.Accounts[0].Addresses)
+		}
+
+		recoveredMnemonic, _, err := m.DecryptSeedPhrase(ctx, recovered.ID, testPassword)
+		if err != nil {
+			t.Fatalf("%s: decrypt seed phrase: %v", tt.name, err)
+		}
+		if recoveredMnemonic != NormalizeMnemonic(mnemonic) {
+			t.Errorf("%s: recovered mnemonic %q, want %q", tt.name, recoveredMnemonic, NormalizeMnemonic(mnemonic))
+		}
+
+		if recovered.BackupVerifiedAt.IsZero() {
+			t.Errorf("%s: recovered wallet is not marked as backed up", tt.name)
+		}
+	}
+}
+
+func TestRecoverWalletFromSharesRejectsTooFewShares(t *testing.T) {
+	m := newTestManager(t, newMemStore(), newTestNode())
+	ctx := context.Background()
+
+	created, _, err := m.CreateWallet(ctx, CreateWalletParams{Name: "original", Password: testPassword})
+	if err != nil {
+		t.Fatalf("create wallet: %v", err)
+	}
+
+	backup, err := m.CreateShareBackup(ctx, CreateShareBackupParams{
+		WalletID:  created.ID,
+		Password:  testPassword,
+		Threshold: 2,
+		Count:     3,
+	})
+	if err != nil {
+		t.Fatalf("create share backup: %v", err)
+	}
+
+	_, err = m.RecoverWalletFromShares(ctx, RecoverFromSharesParams{
+		Shares:   backup.Shares[:1],
+		Name:     "recovered",
+		Password: testPassword,
+	})
+	if !errors.Is(err, slip39.ErrInsufficientShares) {
+		t.Fatalf("recover from one share: %v, want %v", err, slip39.ErrInsufficientShares)
+	}
+}
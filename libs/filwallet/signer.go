@@ -0,0 +1,128 @@
+package filwallet
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/awnumar/memguard"
+	"github.com/codemaestro64/filament/libs/filwallet/address"
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	goaddress "github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/crypto"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/filecoin-project/lotus/chain/types/ethtypes"
+	"golang.org/x/crypto/blake2b"
+)
+
+// SignMessage signs msg with the key of the account that owns msg.From. The
+// wallet must be unlocked; the key is decrypted only for the signature.
+func (m *Manager) SignMessage(ctx context.Context, walletID int, msg *types.Message) (*crypto.Signature, error) {
+	w, err := m.store.FindWallet(ctx, walletID)
+	if err != nil {
+		return nil, fmt.Errorf("find wallet: %w", err)
+	}
+
+	acc, err := accountOf(w, msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	enclave, err := m.sessionKey(walletID, acc.Index)
+	if err != nil {
+		return nil, err
+	}
+
+	if acc.IsBLS() {
+		return signBLSMessage(enclave, msg)
+	}
+
+	return signSecp256k1Message(enclave, msg)
+}
+
+// signSecp256k1Message signs the CID of msg with the secp256k1 key in enclave.
+func signSecp256k1Message(enclave *memguard.Enclave, msg *types.Message) (*crypto.Signature, error) {
+	block, err := msg.ToStorageBlock()
+	if err != nil {
+		return nil, fmt.Errorf("serialize message: %w", err)
+	}
+	digest := blake2b.Sum256(block.Cid().Bytes())
+
+	sig, err := wallet.SignSecp256k1(enclave, digest[:])
+	if err != nil {
+		return nil, fmt.Errorf("sign message: %w", err)
+	}
+
+	return &crypto.Signature{
+		Type: crypto.SigTypeSecp256k1,
+		Data: sig,
+	}, nil
+}
+
+// signDelegatedMessage signs msg from an f4 address with the secp256k1 key in
+// enclave. Like Lotus, it signs the Keccak-256 hash of the EIP-1559
+// transaction the message corresponds to.
+func signDelegatedMessage(enclave *memguard.Enclave, msg *types.Message) (*crypto.Signature, error) {
+	tx, err := ethtypes.Eth1559TxArgsFromUnsignedFilecoinMessage(msg)
+	if err != nil {
+		return nil, fmt.Errorf("convert message to eth transaction: %w", err)
+	}
+
+	rlp, err := tx.ToRlpUnsignedMsg()
+	if err != nil {
+		return nil, fmt.Errorf("serialize eth transaction: %w", err)
+	}
+
+	sig, err := wallet.SignSecp256k1(enclave, ethcrypto.Keccak256(rlp))
+	if err != nil {
+		return nil, fmt.Errorf("sign message: %w", err)
+	}
+
+	return &crypto.Signature{
+		Type: crypto.SigTypeDelegated,
+		Data: sig,
+	}, nil
+}
+
+// signBLSMessage signs the CID of msg with the BLS key in enclave. Unlike
+// secp256k1, BLS signs the CID bytes without hashing them first.
+func signBLSMessage(enclave *memguard.Enclave, msg *types.Message) (*crypto.Signature, error) {
+	block, err := msg.ToStorageBlock()
+	if err != nil {
+		return nil, fmt.Errorf("serialize message: %w", err)
+	}
+
+	buf, err := enclave.Open()
+	if err != nil {
+		return nil, fmt.Errorf("open enclave: %w", err)
+	}
+	defer buf.Destroy()
+
+	sig, err := wallet.SignBLS(buf.Bytes(), block.Cid().Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("sign message: %w", err)
+	}
+
+	return &crypto.Signature{
+		Type: crypto.SigTypeBLS,
+		Data: sig,
+	}, nil
+}
+
+// accountOf returns the account of w whose f1 or f3 address is addr.
+func accountOf(w *wallet.Wallet, addr goaddress.Address) (*wallet.Account, error) {
+	for i, acc := range w.Accounts {
+		for _, accAddr := range acc.Addresses {
+			if accAddr.Type != address.TypeF1 && accAddr.Type != address.TypeF3 {
+				continue
+			}
+
+			parsed, err := goaddress.NewFromString(accAddr.Value)
+			if err == nil && parsed == addr {
+				return &w.Accounts[i], nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrAddressNotInWallet, addr)
+}
@@ -0,0 +1,66 @@
+package slip39
+
+const checksumWords = 3
+
+// Customization strings of the checksum, and of the encryption salt of
+// non-extendable shares.
+const (
+	customizationOrig       = "shamir"
+	customizationExtendable = "shamir_extendable"
+)
+
+var rs1024Gen = [10]uint32{
+	0xe0e040, 0x1c1c080, 0x3838100, 0x7070200, 0xe0e0009,
+	0x1c0c2412, 0x38086c24, 0x3090fc48, 0x21b1f890, 0x3f3f120,
+}
+
+// rs1024Polymod computes the Reed-Solomon code over GF(1024) that SLIP-39
+// uses as checksum.
+func rs1024Polymod(values []int) uint32 {
+	chk := uint32(1)
+	for _, v := range values {
+		b := chk >> 20
+		chk = (chk&0xfffff)<<10 ^ uint32(v)
+		for i, gen := range rs1024Gen {
+			if (b>>i)&1 != 0 {
+				chk ^= gen
+			}
+		}
+	}
+
+	return chk
+}
+
+func customization(extendable bool) string {
+	if extendable {
+		return customizationExtendable
+	}
+
+	return customizationOrig
+}
+
+func withCustomization(data []int, extendable bool) []int {
+	custom := customization(extendable)
+	values := make([]int, 0, len(custom)+len(data)+checksumWords)
+	for i := 0; i < len(custom); i++ {
+		values = append(values, int(custom[i]))
+	}
+
+	return append(values, data...)
+}
+
+func createChecksum(data []int, extendable bool) []int {
+	values := append(withCustomization(data, extendable), make([]int, checksumWords)...)
+	polymod := rs1024Polymod(values) ^ 1
+
+	checksum := make([]int, checksumWords)
+	for i := range checksum {
+		checksum[i] = int(polymod>>(10*(checksumWords-1-i))) & 1023
+	}
+
+	return checksum
+}
+
+func verifyChecksum(data []int, extendable bool) bool {
+	return rs1024Polymod(withCustomization(data, extendable)) == 1
+}
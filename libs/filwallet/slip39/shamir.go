@@ -0,0 +1,170 @@
+package slip39
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+)
+
+// Reserved x coordinates of the shared secret and its digest.
+const (
+	secretIndex = 255
+	digestIndex = 254
+)
+
+const digestLength = 4
+
+// expTable and logTable implement multiplication in GF(256) with the
+// Rijndael polynomial x^8 + x^4 + x^3 + x + 1.
+var expTable, logTable = func() ([255]byte, [256]byte) {
+	var exp [255]byte
+	var log [256]byte
+
+	poly := 1
+	for i := range exp {
+		exp[i] = byte(poly)
+		log[poly] = byte(i)
+
+		// Multiply by the generator x + 1.
+		poly = (poly << 1) ^ poly
+		if poly&0x100 != 0 {
+			poly ^= 0x11b
+		}
+	}
+
+	return exp, log
+}()
+
+type rawShare struct {
+	x    byte
+	data []byte
+}
+
+// interpolate evaluates at x the polynomial passing through shares, byte by
+// byte.
+func interpolate(shares []rawShare, x byte) ([]byte, error) {
+	seen := make(map[byte]bool, len(shares))
+	length := len(shares[0].data)
+	for _, s := range shares {
+		if seen[s.x] {
+			return nil, fmt.Errorf("%w: duplicate share index %d", ErrInvalidShare, s.x)
+		}
+		seen[s.x] = true
+
+		if len(s.data) != length {
+			return nil, fmt.Errorf("%w: share values differ in length", ErrMismatchedShares)
+		}
+	}
+
+	for _, s := range shares {
+		if s.x == x {
+			return append([]byte(nil), s.data...), nil
+		}
+	}
+
+	logProd := 0
+	for _, s := range shares {
+		logProd += int(logTable[s.x^x])
+	}
+
+	result := make([]byte, length)
+	for _, s := range shares {
+		logBasis := logProd - int(logTable[s.x^x])
+		for _, other := range shares {
+			if other.x != s.x {
+				logBasis -= int(logTable[s.x^other.x])
+			}
+		}
+		logBasis = ((logBasis % 255) + 255) % 255
+
+		for i, b := range s.data {
+			if b != 0 {
+				result[i] ^= expTable[(int(logTable[b])+logBasis)%255]
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// splitSecret splits secret into count shares, threshold of which recover
+// it. The digest share lets recovery detect wrong or mixed shares.
+func splitSecret(threshold, count int, secret []byte) ([]rawShare, error) {
+	if threshold < 1 || threshold > count {
+		return nil, ErrInvalidThreshold
+	}
+
+	if count > maxShareCount {
+		return nil, fmt.Errorf("%w: at most %d shares", ErrInvalidThreshold, maxShareCount)
+	}
+
+	shares := make([]rawShare, 0, count)
+	if threshold == 1 {
+		for i := range count {
+			shares = append(shares, rawShare{x: byte(i), data: append([]byte(nil), secret...)})
+		}
+		return shares, nil
+	}
+
+	randomCount := threshold - 2
+	for i := range randomCount {
+		data := make([]byte, len(secret))
+		if _, err := rand.Read(data); err != nil {
+			return nil, err
+		}
+		shares = append(shares, rawShare{x: byte(i), data: data})
+	}
+
+	randomPart := make([]byte, len(secret)-digestLength)
+	if _, err := rand.Read(randomPart); err != nil {
+		return nil, err
+	}
+
+	base := append([]rawShare{}, shares...)
+	base = append(base,
+		rawShare{x: digestIndex, data: append(createDigest(randomPart, secret), randomPart...)},
+		rawShare{x: secretIndex, data: secret},
+	)
+
+	for i := randomCount; i < count; i++ {
+		data, err := interpolate(base, byte(i))
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, rawShare{x: byte(i), data: data})
+	}
+
+	return shares, nil
+}
+
+// recoverSecret recovers the secret of threshold shares and checks it
+// against the digest share.
+func recoverSecret(threshold int, shares []rawShare) ([]byte, error) {
+	if threshold == 1 {
+		return shares[0].data, nil
+	}
+
+	secret, err := interpolate(shares, secretIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	digestShare, err := interpolate(shares, digestIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	digest, randomPart := digestShare[:digestLength], digestShare[digestLength:]
+	if !hmac.Equal(digest, createDigest(randomPart, secret)) {
+		return nil, ErrInvalidDigest
+	}
+
+	return secret, nil
+}
+
+func createDigest(randomPart, secret []byte) []byte {
+	mac := hmac.New(sha256.New, randomPart)
+	mac.Write(secret)
+	return mac.Sum(nil)[:digestLength]
+}
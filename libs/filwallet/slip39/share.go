@@ -0,0 +1,167 @@
+package slip39
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+const (
+	radixBits = 10
+	// idExpWords hold the 15 bit identifier, the extendable flag and the 4
+	// bit iteration exponent.
+	idExpWords = 2
+	// metadataWords are every word of a share but its value.
+	metadataWords = idExpWords + 2 + checksumWords
+	// minShareWords is the length of a share of a 128 bit secret.
+	minShareWords = metadataWords + (minSecretLength*8+radixBits-1)/radixBits
+)
+
+// wordIndex maps every word of the wordlist to its index.
+var wordIndex = func() map[string]int {
+	index := make(map[string]int, len(wordlist))
+	for i, word := range wordlist {
+		index[word] = i
+	}
+
+	return index
+}()
+
+// share is a decoded SLIP-39 mnemonic.
+type share struct {
+	identifier        int
+	extendable        bool
+	iterationExponent int
+	groupIndex        int
+	groupThreshold    int
+	groupCount        int
+	memberIndex       int
+	memberThreshold   int
+	value             []byte
+}
+
+// commonParams are the parameters every share of a secret agrees on.
+type commonParams struct {
+	identifier        int
+	extendable        bool
+	iterationExponent int
+	groupThreshold    int
+	groupCount        int
+}
+
+func (s *share) common() commonParams {
+	return commonParams{
+		identifier:        s.identifier,
+		extendable:        s.extendable,
+		iterationExponent: s.iterationExponent,
+		groupThreshold:    s.groupThreshold,
+		groupCount:        s.groupCount,
+	}
+}
+
+// mnemonic encodes the share as words.
+func (s *share) mnemonic() string {
+	idExp := s.identifier<<5 | boolToInt(s.extendable)<<4 | s.iterationExponent
+	params := s.groupIndex<<16 | (s.groupThreshold-1)<<12 | (s.groupCount-1)<<8 |
+		s.memberIndex<<4 | (s.memberThreshold - 1)
+
+	valueWords := (len(s.value)*8 + radixBits - 1) / radixBits
+
+	data := make([]int, 0, metadataWords+valueWords)
+	data = append(data, intToIndices(big.NewInt(int64(idExp)), idExpWords)...)
+	data = append(data, intToIndices(big.NewInt(int64(params)), 2)...)
+	data = append(data, intToIndices(new(big.Int).SetBytes(s.value), valueWords)...)
+	data = append(data, createChecksum(data, s.extendable)...)
+
+	words := make([]string, len(data))
+	for i, index := range data {
+		words[i] = wordlist[index]
+	}
+
+	return strings.Join(words, " ")
+}
+
+// parseShare decodes and verifies a mnemonic.
+func parseShare(mnemonic string) (*share, error) {
+	words := strings.Fields(strings.ToLower(mnemonic))
+	if len(words) < minShareWords {
+		return nil, fmt.Errorf("%w: must be at least %d words", ErrInvalidShare, minShareWords)
+	}
+
+	paddingBits := (radixBits * (len(words) - metadataWords)) % 16
+	if paddingBits > 8 {
+		return nil, fmt.Errorf("%w: invalid length", ErrInvalidShare)
+	}
+
+	data := make([]int, len(words))
+	for i, word := range words {
+		index, ok := wordIndex[word]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown word %q", ErrInvalidShare, word)
+		}
+		data[i] = index
+	}
+
+	idExp := data[0]<<radixBits | data[1]
+	s := &share{
+		identifier:        idExp >> 5,
+		extendable:        (idExp>>4)&1 == 1,
+		iterationExponent: idExp & 0xf,
+	}
+
+	if !verifyChecksum(data, s.extendable) {
+		return nil, ErrInvalidChecksum
+	}
+
+	params := data[2]<<radixBits | data[3]
+	s.groupIndex = params >> 16
+	s.groupThreshold = (params>>12)&0xf + 1
+	s.groupCount = (params>>8)&0xf + 1
+	s.memberIndex = (params >> 4) & 0xf
+	s.memberThreshold = params&0xf + 1
+
+	if s.groupCount < s.groupThreshold {
+		return nil, fmt.Errorf("%w: group threshold exceeds group count", ErrInvalidShare)
+	}
+
+	valueData := data[idExpWords+2 : len(data)-checksumWords]
+	valueBytes := (radixBits*len(valueData) - paddingBits) / 8
+
+	value := new(big.Int)
+	for _, index := range valueData {
+		value.Lsh(value, radixBits)
+		value.Or(value, big.NewInt(int64(index)))
+	}
+
+	if value.BitLen() > valueBytes*8 {
+		return nil, fmt.Errorf("%w: invalid padding", ErrInvalidShare)
+	}
+
+	s.value = make([]byte, valueBytes)
+	value.FillBytes(s.value)
+
+	return s, nil
+}
+
+// intToIndices splits v into count words of radixBits bits, most significant
+// first.
+func intToIndices(v *big.Int, count int) []int {
+	v = new(big.Int).Set(v)
+	mask := big.NewInt(1<<radixBits - 1)
+
+	indices := make([]int, count)
+	for i := count - 1; i >= 0; i-- {
+		indices[i] = int(new(big.Int).And(v, mask).Int64())
+		v.Rsh(v, radixBits)
+	}
+
+	return indices
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+
+	return 0
+}
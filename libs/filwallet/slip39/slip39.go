@@ -0,0 +1,187 @@
+// Package slip39 implements SLIP-39 Shamir's Secret-Sharing for Mnemonic
+// Codes, splitting a master secret into word shares that recover it once
+// enough of them are combined.
+package slip39
+
+import (
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrInvalidShare       = errors.New("invalid share")
+	ErrInvalidChecksum    = errors.New("invalid share checksum")
+	ErrMismatchedShares   = errors.New("shares do not belong together")
+	ErrInsufficientShares = errors.New("insufficient shares")
+	ErrInvalidDigest      = errors.New("invalid shares digest")
+	ErrInvalidThreshold   = errors.New("invalid threshold")
+	ErrInvalidSecret      = errors.New("master secret must be an even number of at least 16 bytes")
+	ErrInvalidPassphrase  = errors.New("passphrase must be printable ascii")
+)
+
+const (
+	maxShareCount   = 16
+	minSecretLength = 16
+
+	// iterationExponent sets the PBKDF2 cost of new shares to
+	// baseIterationCount << iterationExponent iterations.
+	iterationExponent  = 1
+	baseIterationCount = 10000
+	roundCount         = 4
+)
+
+// Split splits masterSecret into count shares of a single group, threshold of
+// which recover it. The secret is encrypted under passphrase first, which
+// may be empty.
+func Split(masterSecret []byte, passphrase string, threshold, count int) ([]string, error) {
+	if len(masterSecret) < minSecretLength || len(masterSecret)%2 != 0 {
+		return nil, ErrInvalidSecret
+	}
+
+	if threshold == 1 && count > 1 {
+		return nil, fmt.Errorf("%w: a threshold of 1 requires a single share", ErrInvalidThreshold)
+	}
+
+	var id [2]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return nil, err
+	}
+	identifier := int(binary.BigEndian.Uint16(id[:]) >> 1)
+
+	encrypted, err := encrypt(masterSecret, passphrase, iterationExponent, identifier, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// A single group is recovered with a group threshold of 1, its secret
+	// is then the encrypted master secret itself.
+	members, err := splitSecret(threshold, count, encrypted)
+	if err != nil {
+		return nil, err
+	}
+
+	mnemonics := make([]string, 0, len(members))
+	for _, member := range members {
+		s := share{
+			identifier:        identifier,
+			extendable:        true,
+			iterationExponent: iterationExponent,
+			groupIndex:        0,
+			groupThreshold:    1,
+			groupCount:        1,
+			memberIndex:       int(member.x),
+			memberThreshold:   threshold,
+			value:             member.data,
+		}
+		mnemonics = append(mnemonics, s.mnemonic())
+	}
+
+	return mnemonics, nil
+}
+
+// Combine recovers the master secret from shares, which may span several
+// groups, and decrypts it with passphrase.
+func Combine(mnemonics []string, passphrase string) ([]byte, error) {
+	if len(mnemonics) == 0 {
+		return nil, ErrInsufficientShares
+	}
+
+	var common commonParams
+	groups := make(map[int][]*share)
+	for i, mnemonic := range mnemonics {
+		s, err := parseShare(mnemonic)
+		if err != nil {
+			return nil, fmt.Errorf("share %d: %w", i+1, err)
+		}
+
+		if i == 0 {
+			common = s.common()
+		} else if s.common() != common {
+			return nil, fmt.Errorf("share %d: %w", i+1, ErrMismatchedShares)
+		}
+
+		if group := groups[s.groupIndex]; len(group) > 0 && group[0].memberThreshold != s.memberThreshold {
+			return nil, fmt.Errorf("share %d: %w", i+1, ErrMismatchedShares)
+		}
+		groups[s.groupIndex] = append(groups[s.groupIndex], s)
+	}
+
+	groupShares := make([]rawShare, 0, common.groupThreshold)
+	for index, group := range groups {
+		if len(group) < group[0].memberThreshold {
+			continue
+		}
+
+		members := make([]rawShare, 0, group[0].memberThreshold)
+		for _, s := range group[:group[0].memberThreshold] {
+			members = append(members, rawShare{x: byte(s.memberIndex), data: s.value})
+		}
+
+		secret, err := recoverSecret(group[0].memberThreshold, members)
+		if err != nil {
+			return nil, fmt.Errorf("group %d: %w", index+1, err)
+		}
+		groupShares = append(groupShares, rawShare{x: byte(index), data: secret})
+
+		if len(groupShares) == common.groupThreshold {
+			break
+		}
+	}
+
+	if len(groupShares) < common.groupThreshold {
+		return nil, ErrInsufficientShares
+	}
+
+	encrypted, err := recoverSecret(common.groupThreshold, groupShares)
+	if err != nil {
+		return nil, err
+	}
+
+	return decrypt(encrypted, passphrase, common.iterationExponent, common.identifier, common.extendable)
+}
+
+// encrypt runs the four round Feistel network of SLIP-39 over secret.
+func encrypt(secret []byte, passphrase string, exponent, identifier int, extendable bool) ([]byte, error) {
+	return feistel(secret, passphrase, exponent, identifier, extendable, []int{0, 1, 2, 3})
+}
+
+func decrypt(secret []byte, passphrase string, exponent, identifier int, extendable bool) ([]byte, error) {
+	return feistel(secret, passphrase, exponent, identifier, extendable, []int{3, 2, 1, 0})
+}
+
+func feistel(secret []byte, passphrase string, exponent, identifier int, extendable bool, rounds []int) ([]byte, error) {
+	for i := 0; i < len(passphrase); i++ {
+		if passphrase[i] < 32 || passphrase[i] > 126 {
+			return nil, ErrInvalidPassphrase
+		}
+	}
+
+	// Non-extendable shares bind the encryption to their identifier.
+	var salt []byte
+	if !extendable {
+		salt = append([]byte(customizationOrig), byte(identifier>>8), byte(identifier))
+	}
+
+	half := len(secret) / 2
+	l := append([]byte(nil), secret[:half]...)
+	r := append([]byte(nil), secret[half:]...)
+	iterations := (baseIterationCount << exponent) / roundCount
+
+	for _, i := range rounds {
+		f, err := pbkdf2.Key(sha256.New, string(rune(i))+passphrase, append(append([]byte(nil), salt...), r...), iterations, len(r))
+		if err != nil {
+			return nil, err
+		}
+
+		for j := range l {
+			l[j] ^= f[j]
+		}
+		l, r = r, l
+	}
+
+	return append(r, l...), nil
+}
@@ -0,0 +1,160 @@
+package slip39
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+// vectors are test vectors of the SLIP-39 reference implementation,
+// https://github.com/trezor/python-shamir-mnemonic/blob/master/vectors.json.
+// Their passphrase is "TREZOR". Mnemonics that must be rejected have no
+// secret but the error Combine returns.
+var vectors = []struct {
+	description string
+	mnemonics   []string
+	secret      string
+	err         error
+}{
+	{
+		"1. Valid mnemonic without sharing (128 bits)",
+		[]string{"duckling enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical decision keyboard"},
+		"bb54aac4b89dc868ba37d9cc21b2cece",
+		nil,
+	},
+	{
+		"2. Mnemonic with invalid checksum (128 bits)",
+		[]string{"duckling enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical decision kidney"},
+		"",
+		ErrInvalidChecksum,
+	},
+	{
+		"3. Mnemonic with invalid padding (128 bits)",
+		[]string{"duckling enlarge academic academic email result length solution fridge kidney coal piece deal husband erode duke ajar music cargo fitness"},
+		"",
+		ErrInvalidShare,
+	},
+	{
+		"4. Basic sharing 2-of-3 (128 bits)",
+		[]string{
+			"shadow pistol academic always adequate wildlife fancy gross oasis cylinder mustang wrist rescue view short owner flip making coding armed",
+			"shadow pistol academic acid actress prayer class unknown daughter sweater depict flip twice unkind craft early superior advocate guest smoking",
+		},
+		"b43ceb7e57a0ea8766221624d01b0864",
+		nil,
+	},
+	{
+		"5. Basic sharing 2-of-3 (128 bits)",
+		[]string{"shadow pistol academic always adequate wildlife fancy gross oasis cylinder mustang wrist rescue view short owner flip making coding armed"},
+		"",
+		ErrInsufficientShares,
+	},
+	{
+		"6. Mnemonics with different identifiers (128 bits)",
+		[]string{
+			"adequate smoking academic acid debut wine petition glen cluster slow rhyme slow simple epidemic rumor junk tracks treat olympic tolerate",
+			"adequate stay academic agency agency formal party ting frequent learn upstairs remember smear leaf damage anatomy ladle market hush corner",
+		},
+		"",
+		ErrMismatchedShares,
+	},
+	{
+		"17. Valid mnemonic without sharing (256 bits)",
+		[]string{"theory painting academic academic armed sweater year military elder discuss acne wildlife boring employer fused large satoshi bundle carbon diagnose anatomy hamster leaves tracks paces beyond phantom capital marvel lips brave detect luck"},
+		"989baf9dcaad5b10ca33dfd8cc75e42477025dce88ae83e75a230086a0e00e92",
+		nil,
+	},
+	{
+		"41. Valid extendable mnemonic without sharing (128 bits)",
+		[]string{"testify swimming academic academic column loyalty smear include exotic bedroom exotic wrist lobe cover grief golden smart junior estimate learn"},
+		"1679b4516e0ee5954351d288a838f45e",
+		nil,
+	},
+}
+
+func TestVectors(t *testing.T) {
+	for _, v := range vectors {
+		secret, err := Combine(v.mnemonics, "TREZOR")
+		if v.err != nil {
+			if !errors.Is(err, v.err) {
+				t.Errorf("%s: got %x, %v, want %v", v.description, secret, err, v.err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: %v", v.description, err)
+			continue
+		}
+
+		if got := hex.EncodeToString(secret); got != v.secret {
+			t.Errorf("%s: secret %s, want %s", v.description, got, v.secret)
+		}
+	}
+}
+
+func TestSplitCombine(t *testing.T) {
+	tests := []struct {
+		name       string
+		size       int
+		passphrase string
+		threshold  int
+		count      int
+	}{
+		{"single share", 16, "", 1, 1},
+		{"2 of 3", 16, "", 2, 3},
+		{"3 of 5, 256 bits", 32, "", 3, 5},
+		{"passphrase", 16, "TREZOR", 2, 3},
+	}
+
+	for _, tt := range tests {
+		secret := make([]byte, tt.size)
+		if _, err := rand.Read(secret); err != nil {
+			t.Fatal(err)
+		}
+
+		shares, err := Split(secret, tt.passphrase, tt.threshold, tt.count)
+		if err != nil {
+			t.Fatalf("%s: split: %v", tt.name, err)
+		}
+
+		if len(shares) != tt.count {
+			t.Fatalf("%s: got %d shares, want %d", tt.name, len(shares), tt.count)
+		}
+
+		// Any threshold shares recover the secret, the last ones included.
+		for _, subset := range [][]string{shares[:tt.threshold], shares[tt.count-tt.threshold:]} {
+			got, err := Combine(subset, tt.passphrase)
+			if err != nil {
+				t.Fatalf("%s: combine: %v", tt.name, err)
+			}
+			if !bytes.Equal(got, secret) {
+				t.Errorf("%s: combined %x, want %x", tt.name, got, secret)
+			}
+		}
+
+		if tt.threshold > 1 {
+			if _, err := Combine(shares[:tt.threshold-1], tt.passphrase); !errors.Is(err, ErrInsufficientShares) {
+				t.Errorf("%s: combine below threshold: %v, want %v", tt.name, err, ErrInsufficientShares)
+			}
+		}
+	}
+}
+
+func TestSplitRejects(t *testing.T) {
+	secret := make([]byte, 16)
+
+	if _, err := Split(secret[:15], "", 2, 3); !errors.Is(err, ErrInvalidSecret) {
+		t.Errorf("odd secret: %v, want %v", err, ErrInvalidSecret)
+	}
+
+	if _, err := Split(secret, "", 1, 3); !errors.Is(err, ErrInvalidThreshold) {
+		t.Errorf("threshold 1 of 3: %v, want %v", err, ErrInvalidThreshold)
+	}
+
+	if _, err := Split(secret, "pässword", 2, 3); !errors.Is(err, ErrInvalidPassphrase) {
+		t.Errorf("non-ascii passphrase: %v, want %v", err, ErrInvalidPassphrase)
+	}
+}
@@ -2,18 +2,37 @@ package filwallet
 
 import (
 	"context"
+	"time"
 
-	"github.com/codemaestro64/filament/libs/filwallet/address"
 	"github.com/codemaestro64/filament/libs/filwallet/wallet"
 )
 
 type SaveWalletParams struct {
-	KeyJSON       []byte
-	EncryptedSeed []byte
-	Addresses     []address.Address
-	Name          string
-	Salt          []byte
-	Password      string
+	KeyJSON             []byte
+	EncryptedSeed       []byte
+	EncryptedPassphrase []byte
+	Accounts            []wallet.Account
+	Name                string
+	Salt                []byte
+	KDF                 wallet.KDFParams
+	Password            string
+	DerivationScheme    wallet.DerivationScheme
+	BackupVerifiedAt    time.Time
+}
+
+type UpdateDerivationParams struct {
+	Scheme   wallet.DerivationScheme
+	KeyJSON  []byte
+	Accounts []wallet.Account
+}
+
+type UpdateSecretsParams struct {
+	WalletID int
+	// OldSalt is the salt the secrets were derived from. The update fails
+	// with ErrWalletChanged when the stored salt differs, every
+	// re-encryption draws a new one.
+	OldSalt []byte
+	Secrets wallet.Secrets
 }
 
 type Store interface {
@@ -22,4 +41,23 @@ type Store interface {
 	FindWallet(ctx context.Context, walletID int) (*wallet.Wallet, error)
 	SaveWallet(ctx context.Context, p SaveWalletParams) (*wallet.Wallet, error)
 	DeleteWallet(ctx context.Context, walletID int) error
+	SaveAccount(ctx context.Context, walletID int, account wallet.Account) error
+	UpdateWalletDerivation(ctx context.Context, walletID int, p UpdateDerivationParams) error
+	// UpdateActorIDs records the f0 IDs of addresses, keyed by address.
+	UpdateActorIDs(ctx context.Context, walletID int, actorIDs map[string]string) error
+	// RenameAddresses replaces stored addresses and actor IDs, keyed by their
+	// current value.
+	RenameAddresses(ctx context.Context, walletID int, renames map[string]string) error
+	// UpdateWalletSecrets replaces the salt, kdf, key JSON and seed of wallets in a
+	// single transaction, unless any of them was re-encrypted since its
+	// OldSalt was read.
+	UpdateWalletSecrets(ctx context.Context, updates []UpdateSecretsParams) error
+	// UpdateFailedAttempts stores the wrong password counter of a wallet. A
+	// zero retryAfter clears the backoff.
+	UpdateFailedAttempts(ctx context.Context, walletID int, attempts int, retryAfter time.Time) error
+	// UpdateBackupVerified records when the seed phrase backup of a wallet
+	// was last verified.
+	UpdateBackupVerified(ctx context.Context, walletID int, at time.Time) error
+	// RecordAuditEvent appends an event to the audit trail.
+	RecordAuditEvent(ctx context.Context, event AuditEvent) error
 }
@@ -5,9 +5,25 @@ import (
 )
 
 var (
-	ErrNotFound          = errors.New("wallet not found")
-	ErrSessionExpired    = errors.New("session expired")
-	ErrInvalidPassword   = errors.New("invalid password")
-	ErrInvalidSeedPhrase = errors.New("invalid seed phrase")
-	ErrInvalidWalletName = errors.New("invalid wallet name")
+	ErrNotFound            = errors.New("wallet not found")
+	ErrSessionExpired      = errors.New("session expired")
+	ErrInvalidPassword     = errors.New("invalid password")
+	ErrInvalidSeedPhrase   = errors.New("invalid seed phrase")
+	ErrInvalidWordCount    = errors.New("seed phrase must have 12 or 24 words")
+	ErrUnsupportedLanguage = errors.New("unsupported wordlist language")
+	ErrInvalidWalletName   = errors.New("invalid wallet name")
+	ErrInsufficientFunds   = errors.New("insufficient funds")
+	ErrLegacyBalance       = errors.New("legacy addresses still hold funds")
+	ErrInvalidAmount       = errors.New("invalid amount")
+	ErrInvalidAddress      = errors.New("invalid address")
+	ErrFeeExceedsMax       = errors.New("estimated fee exceeds max fee")
+	ErrAddressNotInWallet  = errors.New("address does not belong to wallet")
+	ErrActorNotFound       = errors.New("actor not found")
+	ErrAccountNotFound     = errors.New("account not found")
+	ErrRateLimited         = errors.New("too many attempts")
+	ErrTooManyAttempts     = errors.New("too many failed password attempts")
+	ErrInvalidExportFormat = errors.New("invalid export format")
+	ErrNoBackupChallenge   = errors.New("no pending backup challenge")
+	ErrBackupMismatch      = errors.New("words do not match the seed phrase")
+	ErrWalletChanged       = errors.New("wallet was re-encrypted concurrently")
 )
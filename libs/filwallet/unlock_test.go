@@ -0,0 +1,81 @@
+package filwallet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/codemaestro64/filament/libs/filwallet/wallet"
+)
+
+func TestUnlockAllWalletsPartial(t *testing.T) {
+	const otherPassword = "other password"
+
+	w, err := testWallet()
+	if err != nil {
+		t.Fatalf("create wallet: %v", err)
+	}
+
+	// Copies of the test wallet: one to unlock, one re-encrypted under
+	// otherPassword, one with a corrupted key and one locked out.
+	withID := func(id int, edit func(w *wallet.Wallet)) *wallet.Wallet {
+		copied := *w
+		copied.ID = id
+		if edit != nil {
+			edit(&copied)
+		}
+		return &copied
+	}
+	retryAfter := time.Now().Add(10 * time.Minute).Truncate(time.Second)
+	store := newMemStore(
+		withID(1, nil),
+		withID(2, nil),
+		withID(3, func(w *wallet.Wallet) { w.EncryptedKeyJSON = []byte("corrupted") }),
+		withID(4, func(w *wallet.Wallet) { w.FailedAttempts, w.RetryAfter = 5, retryAfter }),
+	)
+
+	m := newTestManager(t, store, newTestNode())
+	ctx := context.Background()
+
+	if err := m.ChangePassword(ctx, 2, testPassword, otherPassword); err != nil {
+		t.Fatalf("change password: %v", err)
+	}
+	if err := m.UnlockWallet(ctx, 2, otherPassword); err != nil {
+		t.Fatalf("unlock wallet: %v", err)
+	}
+
+	results, err := m.UnlockAllWallets(ctx, testPassword)
+	if err != nil {
+		t.Fatalf("unlock all: %v", err)
+	}
+
+	want := map[int]UnlockStatus{
+		1: UnlockStatusUnlocked,
+		2: UnlockStatusWrongPassword,
+		3: UnlockStatusCorrupted,
+		4: UnlockStatusLockedOut,
+	}
+	if len(results) != len(want) {
+		t.Fatalf("got %d results, want %d", len(results), len(want))
+	}
+	for _, r := range results {
+		if r.Status != want[r.WalletID] {
+			t.Errorf("wallet %d: status %s, want %s", r.WalletID, r.Status, want[r.WalletID])
+		}
+		if (r.Err != nil) != (r.Status == UnlockStatusCorrupted) {
+			t.Errorf("wallet %d: error %v with status %s", r.WalletID, r.Err, r.Status)
+		}
+		if r.Status == UnlockStatusLockedOut && !r.RetryAfter.Equal(retryAfter) {
+			t.Errorf("wallet %d: retry after %s, want %s", r.WalletID, r.RetryAfter, retryAfter)
+		}
+	}
+
+	// Wallet 2 keeps the session of its own password.
+	var unlocked []int
+	for _, s := range m.SessionStatus() {
+		unlocked = append(unlocked, s.WalletID)
+	}
+	if len(unlocked) != 2 || unlocked[0] != 1 || unlocked[1] != 2 {
+		t.Errorf("unlocked wallets %v, want [1 2]", unlocked)
+	}
+}
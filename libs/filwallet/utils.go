@@ -1,31 +1,216 @@
 package filwallet
 
 import (
+	"crypto/sha256"
 	"fmt"
+	"math/big"
+	"strings"
+	"sync"
 
 	"github.com/tyler-smith/go-bip39"
+	"github.com/tyler-smith/go-bip39/wordlists"
+	"golang.org/x/text/unicode/norm"
 )
 
-// GenerateMnemonic generates a new BIP39 mnemonic phrase
-func GenerateMnemonic(bits int) (string, error) {
+// Language is a BIP39 wordlist.
+type Language string
+
+const (
+	LanguageEnglish            Language = "english"
+	LanguageChineseSimplified  Language = "chinese_simplified"
+	LanguageChineseTraditional Language = "chinese_traditional"
+	LanguageCzech              Language = "czech"
+	LanguageFrench             Language = "french"
+	LanguageItalian            Language = "italian"
+	LanguageJapanese           Language = "japanese"
+	LanguageKorean             Language = "korean"
+	LanguageSpanish            Language = "spanish"
+)
+
+// languages is the order in which ValidateMnemonic tries the wordlists.
+var languages = []Language{
+	LanguageEnglish,
+	LanguageChineseSimplified,
+	LanguageChineseTraditional,
+	LanguageCzech,
+	LanguageFrench,
+	LanguageItalian,
+	LanguageJapanese,
+	LanguageKorean,
+	LanguageSpanish,
+}
+
+var wordlistsByLanguage = map[Language][]string{
+	LanguageEnglish:            wordlists.English,
+	LanguageChineseSimplified:  wordlists.ChineseSimplified,
+	LanguageChineseTraditional: wordlists.ChineseTraditional,
+	LanguageCzech:              wordlists.Czech,
+	LanguageFrench:             wordlists.French,
+	LanguageItalian:            wordlists.Italian,
+	LanguageJapanese:           wordlists.Japanese,
+	LanguageKorean:             wordlists.Korean,
+	LanguageSpanish:            wordlists.Spanish,
+}
+
+// wordIndexes maps the NFKD form of every word to its index, per language.
+var wordIndexes = sync.OnceValue(func() map[Language]map[string]int {
+	indexes := make(map[Language]map[string]int, len(wordlistsByLanguage))
+	for lang, words := range wordlistsByLanguage {
+		index := make(map[string]int, len(words))
+		for i, word := range words {
+			index[norm.NFKD.String(word)] = i
+		}
+		indexes[lang] = index
+	}
+
+	return indexes
+})
+
+// Valid reports whether l is a known wordlist.
+func (l Language) Valid() bool {
+	_, ok := wordlistsByLanguage[l]
+	return ok
+}
+
+// separator joins the words of a mnemonic for display. Japanese mnemonics use
+// the ideographic space, as required by BIP39.
+func (l Language) separator() string {
+	if l == LanguageJapanese {
+		return "\u3000"
+	}
+
+	return " "
+}
+
+// WordCountBits returns the entropy bits of a mnemonic of words words. Only
+// 12 and 24 words are offered for new wallets.
+func WordCountBits(words int) (int, error) {
+	switch words {
+	case 0, 12:
+		return 128, nil
+	case 24:
+		return 256, nil
+	}
+
+	return 0, ErrInvalidWordCount
+}
+
+// GenerateMnemonic generates a new BIP39 mnemonic phrase from the wordlist
+// of lang, English when empty.
+func GenerateMnemonic(bits int, lang Language) (string, error) {
 	if bits != 128 && bits != 256 {
 		bits = 128 // default to 12 words
 	}
 
+	if lang == "" {
+		lang = LanguageEnglish
+	}
+
+	if !lang.Valid() {
+		return "", ErrUnsupportedLanguage
+	}
+
 	entropy, err := bip39.NewEntropy(bits)
 	if err != nil {
 		return "", fmt.Errorf("generate entropy: %w", err)
 	}
 
-	mnemonic, err := bip39.NewMnemonic(entropy)
-	if err != nil {
-		return "", fmt.Errorf("generate mnemonic: %w", err)
+	return entropyToMnemonic(entropy, lang), nil
+}
+
+// entropyToMnemonic encodes 16 to 32 bytes of entropy as a mnemonic in the
+// wordlist of lang.
+func entropyToMnemonic(entropy []byte, lang Language) string {
+	words := wordlistsByLanguage[lang]
+
+	// The checksum is the first bits/32 bits of the entropy hash, appended
+	// to the entropy and split into 11 bit word indexes.
+	bits := len(entropy) * 8
+	checksumBits := uint(bits / 32)
+	hash := sha256.Sum256(entropy)
+
+	b := new(big.Int).SetBytes(entropy)
+	b.Lsh(b, checksumBits)
+	b.Or(b, big.NewInt(int64(hash[0]>>(8-checksumBits))))
+
+	count := (bits + int(checksumBits)) / 11
+	mnemonic := make([]string, count)
+	mask := big.NewInt(2047)
+	for i := count - 1; i >= 0; i-- {
+		mnemonic[i] = words[new(big.Int).And(b, mask).Int64()]
+		b.Rsh(b, 11)
+	}
+
+	return strings.Join(mnemonic, lang.separator())
+}
+
+// NormalizeMnemonic applies the NFKD normalization of BIP39 and collapses
+// whitespace, including ideographic spaces, into single spaces.
+func NormalizeMnemonic(mnemonic string) string {
+	return strings.Join(strings.Fields(strings.ToLower(norm.NFKD.String(mnemonic))), " ")
+}
+
+// NormalizePassphrase applies the NFKD normalization of BIP39.
+func NormalizePassphrase(passphrase string) string {
+	return norm.NFKD.String(passphrase)
+}
+
+// DetectLanguage returns the wordlist mnemonic is valid in, including its
+// checksum.
+func DetectLanguage(mnemonic string) (Language, error) {
+	words := strings.Fields(NormalizeMnemonic(mnemonic))
+	for _, lang := range languages {
+		if mnemonicValidIn(words, lang) {
+			return lang, nil
+		}
 	}
 
-	return mnemonic, nil
+	return "", ErrInvalidSeedPhrase
 }
 
-// ValidateMnemonic checks if a mnemonic phrase is valid
+// ValidateMnemonic checks if a mnemonic phrase is valid in any of the BIP39
+// wordlists.
 func ValidateMnemonic(mnemonic string) bool {
-	return bip39.IsMnemonicValid(mnemonic)
+	_, err := DetectLanguage(mnemonic)
+	return err == nil
+}
+
+// mnemonicValidIn checks the length, words and checksum of a normalized
+// mnemonic against the wordlist of lang.
+func mnemonicValidIn(words []string, lang Language) bool {
+	_, ok := mnemonicToEntropy(words, lang)
+	return ok
+}
+
+// mnemonicToEntropy decodes the entropy of a normalized mnemonic in the
+// wordlist of lang, verifying its checksum.
+func mnemonicToEntropy(words []string, lang Language) ([]byte, bool) {
+	if len(words) < 12 || len(words) > 24 || len(words)%3 != 0 {
+		return nil, false
+	}
+
+	index := wordIndexes()[lang]
+	b := new(big.Int)
+	for _, word := range words {
+		i, ok := index[word]
+		if !ok {
+			return nil, false
+		}
+		b.Lsh(b, 11)
+		b.Or(b, big.NewInt(int64(i)))
+	}
+
+	checksumBits := uint(len(words) * 11 / 33)
+	checksum := new(big.Int).And(b, big.NewInt(1<<checksumBits-1)).Int64()
+	b.Rsh(b, checksumBits)
+
+	entropy := make([]byte, (len(words)*11-int(checksumBits))/8)
+	b.FillBytes(entropy)
+	hash := sha256.Sum256(entropy)
+
+	if int64(hash[0]>>(8-checksumBits)) != checksum {
+		return nil, false
+	}
+
+	return entropy, true
 }
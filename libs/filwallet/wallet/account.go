@@ -0,0 +1,24 @@
+package wallet
+
+import (
+	"github.com/awnumar/memguard"
+	"github.com/codemaestro64/filament/libs/filwallet/address"
+)
+
+// Account is a single key of a wallet together with the addresses derived from it.
+type Account struct {
+	Index uint32
+	Path  string
+	// KeyType is the scheme of the account key. Empty means secp256k1, the
+	// only type accounts had before BLS keys could be imported.
+	KeyType   KeyType
+	Addresses []address.Address
+}
+
+// IsBLS reports whether the account key is a BLS key.
+func (a Account) IsBLS() bool {
+	return a.KeyType == KeyTypeBLS
+}
+
+// Keyring holds the sealed private keys of an unlocked wallet, keyed by account index.
+type Keyring map[uint32]*memguard.Enclave
@@ -0,0 +1,77 @@
+package wallet
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/awnumar/memguard"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// Sizes of BLS keys and signatures as used by Filecoin f3 addresses.
+const (
+	BLSPrivateKeySize = 32
+	BLSPublicKeySize  = bls12381.SizeOfG1AffineCompressed
+	BLSSignatureSize  = bls12381.SizeOfG2AffineCompressed
+)
+
+// blsDST is the ciphersuite Filecoin signs messages with: public keys in G1,
+// signatures in G2 and no proof of possession.
+const blsDST = "BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_NUL_"
+
+// BLSPublicKey returns the compressed G1 public key of a BLS private key.
+func BLSPublicKey(privKey []byte) ([]byte, error) {
+	sk, err := blsScalar(privKey)
+	if err != nil {
+		return nil, err
+	}
+	defer sk.SetInt64(0)
+
+	var pub bls12381.G1Affine
+	pub.ScalarMultiplicationBase(sk)
+	pubBytes := pub.Bytes()
+
+	return pubBytes[:], nil
+}
+
+// SignBLS signs msg with a BLS private key and returns the compressed G2 signature.
+func SignBLS(privKey, msg []byte) ([]byte, error) {
+	sk, err := blsScalar(privKey)
+	if err != nil {
+		return nil, err
+	}
+	defer sk.SetInt64(0)
+
+	point, err := bls12381.HashToG2(msg, []byte(blsDST))
+	if err != nil {
+		return nil, fmt.Errorf("hash message to curve: %w", err)
+	}
+
+	var sig bls12381.G2Affine
+	sig.ScalarMultiplication(&point, sk)
+	sigBytes := sig.Bytes()
+
+	return sigBytes[:], nil
+}
+
+// blsScalar parses a little-endian private key into a scalar of the BLS12-381
+// group order. The caller should zero the result when done.
+func blsScalar(privKey []byte) (*big.Int, error) {
+	if len(privKey) != BLSPrivateKeySize {
+		return nil, ErrInvalidBLSKey
+	}
+
+	be := slices.Clone(privKey)
+	slices.Reverse(be)
+	defer memguard.WipeBytes(be)
+
+	sk := new(big.Int).SetBytes(be)
+	if sk.Sign() == 0 || sk.Cmp(fr.Modulus()) >= 0 {
+		sk.SetInt64(0)
+		return nil, ErrInvalidBLSKey
+	}
+
+	return sk, nil
+}
@@ -0,0 +1,138 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/filecoin-project/go-address"
+)
+
+// Keys generated with the IETF KeyGen of blst, the library behind Lotus' BLS
+// signatures. Private keys are little-endian as in a Lotus KeyInfo.
+var blsVectors = []struct {
+	privKey string
+	pubKey  string
+	msg     string
+	sig     string
+}{
+	{
+		privKey: "3562dbb3987d4feb5b898633cc9c812aec49f2c64cad5b34f5a086df199a124d",
+		pubKey:  "a695ad325dfc7e1191fbc9f186f58eff42a634029731b18380ff89bf42c464a42cb8ca55b200f051f57f1e1893c68759",
+		msg:     "",
+		sig:     "816f1c4001302ece3cdb4b755093855bde28b55b6ec1b3834a10ca08112f36e46ca9f90ae2c4e75f7fe1a6ed71f8ba2d08ddc922a3d3f8198388fdd98ada680a4f77ab5df5a7cf2a50052b33b1f2278671f0267827766ce52a016ee713206762",
+	},
+	{
+		privKey: "3562dbb3987d4feb5b898633cc9c812aec49f2c64cad5b34f5a086df199a124d",
+		pubKey:  "a695ad325dfc7e1191fbc9f186f58eff42a634029731b18380ff89bf42c464a42cb8ca55b200f051f57f1e1893c68759",
+		msg:     "potato",
+		sig:     "b554167948b2951aff3c635444d6f904ef0ec6911ca9ece56c2f6acadf25030168eafb04a15c8a97e87834b044d1c05816750f1ab1c07c9a5538a0334f962058a2d06fbea4184ba0840294737d7f8b409c5e1a08bf08d8d567bd09b039565cbc",
+	},
+	{
+		privKey: "798beceecd6c04ecdad28aabd1cd05b406e76dea4e7ffc672d5a308e82274b14",
+		pubKey:  "95a254501b7733239ed3cec4d56737977bd09ede881d8a234560e83e5525017add3b1dcc3eabfb85e12a4131b19c253b",
+		msg:     "potato",
+		sig:     "918b228482bbd2a0f4bdb557327fed63a67e7240623b016ad80c88dd2d63c8bb8088205e2715b5b6a602da8ecd982d150ae59f7110c45ea90aa95a1654677bfa049e1c261601db00360a9b14dc2f1f2a35b8546304c69e686a9505ef8f64f09c",
+	},
+	{
+		privKey: "17320a8df8dd1a5cbbec3bf93d12ebb227925a7f2ed5f4f99b772a416183d843",
+		pubKey:  "b7ff0596ef4da9bc62c0e0c44eaf048ad73d7221d280f289916bbd43bdba306db02d8099fcca769db8347a00746b821f",
+		msg:     "",
+		sig:     "88b0eeaaccc5176339defc23b8fd300932bd8208c9818a61f0afe4d14afef2b38426e350fba49b023544a346f65452ca1952f9bfb77fd0a49b4dc6443afdb9ac23b72542cc71bd3057e5dfa3d1d41f55f6cffb284b6ff31b3ab38b9f4023bfc6",
+	},
+}
+
+func TestBLSVectors(t *testing.T) {
+	for _, v := range blsVectors {
+		privKey, _ := hex.DecodeString(v.privKey)
+
+		pubKey, err := BLSPublicKey(privKey)
+		if err != nil {
+			t.Fatalf("public key %s: %v", v.privKey, err)
+		}
+		if got := hex.EncodeToString(pubKey); got != v.pubKey {
+			t.Errorf("public key %s: got %s, want %s", v.privKey, got, v.pubKey)
+		}
+
+		sig, err := SignBLS(privKey, []byte(v.msg))
+		if err != nil {
+			t.Fatalf("sign %q: %v", v.msg, err)
+		}
+		if got := hex.EncodeToString(sig); got != v.sig {
+			t.Errorf("sign %q with %s: got %s, want %s", v.msg, v.privKey, got, v.sig)
+		}
+
+		if err := verifyBLS(pubKey, []byte(v.msg), sig); err != nil {
+			t.Errorf("verify %q with %s: %v", v.msg, v.pubKey, err)
+		}
+	}
+}
+
+// TestVerifyBLSLotusSignature checks the verification helper against the
+// signature of Lotus' own BLS tests.
+func TestVerifyBLSLotusSignature(t *testing.T) {
+	addr, err := address.NewFromString("f3tcgq5scpfhdwh4dbalwktzf6mbv3ng2nw7tyzni5cyrsgvineid6jybnweecpa6misa6lk4tvwtxj2gkwpzq")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sig, _ := hex.DecodeString("9927444bfcffdca34af57b78757b9b90f1cd28d2a3aeed2aa6bde299f8bbb9184756f2287b0588e6d3f2860d2bb2066e0c59778c1e644fb2cfb35fba8f09fa824a9ed825108c82ff4bf634c1037eeaf185f45673d4a1c1c6eeb712b7d72a5498")
+	msg := []byte("potato")
+
+	if err := verifyBLS(addr.Payload(), msg, sig); err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+
+	sig[40] ^= 0x10
+	if err := verifyBLS(addr.Payload(), msg, sig); err == nil {
+		t.Fatal("verify accepted a modified signature")
+	}
+}
+
+func TestBLSPrivateKeyIsLittleEndian(t *testing.T) {
+	privKey, _ := hex.DecodeString(blsVectors[0].privKey)
+
+	reversed := make([]byte, len(privKey))
+	for i, b := range privKey {
+		reversed[len(privKey)-1-i] = b
+	}
+
+	pubKey, err := BLSPublicKey(reversed)
+	if err == nil && hex.EncodeToString(pubKey) == blsVectors[0].pubKey {
+		t.Fatal("big-endian key yields the same public key")
+	}
+}
+
+// verifyBLS checks a BLS signature of msg against a compressed public key,
+// e(pub, H(m)) == e(g1, sig).
+func verifyBLS(pubKey, msg, sig []byte) error {
+	var pub bls12381.G1Affine
+	if _, err := pub.SetBytes(pubKey); err != nil || pub.IsInfinity() {
+		return errors.New("bad public key")
+	}
+
+	var sigPoint bls12381.G2Affine
+	if _, err := sigPoint.SetBytes(sig); err != nil {
+		return errors.New("bad signature encoding")
+	}
+
+	point, err := bls12381.HashToG2(msg, []byte(blsDST))
+	if err != nil {
+		return err
+	}
+
+	_, _, g1, _ := bls12381.Generators()
+	var negG1 bls12381.G1Affine
+	negG1.Neg(&g1)
+
+	ok, err := bls12381.PairingCheck(
+		[]bls12381.G1Affine{pub, negG1},
+		[]bls12381.G2Affine{point, sigPoint},
+	)
+	if err != nil || !ok {
+		return errors.New("signature mismatch")
+	}
+
+	return nil
+}
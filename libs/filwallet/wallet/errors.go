@@ -4,4 +4,15 @@ import "errors"
 
 var (
 	ErrWalletAlreadyExists = errors.New("wallet already exists")
+	ErrNotHDWallet         = errors.New("wallet has no hierarchical deterministic seed")
+	ErrWrongPassword       = errors.New("wrong password")
+	ErrLegacyDerivation    = errors.New("wallet uses legacy key derivation")
+	ErrAlreadyMigrated     = errors.New("wallet already uses bip44 derivation")
+
+	ErrInvalidPrivateKey     = errors.New("invalid private key")
+	ErrInvalidBLSKey         = errors.New("invalid bls private key")
+	ErrInvalidKeyInfo        = errors.New("invalid key info")
+	ErrUnsupportedKey        = errors.New("unsupported key type")
+	ErrInvalidKeystore       = errors.New("invalid keystore")
+	ErrWrongKeystorePassword = errors.New("wrong keystore password")
 )
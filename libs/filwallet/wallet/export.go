@@ -0,0 +1,65 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/awnumar/memguard"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+)
+
+// ExportKeyInfo returns the keyType key sealed in enclave as the hex encoded
+// KeyInfo accepted by `lotus wallet import`.
+func ExportKeyInfo(enclave *memguard.Enclave, keyType KeyType) (string, error) {
+	buf, err := enclave.Open()
+	if err != nil {
+		return "", fmt.Errorf("open enclave: %w", err)
+	}
+	defer buf.Destroy()
+
+	raw, err := json.Marshal(KeyInfo{
+		Type:       keyType,
+		PrivateKey: buf.Bytes(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal key info: %w", err)
+	}
+	defer memguard.WipeBytes(raw)
+
+	return hex.EncodeToString(raw), nil
+}
+
+// ExportKeystore returns the key sealed in enclave as an Ethereum V3 keystore
+// encrypted under password, as imported by MetaMask and geth.
+func ExportKeystore(enclave *memguard.Enclave, password string) ([]byte, error) {
+	buf, err := enclave.Open()
+	if err != nil {
+		return nil, fmt.Errorf("open enclave: %w", err)
+	}
+	defer buf.Destroy()
+
+	privKey, err := crypto.ToECDSA(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("convert to ecdsa: %w", err)
+	}
+	defer wipeECDSA(privKey)
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, fmt.Errorf("generate key id: %w", err)
+	}
+
+	keyJSON, err := keystore.EncryptKey(&keystore.Key{
+		Id:         id,
+		Address:    crypto.PubkeyToAddress(privKey.PublicKey),
+		PrivateKey: privKey,
+	}, password, keystore.StandardScryptN, keystore.StandardScryptP)
+	if err != nil {
+		return nil, fmt.Errorf("encrypt keystore: %w", err)
+	}
+
+	return keyJSON, nil
+}
@@ -0,0 +1,148 @@
+package wallet
+
+import (
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/awnumar/memguard"
+	"github.com/codemaestro64/filament/apps/api/pkg/util"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// SLIP-44 coin types used in BIP44 derivation paths.
+const (
+	CoinTypeFilecoin uint32 = 461
+	CoinTypeTestnet  uint32 = 1
+)
+
+const (
+	purposeBIP44    uint32 = 44
+	hardenedOffset  uint32 = 0x80000000
+	externalChain   uint32 = 0
+	masterHMACKey          = "Bitcoin seed"
+	extendedKeySize        = 32
+)
+
+var errInvalidChildKey = errors.New("invalid child key")
+
+// CoinType returns the BIP44 coin type for the given network.
+func CoinType(network util.Network) uint32 {
+	if network.IsMainnet() {
+		return CoinTypeFilecoin
+	}
+
+	return CoinTypeTestnet
+}
+
+// DerivationPath returns the BIP44 path m/44'/coinType'/account'/0/index.
+func DerivationPath(coinType, account, index uint32) accounts.DerivationPath {
+	return accounts.DerivationPath{
+		hardenedOffset + purposeBIP44,
+		hardenedOffset + coinType,
+		hardenedOffset + account,
+		externalChain,
+		index,
+	}
+}
+
+// extendedKey is a BIP32 private key together with its chain code.
+type extendedKey struct {
+	key       []byte
+	chainCode []byte
+}
+
+func (k *extendedKey) wipe() {
+	memguard.WipeBytes(k.key)
+	memguard.WipeBytes(k.chainCode)
+}
+
+func newMasterKey(seed []byte) (*extendedKey, error) {
+	mac := hmac.New(sha512.New, []byte(masterHMACKey))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+
+	master := &extendedKey{key: sum[:extendedKeySize], chainCode: sum[extendedKeySize:]}
+	if !validPrivateKey(master.key) {
+		master.wipe()
+		return nil, errors.New("invalid master key")
+	}
+
+	return master, nil
+}
+
+// child implements BIP32 private parent key to private child key derivation.
+func (k *extendedKey) child(i uint32) (*extendedKey, error) {
+	data := make([]byte, 0, 37)
+	if i >= hardenedOffset {
+		data = append(data, 0x00)
+		data = append(data, k.key...)
+	} else {
+		priv, err := crypto.ToECDSA(k.key)
+		if err != nil {
+			return nil, fmt.Errorf("parse parent key: %w", err)
+		}
+		data = append(data, crypto.CompressPubkey(&priv.PublicKey)...)
+		wipeECDSA(priv)
+	}
+	data = binary.BigEndian.AppendUint32(data, i)
+	defer memguard.WipeBytes(data)
+
+	mac := hmac.New(sha512.New, k.chainCode)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+	defer memguard.WipeBytes(sum[:extendedKeySize])
+
+	n := crypto.S256().Params().N
+	il := new(big.Int).SetBytes(sum[:extendedKeySize])
+	if il.Cmp(n) >= 0 {
+		return nil, errInvalidChildKey
+	}
+
+	childInt := il.Add(il, new(big.Int).SetBytes(k.key))
+	childInt.Mod(childInt, n)
+	if childInt.Sign() == 0 {
+		return nil, errInvalidChildKey
+	}
+
+	childKey := make([]byte, extendedKeySize)
+	childInt.FillBytes(childKey)
+	childInt.SetInt64(0)
+
+	return &extendedKey{key: childKey, chainCode: sum[extendedKeySize:]}, nil
+}
+
+func validPrivateKey(key []byte) bool {
+	d := new(big.Int).SetBytes(key)
+	return d.Sign() > 0 && d.Cmp(crypto.S256().Params().N) < 0
+}
+
+// deriveKeyFromSeed derives the secp256k1 private key at path from a BIP39 seed.
+func deriveKeyFromSeed(seed []byte, path accounts.DerivationPath) (*ecdsa.PrivateKey, error) {
+	key, err := newMasterKey(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range path {
+		next, err := key.child(i)
+		key.wipe()
+		if err != nil {
+			return nil, fmt.Errorf("derive %s: %w", path, err)
+		}
+		key = next
+	}
+	defer key.wipe()
+
+	privKey, err := crypto.ToECDSA(key.key)
+	if err != nil {
+		return nil, fmt.Errorf("convert to ecdsa: %w", err)
+	}
+
+	return privKey, nil
+}
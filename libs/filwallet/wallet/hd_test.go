@@ -0,0 +1,109 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/codemaestro64/filament/apps/api/pkg/util"
+	"github.com/codemaestro64/filament/libs/filwallet/address"
+	"github.com/tyler-smith/go-bip39"
+)
+
+const h = hardenedOffset
+
+type bip32Step struct {
+	index     uint32
+	chainCode string
+	key       string
+}
+
+// Test vectors 1 and 2 of BIP32, the first step being the master key.
+var bip32Vectors = []struct {
+	name  string
+	seed  string
+	steps []bip32Step
+}{
+	{
+		name: "vector 1",
+		seed: "000102030405060708090a0b0c0d0e0f",
+		steps: []bip32Step{
+			{0, "873dff81c02f525623fd1fe5167eac3a55a049de3d314bb42ee227ffed37d508", "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35"},
+			{h + 0, "47fdacbd0f1097043b78c63c20c34ef4ed9a111d980047ad16282c7ae6236141", "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea"},
+			{1, "2a7857631386ba23dacac34180dd1983734e444fdbf774041578e9b6adb37c19", "3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368"},
+			{h + 2, "04466b9cc8e161e966409ca52986c584f07e9dc81f735db683c3ff6ec7b1503f", "cbce0d719ecf7431d88e6a89fa1483e02e35092af60c042b1df2ff59fa424dca"},
+			{2, "cfb71883f01676f587d023cc53a35bc7f88f724b1f8c2892ac1275ac822a3edd", "0f479245fb19a38a1954c5c7c0ebab2f9bdfd96a17563ef28a6a4b1a2a764ef4"},
+			{1000000000, "c783e67b921d2beb8f6b389cc646d7263b4145701dadd2161548a8b078e65e9e", "471b76e389e528d6de6d816857e012c5455051cad6660850e58372a6c3e6e7c8"},
+		},
+	},
+	{
+		name: "vector 2",
+		seed: "fffcf9f6f3f0edeae7e4e1dedbd8d5d2cfccc9c6c3c0bdbab7b4b1aeaba8a5a29f9c999693908d8a8784817e7b7875726f6c696663605d5a5754514e4b484542",
+		steps: []bip32Step{
+			{0, "60499f801b896d83179a4374aeb7822aaeaceaa0db1f85ee3e904c4defbd9689", "4b03d6fc340455b363f51020ad3ecca4f0850280cf436c70c727923f6db46c3e"},
+			{0, "f0909affaa7ee7abe5dd4e100598d4dc53cd709d5a5c2cac40e7412f232f7c9c", "abe74a98f6c7eabee0428f53798f0ab8aa1bd37873999041703c742f15ac7e1e"},
+			{h + 2147483647, "be17a268474a6bb9c61e1d720cf6215e2a88c5406c4aee7b38547f585c9a37d9", "877c779ad9687164e9c2f4f0f4ff0340814392330693ce95a58fe18fd52e6e93"},
+			{1, "f366f48f1ea9f2d1d3fe958c95ca84ea18e4c4ddb9366c336c927eb246fb38cb", "704addf544a06e5ee4bea37098463c23613da32020d604506da8c0518e1da4b7"},
+			{h + 2147483646, "637807030d55d01f9a0cb3a7839515d796bd07706386a6eddf06cc29a65a0e29", "f1c7c871a54a804afe328b4c83a1c33b8e5ff48f5087273f04efa83b247d6a2d"},
+			{2, "9452b549be8cea3ecb7a84bec10dcfd94afe4d129ebfd3b3cb58eedf394ed271", "bb7d39bdb83ecf58f2fd82b6d918341cbef428661ef01ab97c28a4842125ac23"},
+		},
+	},
+}
+
+func TestBIP32Vectors(t *testing.T) {
+	for _, v := range bip32Vectors {
+		t.Run(v.name, func(t *testing.T) {
+			seed, _ := hex.DecodeString(v.seed)
+
+			key, err := newMasterKey(seed)
+			if err != nil {
+				t.Fatalf("master key: %v", err)
+			}
+
+			for i, step := range v.steps {
+				if i > 0 {
+					if key, err = key.child(step.index); err != nil {
+						t.Fatalf("step %d: %v", i, err)
+					}
+				}
+
+				if got := hex.EncodeToString(key.chainCode); got != step.chainCode {
+					t.Errorf("step %d: chain code %s, want %s", i, got, step.chainCode)
+				}
+				if got := hex.EncodeToString(key.key); got != step.key {
+					t.Errorf("step %d: key %s, want %s", i, got, step.key)
+				}
+			}
+		})
+	}
+}
+
+func TestDeriveKeyFromSeedFilecoinAddress(t *testing.T) {
+	const mnemonic = "equip will roof matter pink blind book anxiety banner elbow sun young"
+
+	path := DerivationPath(CoinTypeFilecoin, 0, 0)
+	if got, want := path.String(), "m/44'/461'/0'/0/0"; got != want {
+		t.Fatalf("path %s, want %s", got, want)
+	}
+
+	privKey, err := deriveKeyFromSeed(bip39.NewSeed(mnemonic, ""), path)
+	if err != nil {
+		t.Fatalf("derive key: %v", err)
+	}
+
+	addrs, err := address.DeriveAddressesFromPrivateKey(privKey, util.Mainnet)
+	if err != nil {
+		t.Fatalf("derive addresses: %v", err)
+	}
+
+	const want = "f1zx43cf6qb6rd5e4okl7lexnjumxe5toqj6vtr3i"
+	for _, addr := range addrs {
+		if addr.Type == address.TypeF1 {
+			if addr.Value != want {
+				t.Fatalf("f1 address %s, want %s", addr.Value, want)
+			}
+			return
+		}
+	}
+
+	t.Fatal("no f1 address derived")
+}
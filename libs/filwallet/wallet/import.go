@@ -0,0 +1,141 @@
+package wallet
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/awnumar/memguard"
+	"github.com/codemaestro64/filament/apps/api/pkg/util"
+	"github.com/codemaestro64/filament/libs/filwallet/address"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// decodePrivateKey parses a key given either as raw secp256k1 hex, with or
+// without 0x, or as the hex encoded KeyInfo of `lotus wallet export`.
+func decodePrivateKey(encoded string) (*KeyInfo, error) {
+	encoded = strings.TrimPrefix(strings.TrimSpace(encoded), "0x")
+
+	if len(encoded) != 64 {
+		return DecodeKeyInfo(encoded)
+	}
+
+	raw, err := hex.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPrivateKey, err)
+	}
+
+	return &KeyInfo{Type: KeyTypeSecp256k1, PrivateKey: raw}, nil
+}
+
+// ImportKey creates a wallet around a single secp256k1 or BLS key, given as
+// raw hex or as a Lotus KeyInfo. Without a seed the wallet has exactly one
+// account and cannot derive more.
+func ImportKey(encodedKey, walletName, password string, network util.Network, kdf KDFParams) (*Wallet, error) {
+	ki, err := decodePrivateKey(encodedKey)
+	if err != nil {
+		return nil, err
+	}
+	defer memguard.WipeBytes(ki.PrivateKey)
+
+	switch ki.Type {
+	case KeyTypeSecp256k1:
+		privKey, err := crypto.ToECDSA(ki.PrivateKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidPrivateKey, err)
+		}
+		defer wipeECDSA(privKey)
+
+		return importECDSA(privKey, walletName, password, network, kdf)
+	case KeyTypeBLS:
+		return importBLS(ki.PrivateKey, walletName, password, network, kdf)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedKey, ki.Type)
+	}
+}
+
+// ImportKeystore creates a wallet from an Ethereum V3 keystore, as written by
+// geth or MetaMask. The key is re-encrypted under password.
+func ImportKeystore(keyJSON []byte, keystorePassword, walletName, password string, network util.Network, kdf KDFParams) (*Wallet, error) {
+	key, err := keystore.DecryptKey(keyJSON, keystorePassword)
+	if err != nil {
+		if errors.Is(err, keystore.ErrDecrypt) {
+			return nil, ErrWrongKeystorePassword
+		}
+		return nil, fmt.Errorf("%w: %w", ErrInvalidKeystore, err)
+	}
+	defer wipeECDSA(key.PrivateKey)
+
+	return importECDSA(key.PrivateKey, walletName, password, network, kdf)
+}
+
+func importECDSA(privKey *ecdsa.PrivateKey, walletName, password string, network util.Network, kdf KDFParams) (*Wallet, error) {
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	masterKey := deriveMasterKey(password, salt, kdf)
+	defer memguard.WipeBytes(masterKey)
+
+	keyJSON, err := encryptKeyJSON(masterKey, privKey)
+	if err != nil {
+		return nil, fmt.Errorf("encrypt keyJSON: %w", err)
+	}
+
+	addresses, err := address.DeriveAddressesFromPrivateKey(privKey, network)
+	if err != nil {
+		return nil, fmt.Errorf("derive addresses: %w", err)
+	}
+
+	return &Wallet{
+		Name:             walletName,
+		DerivationScheme: SchemeImported,
+		Salt:             salt,
+		KDF:              kdf,
+		Accounts:         []Account{{Index: 0, KeyType: KeyTypeSecp256k1, Addresses: addresses}},
+		EncryptedKeyJSON: keyJSON,
+	}, nil
+}
+
+func importBLS(privKey []byte, walletName, password string, network util.Network, kdf KDFParams) (*Wallet, error) {
+	pubKey, err := BLSPublicKey(privKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPrivateKey, err)
+	}
+
+	addr, err := address.DeriveBLSAddress(pubKey, network)
+	if err != nil {
+		return nil, fmt.Errorf("derive address: %w", err)
+	}
+
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	masterKey := deriveMasterKey(password, salt, kdf)
+	defer memguard.WipeBytes(masterKey)
+
+	keyJSON, err := encryptBLSKeyJSON(masterKey, privKey)
+	if err != nil {
+		return nil, fmt.Errorf("encrypt keyJSON: %w", err)
+	}
+
+	return &Wallet{
+		Name:             walletName,
+		DerivationScheme: SchemeImported,
+		Salt:             salt,
+		KDF:              kdf,
+		Accounts: []Account{{
+			Index:     0,
+			KeyType:   KeyTypeBLS,
+			Addresses: []address.Address{addr},
+		}},
+		EncryptedKeyJSON: keyJSON,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package wallet
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/codemaestro64/filament/apps/api/pkg/util"
+	"github.com/codemaestro64/filament/libs/filwallet/address"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+)
+
+var testKDF = KDFParams{Algorithm: KDFArgon2id, Time: 1, Memory: minKDFMemory, Threads: 1}
+
+func TestImportKeystore(t *testing.T) {
+	const keystorePassword = "keystore password"
+
+	privKey, err := crypto.HexToECDSA("7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyJSON, err := keystore.EncryptKey(&keystore.Key{
+		Id:         uuid.New(),
+		Address:    crypto.PubkeyToAddress(privKey.PublicKey),
+		PrivateKey: privKey,
+	}, keystorePassword, keystore.LightScryptN, keystore.LightScryptP)
+	if err != nil {
+		t.Fatalf("encrypt keystore: %v", err)
+	}
+
+	w, err := ImportKeystore(keyJSON, keystorePassword, "imported", "password", util.CalibrationNet, testKDF)
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+
+	if w.DerivationScheme != SchemeImported || len(w.Accounts) != 1 {
+		t.Fatalf("imported %s wallet with %d accounts, want one imported account", w.DerivationScheme, len(w.Accounts))
+	}
+
+	want, err := address.DeriveAddressesFromPrivateKey(privKey, util.CalibrationNet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, addr := range w.Accounts[0].Addresses {
+		if addr != want[i] {
+			t.Errorf("address %d = %s, want %s", i, addr, want[i])
+		}
+	}
+
+	keyring, err := w.Unlock("password")
+	if err != nil {
+		t.Fatalf("unlock: %v", err)
+	}
+	buf, err := keyring[0].Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer buf.Destroy()
+	if !bytes.Equal(buf.Bytes(), crypto.FromECDSA(privKey)) {
+		t.Error("unlocked key differs from the keystore key")
+	}
+
+	if _, err := ImportKeystore(keyJSON, "wrong", "imported", "password", util.CalibrationNet, testKDF); !errors.Is(err, ErrWrongKeystorePassword) {
+		t.Errorf("wrong keystore password: error = %v, want %v", err, ErrWrongKeystorePassword)
+	}
+
+	if _, err := ImportKeystore([]byte(`{"version":3}`), keystorePassword, "imported", "password", util.CalibrationNet, testKDF); !errors.Is(err, ErrInvalidKeystore) {
+		t.Errorf("malformed keystore: error = %v, want %v", err, ErrInvalidKeystore)
+	}
+}
@@ -0,0 +1,137 @@
+package wallet
+
+import (
+	"crypto/rand"
+	"fmt"
+	"time"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// KDFAlgorithm identifies the function that turns a password into the key
+// encrypting a wallet.
+type KDFAlgorithm string
+
+const (
+	KDFArgon2id KDFAlgorithm = "argon2id"
+)
+
+// Values lists the valid algorithms, satisfying ent's EnumValues interface.
+func (KDFAlgorithm) Values() []string {
+	return []string{string(KDFArgon2id)}
+}
+
+func (a KDFAlgorithm) String() string {
+	return string(a)
+}
+
+// KDFParams are the password hashing parameters of a wallet.
+type KDFParams struct {
+	Algorithm KDFAlgorithm
+	Time      uint32
+	// Memory is in KiB.
+	Memory  uint32
+	Threads uint8
+}
+
+var (
+	// LegacyKDFParams were used for every wallet before the parameters were
+	// stored with it.
+	LegacyKDFParams = KDFParams{Algorithm: KDFArgon2id, Time: 1, Memory: 64 * 1024, Threads: 4}
+	// DefaultKDFParams follow the Argon2id recommendation of OWASP for
+	// 64 MiB of memory.
+	DefaultKDFParams = KDFParams{Algorithm: KDFArgon2id, Time: 3, Memory: 64 * 1024, Threads: 4}
+)
+
+// Bounds of the parameters accepted by Validate and picked by CalibrateKDF.
+const (
+	minKDFMemory = 8 * 1024
+	maxKDFTime   = 64
+)
+
+func (p KDFParams) Validate() error {
+	if p.Algorithm != KDFArgon2id {
+		return fmt.Errorf("unsupported kdf algorithm %q", p.Algorithm)
+	}
+
+	if p.Time == 0 || p.Time > maxKDFTime {
+		return fmt.Errorf("kdf time must be between 1 and %d", maxKDFTime)
+	}
+
+	if p.Memory < minKDFMemory {
+		return fmt.Errorf("kdf memory must be at least %d KiB", minKDFMemory)
+	}
+
+	if p.Threads == 0 {
+		return fmt.Errorf("kdf threads must be at least 1")
+	}
+
+	return nil
+}
+
+// WeakerThan reports whether other is at least as costly as p in time and
+// memory and more costly in one of them, so that a wallet using p should be
+// re-encrypted with other. Parameters trading one cost for the other, or of
+// another algorithm, are not comparable and never weaker, so that a change
+// of configuration cannot silently downgrade existing wallets.
+func (p KDFParams) WeakerThan(other KDFParams) bool {
+	p, other = p.orLegacy(), other.orLegacy()
+	if p.Algorithm != other.Algorithm {
+		return false
+	}
+
+	if other.Time < p.Time || other.Memory < p.Memory {
+		return false
+	}
+
+	return other.Time > p.Time || other.Memory > p.Memory
+}
+
+// orLegacy returns p, or LegacyKDFParams when p is unset. Wallets without
+// stored parameters predate them and use LegacyKDFParams.
+func (p KDFParams) orLegacy() KDFParams {
+	if p.Algorithm == "" {
+		return LegacyKDFParams
+	}
+
+	return p
+}
+
+func (p KDFParams) String() string {
+	return fmt.Sprintf("%s(t=%d, m=%dKiB, p=%d)", p.Algorithm, p.Time, p.Memory, p.Threads)
+}
+
+// CalibrateKDF picks the number of Argon2id passes that makes a single key
+// derivation with the memory and threads of base take at least target on this
+// machine. It never picks fewer passes than base. Calibration takes several
+// derivations, so it is meant to run once and its result to be configured.
+func CalibrateKDF(target time.Duration, base KDFParams) (KDFParams, error) {
+	params := base
+	params.Algorithm = KDFArgon2id
+	if err := params.Validate(); err != nil {
+		return KDFParams{}, err
+	}
+
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return KDFParams{}, err
+	}
+
+	for ; params.Time < maxKDFTime; params.Time++ {
+		start := time.Now()
+		deriveMasterKey("calibration", salt, params)
+		if time.Since(start) >= target {
+			break
+		}
+	}
+
+	return params, nil
+}
+
+// deriveMasterKey turns a human password into a high-entropy 32-byte key.
+func deriveMasterKey(password string, salt []byte, params KDFParams) []byte {
+	params = params.orLegacy()
+
+	// Argon2id used to protect against GPU brute-forcing.
+	return argon2.IDKey([]byte(password), salt, params.Time, params.Memory, params.Threads, 32)
+}
@@ -0,0 +1,53 @@
+package wallet
+
+import (
+	"testing"
+	"time"
+)
+
+func TestKDFParamsWeakerThan(t *testing.T) {
+	base := KDFParams{Algorithm: KDFArgon2id, Time: 3, Memory: 64 * 1024, Threads: 4}
+
+	tests := []struct {
+		name  string
+		p     KDFParams
+		other KDFParams
+		want  bool
+	}{
+		{"equal", base, base, false},
+		{"more time", base, KDFParams{KDFArgon2id, 4, 64 * 1024, 4}, true},
+		{"more memory", base, KDFParams{KDFArgon2id, 3, 128 * 1024, 4}, true},
+		{"more of both", base, KDFParams{KDFArgon2id, 4, 128 * 1024, 4}, true},
+		{"less time", base, KDFParams{KDFArgon2id, 2, 64 * 1024, 4}, false},
+		{"less memory", base, KDFParams{KDFArgon2id, 3, 32 * 1024, 4}, false},
+		{"more time, less memory", base, KDFParams{KDFArgon2id, 8, 32 * 1024, 4}, false},
+		{"less time, more memory", base, KDFParams{KDFArgon2id, 1, 256 * 1024, 4}, false},
+		{"threads only", base, KDFParams{KDFArgon2id, 3, 64 * 1024, 8}, false},
+		{"other algorithm", base, KDFParams{"scrypt", 8, 256 * 1024, 4}, false},
+		{"unset is legacy", KDFParams{}, DefaultKDFParams, true},
+		{"legacy to unset", LegacyKDFParams, KDFParams{}, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.p.WeakerThan(tt.other); got != tt.want {
+			t.Errorf("%s: %s.WeakerThan(%s) = %t, want %t", tt.name, tt.p, tt.other, got, tt.want)
+		}
+	}
+}
+
+func TestCalibrateKDF(t *testing.T) {
+	base := KDFParams{Algorithm: KDFArgon2id, Time: 2, Memory: minKDFMemory, Threads: 1}
+
+	// Any derivation meets the target, the configured passes are kept.
+	got, err := CalibrateKDF(time.Nanosecond, base)
+	if err != nil {
+		t.Fatalf("calibrate: %v", err)
+	}
+	if got != base {
+		t.Errorf("calibrate = %s, want %s", got, base)
+	}
+
+	if _, err := CalibrateKDF(time.Second, KDFParams{Time: 1, Memory: 1024, Threads: 1}); err == nil {
+		t.Error("calibrate accepted too little memory")
+	}
+}
@@ -0,0 +1,54 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// KeyType is the signature scheme of a key, named as in a Lotus KeyInfo.
+type KeyType string
+
+const (
+	// KeyTypeSecp256k1 keys own f1, f4 and 0x addresses.
+	KeyTypeSecp256k1 KeyType = "secp256k1"
+	// KeyTypeBLS keys own an f3 address.
+	KeyTypeBLS KeyType = "bls"
+)
+
+// Values lists the valid key types, satisfying ent's EnumValues interface.
+func (KeyType) Values() []string {
+	return []string{string(KeyTypeSecp256k1), string(KeyTypeBLS)}
+}
+
+func (t KeyType) String() string {
+	return string(t)
+}
+
+// KeyInfo is the key format produced by `lotus wallet export`.
+type KeyInfo struct {
+	Type       KeyType
+	PrivateKey []byte
+}
+
+// DecodeKeyInfo parses the hex encoded JSON of a Lotus KeyInfo.
+func DecodeKeyInfo(encoded string) (*KeyInfo, error) {
+	raw, err := hex.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidKeyInfo, err)
+	}
+
+	var ki KeyInfo
+	if err := json.Unmarshal(raw, &ki); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidKeyInfo, err)
+	}
+
+	switch ki.Type {
+	case KeyTypeSecp256k1, KeyTypeBLS:
+	default:
+		return nil, fmt.Errorf("%w: unsupported key type %q", ErrInvalidKeyInfo, ki.Type)
+	}
+
+	return &ki, nil
+}
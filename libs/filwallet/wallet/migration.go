@@ -0,0 +1,90 @@
+package wallet
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+
+	"github.com/awnumar/memguard"
+	"github.com/codemaestro64/filament/apps/api/pkg/util"
+	"github.com/codemaestro64/filament/libs/filwallet/address"
+	"github.com/ethereum/go-ethereum/accounts"
+)
+
+// DeriveBIP44Account derives the primary account a legacy wallet would have
+// under BIP44 derivation. The wallet itself is left unchanged.
+func (w *Wallet) DeriveBIP44Account(password string, network util.Network) (*Account, error) {
+	masterKey := deriveMasterKey(password, w.Salt, w.KDF)
+	defer memguard.WipeBytes(masterKey)
+
+	privKey, path, err := w.bip44PrimaryKey(masterKey, network)
+	if err != nil {
+		return nil, err
+	}
+	defer wipeECDSA(privKey)
+
+	return newAccount(privKey, path, network)
+}
+
+// MigrateToBIP44 re-derives the primary key of a legacy wallet at the standard
+// BIP44 path and encrypts it under the existing password. The returned key
+// JSON and account replace the legacy ones once persisted.
+func (w *Wallet) MigrateToBIP44(password string, network util.Network) ([]byte, *Account, error) {
+	masterKey := deriveMasterKey(password, w.Salt, w.KDF)
+	defer memguard.WipeBytes(masterKey)
+
+	privKey, path, err := w.bip44PrimaryKey(masterKey, network)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer wipeECDSA(privKey)
+
+	account, err := newAccount(privKey, path, network)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyJSON, err := encryptKeyJSON(masterKey, privKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("encrypt keyJSON: %w", err)
+	}
+
+	return keyJSON, account, nil
+}
+
+func (w *Wallet) bip44PrimaryKey(masterKey []byte, network util.Network) (*ecdsa.PrivateKey, accounts.DerivationPath, error) {
+	if w.DerivationScheme == SchemeImported {
+		return nil, nil, ErrNotHDWallet
+	}
+
+	if w.DerivationScheme != SchemeLegacy {
+		return nil, nil, ErrAlreadyMigrated
+	}
+
+	seed, err := w.decryptSeed(masterKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer memguard.WipeBytes(seed)
+
+	path := DerivationPath(CoinType(network), 0, 0)
+	privKey, err := deriveKeyFromSeed(seed, path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("derive private key from seed: %w", err)
+	}
+
+	return privKey, path, nil
+}
+
+func newAccount(privKey *ecdsa.PrivateKey, path accounts.DerivationPath, network util.Network) (*Account, error) {
+	addresses, err := address.DeriveAddressesFromPrivateKey(privKey, network)
+	if err != nil {
+		return nil, fmt.Errorf("derive addresses: %w", err)
+	}
+
+	return &Account{
+		Index:     path[len(path)-1],
+		Path:      path.String(),
+		KeyType:   KeyTypeSecp256k1,
+		Addresses: addresses,
+	}, nil
+}
@@ -0,0 +1,82 @@
+package wallet
+
+import (
+	"crypto/rand"
+	"fmt"
+
+	"github.com/awnumar/memguard"
+)
+
+// Secrets are the encrypted parts of a wallet that depend on its password.
+type Secrets struct {
+	Salt              []byte
+	KDF               KDFParams
+	EncryptedKeyJSON  []byte
+	EncryptedMnemonic []byte
+	// EncryptedPassphrase is empty when the seed has no passphrase.
+	EncryptedPassphrase []byte
+}
+
+// ChangePassword decrypts the key and seed of w with oldPassword and
+// re-encrypts them under newPassword with a fresh salt and kdf. w is left
+// unchanged until the returned secrets are persisted.
+func (w *Wallet) ChangePassword(oldPassword, newPassword string, kdf KDFParams) (*Secrets, error) {
+	oldKey := deriveMasterKey(oldPassword, w.Salt, w.KDF)
+	defer memguard.WipeBytes(oldKey)
+
+	privKey, err := w.decryptPrimaryKey(oldKey)
+	if err != nil {
+		return nil, err
+	}
+	defer memguard.WipeBytes(privKey)
+
+	var mnemonic []byte
+	if len(w.EncryptedMnemonic) > 0 {
+		mnemonic, err = decryptAESGCM(w.EncryptedMnemonic, oldKey)
+		if err != nil {
+			return nil, ErrWrongPassword
+		}
+		defer memguard.WipeBytes(mnemonic)
+	}
+
+	passphrase, err := w.decryptPassphrase(oldKey)
+	if err != nil {
+		return nil, err
+	}
+	defer memguard.WipeBytes(passphrase)
+
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	newKey := deriveMasterKey(newPassword, salt, kdf)
+	defer memguard.WipeBytes(newKey)
+
+	keyJSON, err := w.encryptPrimaryKey(newKey, privKey)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := &Secrets{
+		Salt:             salt,
+		KDF:              kdf,
+		EncryptedKeyJSON: keyJSON,
+	}
+
+	if mnemonic != nil {
+		secrets.EncryptedMnemonic, err = encryptAESGCM(mnemonic, newKey)
+		if err != nil {
+			return nil, fmt.Errorf("encrypt mnemonic: %w", err)
+		}
+	}
+
+	if passphrase != nil {
+		secrets.EncryptedPassphrase, err = encryptAESGCM(passphrase, newKey)
+		if err != nil {
+			return nil, fmt.Errorf("encrypt passphrase: %w", err)
+		}
+	}
+
+	return secrets, nil
+}
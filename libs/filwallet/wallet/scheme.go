@@ -0,0 +1,23 @@
+package wallet
+
+// DerivationScheme identifies how a wallet's keys were derived from its seed,
+// or that they were imported without one.
+type DerivationScheme string
+
+const (
+	// SchemeLegacy is the SHA-256 derivation used before BIP44 support.
+	SchemeLegacy DerivationScheme = "legacy"
+	// SchemeBIP44 derives keys at m/44'/coinType'/account'/0/index.
+	SchemeBIP44 DerivationScheme = "bip44"
+	// SchemeImported wallets hold a single imported key and no seed.
+	SchemeImported DerivationScheme = "imported"
+)
+
+// Values lists the valid schemes, satisfying ent's EnumValues interface.
+func (DerivationScheme) Values() []string {
+	return []string{string(SchemeLegacy), string(SchemeBIP44), string(SchemeImported)}
+}
+
+func (s DerivationScheme) String() string {
+	return string(s)
+}
@@ -0,0 +1,32 @@
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/awnumar/memguard"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// SignSecp256k1 signs a 32-byte digest with the secp256k1 key sealed in
+// enclave. The key is only held in plaintext for the duration of the call.
+// The signature is 65 bytes, R || S || V, as Filecoin expects.
+func SignSecp256k1(enclave *memguard.Enclave, digest []byte) ([]byte, error) {
+	buf, err := enclave.Open()
+	if err != nil {
+		return nil, fmt.Errorf("open enclave: %w", err)
+	}
+	defer buf.Destroy()
+
+	privKey, err := crypto.ToECDSA(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("convert to ecdsa: %w", err)
+	}
+	defer wipeECDSA(privKey)
+
+	sig, err := crypto.Sign(digest, privKey)
+	if err != nil {
+		return nil, fmt.Errorf("sign digest: %w", err)
+	}
+
+	return sig, nil
+}
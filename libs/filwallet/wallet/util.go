@@ -5,22 +5,17 @@ import (
 	"crypto/cipher"
 	"crypto/ecdsa"
 	"crypto/rand"
-	"crypto/sha256"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 
+	"github.com/awnumar/memguard"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/crypto"
-	"golang.org/x/crypto/argon2"
+	"github.com/google/uuid"
 )
 
-// deriveMasterKey turns a human password into a high-entropy 32-byte hex string.
-func deriveMasterKey(password string, salt []byte) []byte {
-	// Argon2id used to protect against GPU brute-forcing.
-	return argon2.IDKey([]byte(password), salt, 1, 64*1024, 4, 32)
-}
-
 func encryptAESGCM(plaintext, key []byte) ([]byte, error) {
 	block, _ := aes.NewCipher(key[:32]) // Use first 32 bytes
 	gcm, _ := cipher.NewGCM(block)
@@ -39,23 +34,6 @@ func decryptAESGCM(ciphertext, key []byte) ([]byte, error) {
 	return gcm.Open(nil, nonce, ct, nil)
 }
 
-// derivePrivateKeyFromSeed derives an ECDSA private key from a BIP39 seed
-func derivePrivateKeyFromSeed(seed []byte) (*ecdsa.PrivateKey, error) {
-	// Use first 32 bytes of seed as private key material
-	if len(seed) < 32 {
-		return nil, fmt.Errorf("seed too short")
-	}
-
-	// Hash the seed to get deterministic private key
-	hash := sha256.Sum256(seed[:32])
-	privKey, err := crypto.ToECDSA(hash[:])
-	if err != nil {
-		return nil, fmt.Errorf("convert to ecdsa: %w", err)
-	}
-
-	return privKey, nil
-}
-
 func wipeECDSA(priv *ecdsa.PrivateKey) {
 	if priv == nil || priv.D == nil {
 		return
@@ -66,20 +44,49 @@ func wipeECDSA(priv *ecdsa.PrivateKey) {
 	}
 }
 
-func importKeyJSON(masterKey []byte, privKey *ecdsa.PrivateKey, dataDir string) ([]byte, error) {
-	ks := keystore.NewKeyStore(dataDir, keystore.StandardScryptN, keystore.StandardScryptP)
-	account, err := ks.ImportECDSA(privKey, string(masterKey))
+// encryptKeyJSON encrypts privKey as keystore JSON in memory. Nothing is
+// written to disk, the JSON is only ever stored in the database.
+func encryptKeyJSON(masterKey []byte, privKey *ecdsa.PrivateKey) ([]byte, error) {
+	id, err := uuid.NewRandom()
 	if err != nil {
-		if errors.Is(err, keystore.ErrAccountAlreadyExists) {
-			return nil, ErrWalletAlreadyExists
-		}
-		return nil, fmt.Errorf("import ecdsa: %w", err)
+		return nil, fmt.Errorf("generate key id: %w", err)
 	}
 
-	keyJSON, err := ks.Export(account, string(masterKey), string(masterKey))
+	keyJSON, err := keystore.EncryptKey(&keystore.Key{
+		Id:         id,
+		Address:    crypto.PubkeyToAddress(privKey.PublicKey),
+		PrivateKey: privKey,
+	}, string(masterKey), keystore.StandardScryptN, keystore.StandardScryptP)
 	if err != nil {
-		return nil, fmt.Errorf("export keystore: %w", err)
+		return nil, fmt.Errorf("encrypt key: %w", err)
 	}
 
 	return keyJSON, nil
 }
+
+// encryptBLSKeyJSON encrypts a BLS key as KeyInfo JSON. Keystore JSON only
+// holds secp256k1 keys.
+func encryptBLSKeyJSON(masterKey, privKey []byte) ([]byte, error) {
+	raw, err := json.Marshal(KeyInfo{Type: KeyTypeBLS, PrivateKey: privKey})
+	if err != nil {
+		return nil, fmt.Errorf("marshal key info: %w", err)
+	}
+	defer memguard.WipeBytes(raw)
+
+	return encryptAESGCM(raw, masterKey)
+}
+
+func decryptBLSKeyJSON(keyJSON, masterKey []byte) ([]byte, error) {
+	raw, err := decryptAESGCM(keyJSON, masterKey)
+	if err != nil {
+		return nil, ErrWrongPassword
+	}
+	defer memguard.WipeBytes(raw)
+
+	var ki KeyInfo
+	if err := json.Unmarshal(raw, &ki); err != nil || ki.Type != KeyTypeBLS {
+		return nil, fmt.Errorf("decrypt wallet key: %w", ErrInvalidKeyInfo)
+	}
+
+	return ki.PrivateKey, nil
+}
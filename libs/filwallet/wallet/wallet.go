@@ -1,13 +1,17 @@
 package wallet
 
 import (
+	"crypto/ecdsa"
 	"crypto/rand"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/awnumar/memguard"
+	"github.com/codemaestro64/filament/apps/api/pkg/util"
 	"github.com/codemaestro64/filament/libs/filwallet/address"
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/tyler-smith/go-bip39"
@@ -17,34 +21,50 @@ type Wallet struct {
 	ID                int
 	IsDefault         bool
 	Name              string
-	Addresses         []address.Address
+	ActorID           string
+	DerivationScheme  DerivationScheme
+	Accounts          []Account
 	Salt              []byte
+	KDF               KDFParams
 	EncryptedKeyJSON  []byte
 	EncryptedMnemonic []byte
-	CreatedAt         time.Time
+	// EncryptedPassphrase is the BIP39 passphrase of the seed, empty when the
+	// seed has none.
+	EncryptedPassphrase []byte
+	// FailedAttempts counts wrong passwords since the last correct one.
+	FailedAttempts int
+	// RetryAfter is when the next password check is allowed.
+	RetryAfter time.Time
+	// BackupVerifiedAt is when the user last proved to hold the seed
+	// phrase, zero when never.
+	BackupVerifiedAt time.Time
+	CreatedAt        time.Time
 }
 
-func CreateNew(dataDir string, mnemonic, walletName, password string) (*Wallet, error) {
+// CreateNew creates a wallet from mnemonic and an optional BIP39 passphrase,
+// both stored encrypted under password.
+func CreateNew(mnemonic, passphrase, walletName, password string, network util.Network, kdf KDFParams) (*Wallet, error) {
 	salt := make([]byte, 32)
 	if _, err := rand.Read(salt); err != nil {
 		return nil, err
 	}
 
-	seed := bip39.NewSeed(mnemonic, "")
+	seed := bip39.NewSeed(mnemonic, passphrase)
 	defer memguard.WipeBytes(seed)
 
-	privKey, err := derivePrivateKeyFromSeed(seed)
+	path := DerivationPath(CoinType(network), 0, 0)
+	privKey, err := deriveKeyFromSeed(seed, path)
 	if err != nil {
 		return nil, fmt.Errorf("derive private key from seed: %w", err)
 	}
 	defer wipeECDSA(privKey)
 
-	masterKey := deriveMasterKey(password, salt)
+	masterKey := deriveMasterKey(password, salt, kdf)
 	defer memguard.WipeBytes(masterKey)
 
-	keyJSON, err := importKeyJSON(masterKey, privKey, dataDir)
+	keyJSON, err := encryptKeyJSON(masterKey, privKey)
 	if err != nil {
-		return nil, fmt.Errorf("import keyJSON: %w", err)
+		return nil, fmt.Errorf("encrypt keyJSON: %w", err)
 	}
 
 	encryptedMnemonic, err := encryptAESGCM([]byte(mnemonic), masterKey)
@@ -52,55 +72,309 @@ func CreateNew(dataDir string, mnemonic, walletName, password string) (*Wallet,
 		return nil, fmt.Errorf("encrypt mnemonic: %w", err)
 	}
 
-	addresses, err := address.DeriveAddressesFromPrivateKey(privKey)
+	var encryptedPassphrase []byte
+	if passphrase != "" {
+		encryptedPassphrase, err = encryptAESGCM([]byte(passphrase), masterKey)
+		if err != nil {
+			return nil, fmt.Errorf("encrypt passphrase: %w", err)
+		}
+	}
+
+	addresses, err := address.DeriveAddressesFromPrivateKey(privKey, network)
 	if err != nil {
 		return nil, fmt.Errorf("derive addresses: %w", err)
 	}
 
 	return &Wallet{
-		Name:              walletName,
-		Salt:              salt,
-		Addresses:         addresses,
-		EncryptedKeyJSON:  keyJSON,
-		EncryptedMnemonic: encryptedMnemonic,
+		Name:                walletName,
+		DerivationScheme:    SchemeBIP44,
+		Salt:                salt,
+		KDF:                 kdf,
+		Accounts:            []Account{{Index: 0, Path: path.String(), KeyType: KeyTypeSecp256k1, Addresses: addresses}},
+		EncryptedKeyJSON:    keyJSON,
+		EncryptedMnemonic:   encryptedMnemonic,
+		EncryptedPassphrase: encryptedPassphrase,
 	}, nil
 }
 
+// Addresses returns the addresses of every account in the wallet.
+func (w *Wallet) Addresses() []address.Address {
+	var addrs []address.Address
+	for _, acc := range w.Accounts {
+		addrs = append(addrs, acc.Addresses...)
+	}
+
+	return addrs
+}
+
+// NextAccountIndex returns the first unused BIP44 address index.
+func (w *Wallet) NextAccountIndex() uint32 {
+	var next uint32
+	for _, acc := range w.Accounts {
+		if acc.Index >= next {
+			next = acc.Index + 1
+		}
+	}
+
+	return next
+}
+
 // Unlock handles the decryption logic internal to a wallet's data.
-func (w *Wallet) Unlock(password string) (*memguard.Enclave, error) {
-	masterKey := deriveMasterKey(password, w.Salt)
+func (w *Wallet) Unlock(password string) (Keyring, error) {
+	masterKey := deriveMasterKey(password, w.Salt, w.KDF)
 	defer memguard.WipeBytes(masterKey)
 
-	key, err := keystore.DecryptKey(w.EncryptedKeyJSON, string(masterKey))
+	privKey, err := w.decryptPrimaryKey(masterKey)
 	if err != nil {
-		return nil, fmt.Errorf("decrypt wallet key: %w", err)
+		return nil, err
 	}
 
-	privBytes := crypto.FromECDSA(key.PrivateKey)
-	enclave := memguard.NewEnclave(privBytes)
+	keyring := Keyring{0: memguard.NewEnclave(privKey)}
+	memguard.WipeBytes(privKey)
 
-	// Clean up
-	wipeECDSA(key.PrivateKey)
-	memguard.WipeBytes(privBytes)
+	// Only the primary key is stored; further accounts are re-derived from the seed.
+	if len(w.Accounts) <= 1 {
+		return keyring, nil
+	}
+
+	seed, err := w.decryptSeed(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	defer memguard.WipeBytes(seed)
+
+	for _, acc := range w.Accounts {
+		if acc.Index == 0 {
+			continue
+		}
+
+		privKey, err := deriveAccountKey(seed, acc)
+		if err != nil {
+			return nil, fmt.Errorf("derive account %d: %w", acc.Index, err)
+		}
+		keyring[acc.Index] = sealECDSA(privKey)
+		wipeECDSA(privKey)
+	}
+
+	return keyring, nil
+}
+
+// StaleF1Addresses returns the stored f1 addresses of w that the keys in
+// keyring do not own, mapped to the ones they do. Addresses stored before f1
+// was derived from the uncompressed public key are stale this way.
+func (w *Wallet) StaleF1Addresses(keyring Keyring, network util.Network) (map[string]string, error) {
+	stale := make(map[string]string)
+	for _, acc := range w.Accounts {
+		enclave, ok := keyring[acc.Index]
+		if !ok || acc.IsBLS() {
+			continue
+		}
+
+		derived, err := f1AddressOf(enclave, network)
+		if err != nil {
+			return nil, fmt.Errorf("account %d: %w", acc.Index, err)
+		}
+
+		for _, addr := range acc.Addresses {
+			if addr.Type == address.TypeF1 && addr.Value != derived {
+				stale[addr.Value] = derived
+			}
+		}
+	}
+
+	return stale, nil
+}
+
+func f1AddressOf(enclave *memguard.Enclave, network util.Network) (string, error) {
+	buf, err := enclave.Open()
+	if err != nil {
+		return "", fmt.Errorf("open enclave: %w", err)
+	}
+	defer buf.Destroy()
+
+	privKey, err := crypto.ToECDSA(buf.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("convert to ecdsa: %w", err)
+	}
+	defer wipeECDSA(privKey)
+
+	addresses, err := address.DeriveAddressesFromPrivateKey(privKey, network)
+	if err != nil {
+		return "", fmt.Errorf("derive addresses: %w", err)
+	}
+
+	for _, addr := range addresses {
+		if addr.Type == address.TypeF1 {
+			return addr.Value, nil
+		}
+	}
+
+	return "", errors.New("no f1 address derived")
+}
+
+// DeriveAccount derives the next BIP44 account from the wallet seed. The
+// coin type and account of the primary derivation path are reused.
+func (w *Wallet) DeriveAccount(password string, network util.Network) (*Account, *memguard.Enclave, error) {
+	if w.DerivationScheme == SchemeImported {
+		return nil, nil, ErrNotHDWallet
+	}
+
+	if w.DerivationScheme != SchemeBIP44 {
+		return nil, nil, ErrLegacyDerivation
+	}
+
+	if len(w.Accounts) == 0 || w.Accounts[0].Path == "" {
+		return nil, nil, ErrNotHDWallet
+	}
+
+	basePath, err := accounts.ParseDerivationPath(w.Accounts[0].Path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse derivation path: %w", err)
+	}
+
+	masterKey := deriveMasterKey(password, w.Salt, w.KDF)
+	defer memguard.WipeBytes(masterKey)
+
+	seed, err := w.decryptSeed(masterKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer memguard.WipeBytes(seed)
+
+	path := append(accounts.DerivationPath{}, basePath...)
+	path[len(path)-1] = w.NextAccountIndex()
+
+	privKey, err := deriveKeyFromSeed(seed, path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("derive private key from seed: %w", err)
+	}
+	defer wipeECDSA(privKey)
 
-	return enclave, nil
+	account, err := newAccount(privKey, path, network)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return account, sealECDSA(privKey), nil
 }
 
-func (w *Wallet) DecryptSeedPhrase(password string) (string, error) {
+// DecryptSeedPhrase returns the mnemonic of the wallet and its BIP39
+// passphrase, which is empty when the seed has none.
+func (w *Wallet) DecryptSeedPhrase(password string) (string, string, error) {
 	if len(w.EncryptedMnemonic) == 0 {
-		return "", errors.New("no seed phrase stored for this wallet")
+		return "", "", ErrNotHDWallet
 	}
 
-	masterKey := deriveMasterKey(password, w.Salt)
+	masterKey := deriveMasterKey(password, w.Salt, w.KDF)
 	defer memguard.WipeBytes(masterKey)
 
 	mnemonicBytes, err := decryptAESGCM(w.EncryptedMnemonic, masterKey)
 	if err != nil {
-		return "", fmt.Errorf("decrypt seed phrase: %w", err)
+		return "", "", ErrWrongPassword
+	}
+
+	passphraseBytes, err := w.decryptPassphrase(masterKey)
+	if err != nil {
+		memguard.WipeBytes(mnemonicBytes)
+		return "", "", err
 	}
+	defer memguard.WipeBytes(passphraseBytes)
 
+	// String aliases the locked memory, so it is copied out before the
+	// buffer is destroyed.
 	buf := memguard.NewBufferFromBytes(mnemonicBytes)
-	//defer buf.Destroy()
+	defer buf.Destroy()
+
+	return strings.Clone(buf.String()), string(passphraseBytes), nil
+}
+
+// decryptSeed decrypts the stored mnemonic and expands it into a BIP39 seed.
+func (w *Wallet) decryptSeed(masterKey []byte) ([]byte, error) {
+	if len(w.EncryptedMnemonic) == 0 {
+		return nil, ErrNotHDWallet
+	}
+
+	mnemonicBytes, err := decryptAESGCM(w.EncryptedMnemonic, masterKey)
+	if err != nil {
+		return nil, ErrWrongPassword
+	}
+	defer memguard.WipeBytes(mnemonicBytes)
+
+	passphraseBytes, err := w.decryptPassphrase(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	defer memguard.WipeBytes(passphraseBytes)
+
+	return bip39.NewSeed(string(mnemonicBytes), string(passphraseBytes)), nil
+}
+
+// decryptPassphrase decrypts the stored BIP39 passphrase, nil when there is
+// none.
+func (w *Wallet) decryptPassphrase(masterKey []byte) ([]byte, error) {
+	if len(w.EncryptedPassphrase) == 0 {
+		return nil, nil
+	}
+
+	passphrase, err := decryptAESGCM(w.EncryptedPassphrase, masterKey)
+	if err != nil {
+		return nil, ErrWrongPassword
+	}
+
+	return passphrase, nil
+}
+
+func (w *Wallet) primaryIsBLS() bool {
+	return len(w.Accounts) > 0 && w.Accounts[0].IsBLS()
+}
+
+// decryptPrimaryKey decrypts the key of account 0, serialized as in a KeyInfo.
+func (w *Wallet) decryptPrimaryKey(masterKey []byte) ([]byte, error) {
+	if w.primaryIsBLS() {
+		return decryptBLSKeyJSON(w.EncryptedKeyJSON, masterKey)
+	}
+
+	key, err := keystore.DecryptKey(w.EncryptedKeyJSON, string(masterKey))
+	if err != nil {
+		if errors.Is(err, keystore.ErrDecrypt) {
+			return nil, ErrWrongPassword
+		}
+		return nil, fmt.Errorf("decrypt wallet key: %w", err)
+	}
+	defer wipeECDSA(key.PrivateKey)
+
+	return crypto.FromECDSA(key.PrivateKey), nil
+}
+
+// encryptPrimaryKey encrypts privKey, as returned by decryptPrimaryKey,
+// under masterKey.
+func (w *Wallet) encryptPrimaryKey(masterKey, privKey []byte) ([]byte, error) {
+	if w.primaryIsBLS() {
+		return encryptBLSKeyJSON(masterKey, privKey)
+	}
+
+	ecdsaKey, err := crypto.ToECDSA(privKey)
+	if err != nil {
+		return nil, fmt.Errorf("convert to ecdsa: %w", err)
+	}
+	defer wipeECDSA(ecdsaKey)
+
+	return encryptKeyJSON(masterKey, ecdsaKey)
+}
+
+func deriveAccountKey(seed []byte, acc Account) (*ecdsa.PrivateKey, error) {
+	path, err := accounts.ParseDerivationPath(acc.Path)
+	if err != nil {
+		return nil, fmt.Errorf("parse derivation path: %w", err)
+	}
+
+	return deriveKeyFromSeed(seed, path)
+}
+
+func sealECDSA(privKey *ecdsa.PrivateKey) *memguard.Enclave {
+	privBytes := crypto.FromECDSA(privKey)
+	enclave := memguard.NewEnclave(privBytes)
+	memguard.WipeBytes(privBytes)
 
-	return buf.String(), nil
+	return enclave
 }
@@ -0,0 +1,471 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: v1/admin.proto
+
+package pbv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MigrateWalletRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	WalletId int64                  `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Password string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// Persist the migration. When false only a preview is returned. Refused
+	// while the legacy addresses hold funds.
+	Commit bool `protobuf:"varint,3,opt,name=commit,proto3" json:"commit,omitempty"`
+	// Build messages sweeping the legacy f1 and f4 balances to the new
+	// addresses, signed with the legacy key. With commit they are pushed
+	// instead of persisting the migration, which takes another commit once
+	// they landed.
+	BuildSweep    bool `protobuf:"varint,4,opt,name=build_sweep,json=buildSweep,proto3" json:"build_sweep,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MigrateWalletRequest) Reset() {
+	*x = MigrateWalletRequest{}
+	mi := &file_v1_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigrateWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateWalletRequest) ProtoMessage() {}
+
+func (x *MigrateWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateWalletRequest.ProtoReflect.Descriptor instead.
+func (*MigrateWalletRequest) Descriptor() ([]byte, []int) {
+	return file_v1_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MigrateWalletRequest) GetWalletId() int64 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *MigrateWalletRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *MigrateWalletRequest) GetCommit() bool {
+	if x != nil {
+		return x.Commit
+	}
+	return false
+}
+
+func (x *MigrateWalletRequest) GetBuildSweep() bool {
+	if x != nil {
+		return x.BuildSweep
+	}
+	return false
+}
+
+type MigrateWalletResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	WalletId         int64                  `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	DerivationScheme DerivationScheme       `protobuf:"varint,2,opt,name=derivation_scheme,json=derivationScheme,proto3,enum=wallet.v1.DerivationScheme" json:"derivation_scheme,omitempty"`
+	LegacyAddresses  []*Address             `protobuf:"bytes,3,rep,name=legacy_addresses,json=legacyAddresses,proto3" json:"legacy_addresses,omitempty"`
+	NewAddresses     []*Address             `protobuf:"bytes,4,rep,name=new_addresses,json=newAddresses,proto3" json:"new_addresses,omitempty"`
+	Sweeps           []*SignedMessage       `protobuf:"bytes,5,rep,name=sweeps,proto3" json:"sweeps,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MigrateWalletResponse) Reset() {
+	*x = MigrateWalletResponse{}
+	mi := &file_v1_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigrateWalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateWalletResponse) ProtoMessage() {}
+
+func (x *MigrateWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateWalletResponse.ProtoReflect.Descriptor instead.
+func (*MigrateWalletResponse) Descriptor() ([]byte, []int) {
+	return file_v1_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MigrateWalletResponse) GetWalletId() int64 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *MigrateWalletResponse) GetDerivationScheme() DerivationScheme {
+	if x != nil {
+		return x.DerivationScheme
+	}
+	return DerivationScheme_DERIVATION_SCHEME_LEGACY
+}
+
+func (x *MigrateWalletResponse) GetLegacyAddresses() []*Address {
+	if x != nil {
+		return x.LegacyAddresses
+	}
+	return nil
+}
+
+func (x *MigrateWalletResponse) GetNewAddresses() []*Address {
+	if x != nil {
+		return x.NewAddresses
+	}
+	return nil
+}
+
+func (x *MigrateWalletResponse) GetSweeps() []*SignedMessage {
+	if x != nil {
+		return x.Sweeps
+	}
+	return nil
+}
+
+type EndpointHealth struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Url   string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// False while the endpoint is failing and being backed off.
+	Healthy bool `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	// False when the endpoint's head trails the highest known head.
+	InSync              bool                   `protobuf:"varint,3,opt,name=in_sync,json=inSync,proto3" json:"in_sync,omitempty"`
+	HeadHeight          int64                  `protobuf:"varint,4,opt,name=head_height,json=headHeight,proto3" json:"head_height,omitempty"`
+	LatencyMs           int64                  `protobuf:"varint,5,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	ConsecutiveFailures uint32                 `protobuf:"varint,6,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	LastError           string                 `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CheckedAt           *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	RetryAt             *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=retry_at,json=retryAt,proto3,oneof" json:"retry_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *EndpointHealth) Reset() {
+	*x = EndpointHealth{}
+	mi := &file_v1_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndpointHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndpointHealth) ProtoMessage() {}
+
+func (x *EndpointHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndpointHealth.ProtoReflect.Descriptor instead.
+func (*EndpointHealth) Descriptor() ([]byte, []int) {
+	return file_v1_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EndpointHealth) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *EndpointHealth) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *EndpointHealth) GetInSync() bool {
+	if x != nil {
+		return x.InSync
+	}
+	return false
+}
+
+func (x *EndpointHealth) GetHeadHeight() int64 {
+	if x != nil {
+		return x.HeadHeight
+	}
+	return 0
+}
+
+func (x *EndpointHealth) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *EndpointHealth) GetConsecutiveFailures() uint32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *EndpointHealth) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *EndpointHealth) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+func (x *EndpointHealth) GetRetryAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RetryAt
+	}
+	return nil
+}
+
+type GetEndpointHealthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEndpointHealthRequest) Reset() {
+	*x = GetEndpointHealthRequest{}
+	mi := &file_v1_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEndpointHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEndpointHealthRequest) ProtoMessage() {}
+
+func (x *GetEndpointHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEndpointHealthRequest.ProtoReflect.Descriptor instead.
+func (*GetEndpointHealthRequest) Descriptor() ([]byte, []int) {
+	return file_v1_admin_proto_rawDescGZIP(), []int{3}
+}
+
+type GetEndpointHealthResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoints     []*EndpointHealth      `protobuf:"bytes,1,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEndpointHealthResponse) Reset() {
+	*x = GetEndpointHealthResponse{}
+	mi := &file_v1_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEndpointHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEndpointHealthResponse) ProtoMessage() {}
+
+func (x *GetEndpointHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEndpointHealthResponse.ProtoReflect.Descriptor instead.
+func (*GetEndpointHealthResponse) Descriptor() ([]byte, []int) {
+	return file_v1_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetEndpointHealthResponse) GetEndpoints() []*EndpointHealth {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+var File_v1_admin_proto protoreflect.FileDescriptor
+
+const file_v1_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x0ev1/admin.proto\x12\twallet.v1\x1a\x0ev1/types.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x88\x01\n" +
+	"\x14MigrateWalletRequest\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\x03R\bwalletId\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x16\n" +
+	"\x06commit\x18\x03 \x01(\bR\x06commit\x12\x1f\n" +
+	"\vbuild_sweep\x18\x04 \x01(\bR\n" +
+	"buildSweep\"\xa8\x02\n" +
+	"\x15MigrateWalletResponse\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\x03R\bwalletId\x12H\n" +
+	"\x11derivation_scheme\x18\x02 \x01(\x0e2\x1b.wallet.v1.DerivationSchemeR\x10derivationScheme\x12=\n" +
+	"\x10legacy_addresses\x18\x03 \x03(\v2\x12.wallet.v1.AddressR\x0flegacyAddresses\x127\n" +
+	"\rnew_addresses\x18\x04 \x03(\v2\x12.wallet.v1.AddressR\fnewAddresses\x120\n" +
+	"\x06sweeps\x18\x05 \x03(\v2\x18.wallet.v1.SignedMessageR\x06sweeps\"\xeb\x02\n" +
+	"\x0eEndpointHealth\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x18\n" +
+	"\ahealthy\x18\x02 \x01(\bR\ahealthy\x12\x17\n" +
+	"\ain_sync\x18\x03 \x01(\bR\x06inSync\x12\x1f\n" +
+	"\vhead_height\x18\x04 \x01(\x03R\n" +
+	"headHeight\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\x05 \x01(\x03R\tlatencyMs\x121\n" +
+	"\x14consecutive_failures\x18\x06 \x01(\rR\x13consecutiveFailures\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\a \x01(\tR\tlastError\x129\n" +
+	"\n" +
+	"checked_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcheckedAt\x12:\n" +
+	"\bretry_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x00R\aretryAt\x88\x01\x01B\v\n" +
+	"\t_retry_at\"\x1a\n" +
+	"\x18GetEndpointHealthRequest\"T\n" +
+	"\x19GetEndpointHealthResponse\x127\n" +
+	"\tendpoints\x18\x01 \x03(\v2\x19.wallet.v1.EndpointHealthR\tendpoints2\xc2\x01\n" +
+	"\fAdminService\x12R\n" +
+	"\rMigrateWallet\x12\x1f.wallet.v1.MigrateWalletRequest\x1a .wallet.v1.MigrateWalletResponse\x12^\n" +
+	"\x11GetEndpointHealth\x12#.wallet.v1.GetEndpointHealthRequest\x1a$.wallet.v1.GetEndpointHealthResponseB=Z;github.com/codemaestro64/filament/libs/proto/gen/go/v1;pbv1b\x06proto3"
+
+var (
+	file_v1_admin_proto_rawDescOnce sync.Once
+	file_v1_admin_proto_rawDescData []byte
+)
+
+func file_v1_admin_proto_rawDescGZIP() []byte {
+	file_v1_admin_proto_rawDescOnce.Do(func() {
+		file_v1_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_admin_proto_rawDesc), len(file_v1_admin_proto_rawDesc)))
+	})
+	return file_v1_admin_proto_rawDescData
+}
+
+var file_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_v1_admin_proto_goTypes = []any{
+	(*MigrateWalletRequest)(nil),      // 0: wallet.v1.MigrateWalletRequest
+	(*MigrateWalletResponse)(nil),     // 1: wallet.v1.MigrateWalletResponse
+	(*EndpointHealth)(nil),            // 2: wallet.v1.EndpointHealth
+	(*GetEndpointHealthRequest)(nil),  // 3: wallet.v1.GetEndpointHealthRequest
+	(*GetEndpointHealthResponse)(nil), // 4: wallet.v1.GetEndpointHealthResponse
+	(DerivationScheme)(0),             // 5: wallet.v1.DerivationScheme
+	(*Address)(nil),                   // 6: wallet.v1.Address
+	(*SignedMessage)(nil),             // 7: wallet.v1.SignedMessage
+	(*timestamppb.Timestamp)(nil),     // 8: google.protobuf.Timestamp
+}
+var file_v1_admin_proto_depIdxs = []int32{
+	5, // 0: wallet.v1.MigrateWalletResponse.derivation_scheme:type_name -> wallet.v1.DerivationScheme
+	6, // 1: wallet.v1.MigrateWalletResponse.legacy_addresses:type_name -> wallet.v1.Address
+	6, // 2: wallet.v1.MigrateWalletResponse.new_addresses:type_name -> wallet.v1.Address
+	7, // 3: wallet.v1.MigrateWalletResponse.sweeps:type_name -> wallet.v1.SignedMessage
+	8, // 4: wallet.v1.EndpointHealth.checked_at:type_name -> google.protobuf.Timestamp
+	8, // 5: wallet.v1.EndpointHealth.retry_at:type_name -> google.protobuf.Timestamp
+	2, // 6: wallet.v1.GetEndpointHealthResponse.endpoints:type_name -> wallet.v1.EndpointHealth
+	0, // 7: wallet.v1.AdminService.MigrateWallet:input_type -> wallet.v1.MigrateWalletRequest
+	3, // 8: wallet.v1.AdminService.GetEndpointHealth:input_type -> wallet.v1.GetEndpointHealthRequest
+	1, // 9: wallet.v1.AdminService.MigrateWallet:output_type -> wallet.v1.MigrateWalletResponse
+	4, // 10: wallet.v1.AdminService.GetEndpointHealth:output_type -> wallet.v1.GetEndpointHealthResponse
+	9, // [9:11] is the sub-list for method output_type
+	7, // [7:9] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_v1_admin_proto_init() }
+func file_v1_admin_proto_init() {
+	if File_v1_admin_proto != nil {
+		return
+	}
+	file_v1_types_proto_init()
+	file_v1_admin_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_admin_proto_rawDesc), len(file_v1_admin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v1_admin_proto_goTypes,
+		DependencyIndexes: file_v1_admin_proto_depIdxs,
+		MessageInfos:      file_v1_admin_proto_msgTypes,
+	}.Build()
+	File_v1_admin_proto = out.File
+	file_v1_admin_proto_goTypes = nil
+	file_v1_admin_proto_depIdxs = nil
+}
@@ -9,6 +9,7 @@ package pbv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -28,6 +29,9 @@ const (
 	ErrorCode_NOT_FOUND          ErrorCode = 1
 	ErrorCode_VALIDATION_FAILED  ErrorCode = 2
 	ErrorCode_INSUFFICIENT_FUNDS ErrorCode = 3
+	ErrorCode_ALREADY_EXISTS     ErrorCode = 4
+	ErrorCode_RATE_LIMITED       ErrorCode = 5
+	ErrorCode_TOO_MANY_ATTEMPTS  ErrorCode = 6
 )
 
 // Enum value maps for ErrorCode.
@@ -37,12 +41,18 @@ var (
 		1: "NOT_FOUND",
 		2: "VALIDATION_FAILED",
 		3: "INSUFFICIENT_FUNDS",
+		4: "ALREADY_EXISTS",
+		5: "RATE_LIMITED",
+		6: "TOO_MANY_ATTEMPTS",
 	}
 	ErrorCode_value = map[string]int32{
 		"NONE":               0,
 		"NOT_FOUND":          1,
 		"VALIDATION_FAILED":  2,
 		"INSUFFICIENT_FUNDS": 3,
+		"ALREADY_EXISTS":     4,
+		"RATE_LIMITED":       5,
+		"TOO_MANY_ATTEMPTS":  6,
 	}
 )
 
@@ -126,10 +136,15 @@ func (x *FieldViolation) GetMessage() string {
 }
 
 type ErrorDetails struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          ErrorCode              `protobuf:"varint,1,opt,name=code,proto3,enum=wallet.v1.ErrorCode" json:"code,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Violations    []*FieldViolation      `protobuf:"bytes,3,rep,name=violations,proto3" json:"violations,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Code       ErrorCode              `protobuf:"varint,1,opt,name=code,proto3,enum=wallet.v1.ErrorCode" json:"code,omitempty"`
+	Message    string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Violations []*FieldViolation      `protobuf:"bytes,3,rep,name=violations,proto3" json:"violations,omitempty"`
+	// Set when a password check failed or was refused, counting down to a
+	// lockout of the wallet.
+	RemainingAttempts *int32 `protobuf:"varint,4,opt,name=remaining_attempts,json=remainingAttempts,proto3,oneof" json:"remaining_attempts,omitempty"`
+	// When the next password check is allowed.
+	RetryAfter    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=retry_after,json=retryAfter,proto3,oneof" json:"retry_after,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -185,25 +200,47 @@ func (x *ErrorDetails) GetViolations() []*FieldViolation {
 	return nil
 }
 
+func (x *ErrorDetails) GetRemainingAttempts() int32 {
+	if x != nil && x.RemainingAttempts != nil {
+		return *x.RemainingAttempts
+	}
+	return 0
+}
+
+func (x *ErrorDetails) GetRetryAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RetryAfter
+	}
+	return nil
+}
+
 var File_v1_errors_proto protoreflect.FileDescriptor
 
 const file_v1_errors_proto_rawDesc = "" +
 	"\n" +
-	"\x0fv1/errors.proto\x12\twallet.v1\"@\n" +
+	"\x0fv1/errors.proto\x12\twallet.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"@\n" +
 	"\x0eFieldViolation\x12\x14\n" +
 	"\x05field\x18\x01 \x01(\tR\x05field\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x8d\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xaa\x02\n" +
 	"\fErrorDetails\x12(\n" +
 	"\x04code\x18\x01 \x01(\x0e2\x14.wallet.v1.ErrorCodeR\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x129\n" +
 	"\n" +
 	"violations\x18\x03 \x03(\v2\x19.wallet.v1.FieldViolationR\n" +
-	"violations*S\n" +
+	"violations\x122\n" +
+	"\x12remaining_attempts\x18\x04 \x01(\x05H\x00R\x11remainingAttempts\x88\x01\x01\x12@\n" +
+	"\vretry_after\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\n" +
+	"retryAfter\x88\x01\x01B\x15\n" +
+	"\x13_remaining_attemptsB\x0e\n" +
+	"\f_retry_after*\x90\x01\n" +
 	"\tErrorCode\x12\b\n" +
 	"\x04NONE\x10\x00\x12\r\n" +
 	"\tNOT_FOUND\x10\x01\x12\x15\n" +
 	"\x11VALIDATION_FAILED\x10\x02\x12\x16\n" +
-	"\x12INSUFFICIENT_FUNDS\x10\x03B=Z;github.com/codemaestro64/filament/libs/proto/gen/go/v1;pbv1b\x06proto3"
+	"\x12INSUFFICIENT_FUNDS\x10\x03\x12\x12\n" +
+	"\x0eALREADY_EXISTS\x10\x04\x12\x10\n" +
+	"\fRATE_LIMITED\x10\x05\x12\x15\n" +
+	"\x11TOO_MANY_ATTEMPTS\x10\x06B=Z;github.com/codemaestro64/filament/libs/proto/gen/go/v1;pbv1b\x06proto3"
 
 var (
 	file_v1_errors_proto_rawDescOnce sync.Once
@@ -220,18 +257,20 @@ func file_v1_errors_proto_rawDescGZIP() []byte {
 var file_v1_errors_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_v1_errors_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_v1_errors_proto_goTypes = []any{
-	(ErrorCode)(0),         // 0: wallet.v1.ErrorCode
-	(*FieldViolation)(nil), // 1: wallet.v1.FieldViolation
-	(*ErrorDetails)(nil),   // 2: wallet.v1.ErrorDetails
+	(ErrorCode)(0),                // 0: wallet.v1.ErrorCode
+	(*FieldViolation)(nil),        // 1: wallet.v1.FieldViolation
+	(*ErrorDetails)(nil),          // 2: wallet.v1.ErrorDetails
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
 }
 var file_v1_errors_proto_depIdxs = []int32{
 	0, // 0: wallet.v1.ErrorDetails.code:type_name -> wallet.v1.ErrorCode
 	1, // 1: wallet.v1.ErrorDetails.violations:type_name -> wallet.v1.FieldViolation
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 2: wallet.v1.ErrorDetails.retry_after:type_name -> google.protobuf.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_v1_errors_proto_init() }
@@ -239,6 +278,7 @@ func file_v1_errors_proto_init() {
 	if File_v1_errors_proto != nil {
 		return
 	}
+	file_v1_errors_proto_msgTypes[1].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -0,0 +1,136 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: v1/admin.proto
+
+package pbv1connect
+
+import (
+	context "context"
+	errors "errors"
+	connect_go "github.com/bufbuild/connect-go"
+	v1 "github.com/codemaestro64/filament/libs/proto/gen/go/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect_go.IsAtLeastVersion0_1_0
+
+const (
+	// AdminServiceName is the fully-qualified name of the AdminService service.
+	AdminServiceName = "wallet.v1.AdminService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AdminServiceMigrateWalletProcedure is the fully-qualified name of the AdminService's
+	// MigrateWallet RPC.
+	AdminServiceMigrateWalletProcedure = "/wallet.v1.AdminService/MigrateWallet"
+	// AdminServiceGetEndpointHealthProcedure is the fully-qualified name of the AdminService's
+	// GetEndpointHealth RPC.
+	AdminServiceGetEndpointHealthProcedure = "/wallet.v1.AdminService/GetEndpointHealth"
+)
+
+// AdminServiceClient is a client for the wallet.v1.AdminService service.
+type AdminServiceClient interface {
+	// Moves a wallet created with the legacy SHA-256 key derivation to BIP44.
+	MigrateWallet(context.Context, *connect_go.Request[v1.MigrateWalletRequest]) (*connect_go.Response[v1.MigrateWalletResponse], error)
+	// Reports the health of the configured Lotus RPC endpoints in failover order.
+	GetEndpointHealth(context.Context, *connect_go.Request[v1.GetEndpointHealthRequest]) (*connect_go.Response[v1.GetEndpointHealthResponse], error)
+}
+
+// NewAdminServiceClient constructs a client for the wallet.v1.AdminService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAdminServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) AdminServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &adminServiceClient{
+		migrateWallet: connect_go.NewClient[v1.MigrateWalletRequest, v1.MigrateWalletResponse](
+			httpClient,
+			baseURL+AdminServiceMigrateWalletProcedure,
+			opts...,
+		),
+		getEndpointHealth: connect_go.NewClient[v1.GetEndpointHealthRequest, v1.GetEndpointHealthResponse](
+			httpClient,
+			baseURL+AdminServiceGetEndpointHealthProcedure,
+			opts...,
+		),
+	}
+}
+
+// adminServiceClient implements AdminServiceClient.
+type adminServiceClient struct {
+	migrateWallet     *connect_go.Client[v1.MigrateWalletRequest, v1.MigrateWalletResponse]
+	getEndpointHealth *connect_go.Client[v1.GetEndpointHealthRequest, v1.GetEndpointHealthResponse]
+}
+
+// MigrateWallet calls wallet.v1.AdminService.MigrateWallet.
+func (c *adminServiceClient) MigrateWallet(ctx context.Context, req *connect_go.Request[v1.MigrateWalletRequest]) (*connect_go.Response[v1.MigrateWalletResponse], error) {
+	return c.migrateWallet.CallUnary(ctx, req)
+}
+
+// GetEndpointHealth calls wallet.v1.AdminService.GetEndpointHealth.
+func (c *adminServiceClient) GetEndpointHealth(ctx context.Context, req *connect_go.Request[v1.GetEndpointHealthRequest]) (*connect_go.Response[v1.GetEndpointHealthResponse], error) {
+	return c.getEndpointHealth.CallUnary(ctx, req)
+}
+
+// AdminServiceHandler is an implementation of the wallet.v1.AdminService service.
+type AdminServiceHandler interface {
+	// Moves a wallet created with the legacy SHA-256 key derivation to BIP44.
+	MigrateWallet(context.Context, *connect_go.Request[v1.MigrateWalletRequest]) (*connect_go.Response[v1.MigrateWalletResponse], error)
+	// Reports the health of the configured Lotus RPC endpoints in failover order.
+	GetEndpointHealth(context.Context, *connect_go.Request[v1.GetEndpointHealthRequest]) (*connect_go.Response[v1.GetEndpointHealthResponse], error)
+}
+
+// NewAdminServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	adminServiceMigrateWalletHandler := connect_go.NewUnaryHandler(
+		AdminServiceMigrateWalletProcedure,
+		svc.MigrateWallet,
+		opts...,
+	)
+	adminServiceGetEndpointHealthHandler := connect_go.NewUnaryHandler(
+		AdminServiceGetEndpointHealthProcedure,
+		svc.GetEndpointHealth,
+		opts...,
+	)
+	return "/wallet.v1.AdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AdminServiceMigrateWalletProcedure:
+			adminServiceMigrateWalletHandler.ServeHTTP(w, r)
+		case AdminServiceGetEndpointHealthProcedure:
+			adminServiceGetEndpointHealthHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAdminServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAdminServiceHandler struct{}
+
+func (UnimplementedAdminServiceHandler) MigrateWallet(context.Context, *connect_go.Request[v1.MigrateWalletRequest]) (*connect_go.Response[v1.MigrateWalletResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("wallet.v1.AdminService.MigrateWallet is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetEndpointHealth(context.Context, *connect_go.Request[v1.GetEndpointHealthRequest]) (*connect_go.Response[v1.GetEndpointHealthResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("wallet.v1.AdminService.GetEndpointHealth is not implemented"))
+}
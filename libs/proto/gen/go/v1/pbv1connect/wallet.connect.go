@@ -44,6 +44,21 @@ const (
 	// WalletServiceRecoverWalletProcedure is the fully-qualified name of the WalletService's
 	// RecoverWallet RPC.
 	WalletServiceRecoverWalletProcedure = "/wallet.v1.WalletService/RecoverWallet"
+	// WalletServiceCreateShareBackupProcedure is the fully-qualified name of the WalletService's
+	// CreateShareBackup RPC.
+	WalletServiceCreateShareBackupProcedure = "/wallet.v1.WalletService/CreateShareBackup"
+	// WalletServiceRecoverWalletFromSharesProcedure is the fully-qualified name of the WalletService's
+	// RecoverWalletFromShares RPC.
+	WalletServiceRecoverWalletFromSharesProcedure = "/wallet.v1.WalletService/RecoverWalletFromShares"
+	// WalletServiceRevealSeedPhraseProcedure is the fully-qualified name of the WalletService's
+	// RevealSeedPhrase RPC.
+	WalletServiceRevealSeedPhraseProcedure = "/wallet.v1.WalletService/RevealSeedPhrase"
+	// WalletServiceStartBackupVerificationProcedure is the fully-qualified name of the WalletService's
+	// StartBackupVerification RPC.
+	WalletServiceStartBackupVerificationProcedure = "/wallet.v1.WalletService/StartBackupVerification"
+	// WalletServiceVerifyBackupProcedure is the fully-qualified name of the WalletService's
+	// VerifyBackup RPC.
+	WalletServiceVerifyBackupProcedure = "/wallet.v1.WalletService/VerifyBackup"
 	// WalletServiceUpdateWalletProcedure is the fully-qualified name of the WalletService's
 	// UpdateWallet RPC.
 	WalletServiceUpdateWalletProcedure = "/wallet.v1.WalletService/UpdateWallet"
@@ -53,6 +68,27 @@ const (
 	// WalletServiceUnlockWalletsProcedure is the fully-qualified name of the WalletService's
 	// UnlockWallets RPC.
 	WalletServiceUnlockWalletsProcedure = "/wallet.v1.WalletService/UnlockWallets"
+	// WalletServiceLockWalletProcedure is the fully-qualified name of the WalletService's LockWallet
+	// RPC.
+	WalletServiceLockWalletProcedure = "/wallet.v1.WalletService/LockWallet"
+	// WalletServiceLockAllProcedure is the fully-qualified name of the WalletService's LockAll RPC.
+	WalletServiceLockAllProcedure = "/wallet.v1.WalletService/LockAll"
+	// WalletServiceGetSessionStatusProcedure is the fully-qualified name of the WalletService's
+	// GetSessionStatus RPC.
+	WalletServiceGetSessionStatusProcedure = "/wallet.v1.WalletService/GetSessionStatus"
+	// WalletServiceImportKeyProcedure is the fully-qualified name of the WalletService's ImportKey RPC.
+	WalletServiceImportKeyProcedure = "/wallet.v1.WalletService/ImportKey"
+	// WalletServiceImportKeystoreProcedure is the fully-qualified name of the WalletService's
+	// ImportKeystore RPC.
+	WalletServiceImportKeystoreProcedure = "/wallet.v1.WalletService/ImportKeystore"
+	// WalletServiceChangePasswordProcedure is the fully-qualified name of the WalletService's
+	// ChangePassword RPC.
+	WalletServiceChangePasswordProcedure = "/wallet.v1.WalletService/ChangePassword"
+	// WalletServiceExportKeyProcedure is the fully-qualified name of the WalletService's ExportKey RPC.
+	WalletServiceExportKeyProcedure = "/wallet.v1.WalletService/ExportKey"
+	// WalletServiceConvertAddressProcedure is the fully-qualified name of the WalletService's
+	// ConvertAddress RPC.
+	WalletServiceConvertAddressProcedure = "/wallet.v1.WalletService/ConvertAddress"
 )
 
 // WalletServiceClient is a client for the wallet.v1.WalletService service.
@@ -64,11 +100,44 @@ type WalletServiceClient interface {
 	// Creates a new cryptographic wallet and saves its metadata.
 	CreateWallet(context.Context, *connect_go.Request[v1.CreateWalletRequest]) (*connect_go.Response[v1.CreateWalletResponse], error)
 	RecoverWallet(context.Context, *connect_go.Request[v1.RecoverWalletRequest]) (*connect_go.Response[v1.RecoverWalletResponse], error)
+	// Splits the seed of a wallet into SLIP-39 shares, threshold of which
+	// recover it. Requires the wallet password and is audited.
+	CreateShareBackup(context.Context, *connect_go.Request[v1.CreateShareBackupRequest]) (*connect_go.Response[v1.CreateShareBackupResponse], error)
+	// Recovers a wallet from SLIP-39 shares instead of a seed phrase.
+	RecoverWalletFromShares(context.Context, *connect_go.Request[v1.RecoverWalletFromSharesRequest]) (*connect_go.Response[v1.RecoverWalletFromSharesResponse], error)
+	// Returns the seed phrase of a wallet after checking its password. Reveals
+	// are audited.
+	RevealSeedPhrase(context.Context, *connect_go.Request[v1.RevealSeedPhraseRequest]) (*connect_go.Response[v1.RevealSeedPhraseResponse], error)
+	// Picks random seed phrase positions for the user to fill in.
+	StartBackupVerification(context.Context, *connect_go.Request[v1.StartBackupVerificationRequest]) (*connect_go.Response[v1.StartBackupVerificationResponse], error)
+	// Checks the words of the pending challenge and marks the backup as
+	// verified. A wrong answer needs a new challenge.
+	VerifyBackup(context.Context, *connect_go.Request[v1.VerifyBackupRequest]) (*connect_go.Response[v1.VerifyBackupResponse], error)
 	// Updates mutable metadata associated with a wallet (name, default status).
 	UpdateWallet(context.Context, *connect_go.Request[v1.UpdateWalletRequest]) (*connect_go.Response[v1.UpdateWalletResponse], error)
 	// Permanently deletes or archives a wallet record.
 	DeleteWallet(context.Context, *connect_go.Request[v1.DeleteWalletRequest]) (*connect_go.Response[v1.DeleteWalletResponse], error)
+	// Unlocks every wallet that uses the given password and reports the
+	// outcome per wallet.
 	UnlockWallets(context.Context, *connect_go.Request[v1.UnlockWalletsRequest]) (*connect_go.Response[v1.UnlockWalletsResponse], error)
+	// Locks a single wallet.
+	LockWallet(context.Context, *connect_go.Request[v1.LockWalletRequest]) (*connect_go.Response[v1.LockWalletResponse], error)
+	// Locks every wallet.
+	LockAll(context.Context, *connect_go.Request[v1.LockAllRequest]) (*connect_go.Response[v1.LockAllResponse], error)
+	// Lists the unlocked wallets and when each one locks.
+	GetSessionStatus(context.Context, *connect_go.Request[v1.GetSessionStatusRequest]) (*connect_go.Response[v1.GetSessionStatusResponse], error)
+	// Creates a wallet from a single private key. It has no seed phrase.
+	ImportKey(context.Context, *connect_go.Request[v1.ImportKeyRequest]) (*connect_go.Response[v1.ImportKeyResponse], error)
+	// Creates a wallet from an Ethereum V3 keystore file.
+	ImportKeystore(context.Context, *connect_go.Request[v1.ImportKeystoreRequest]) (*connect_go.Response[v1.ImportKeystoreResponse], error)
+	// Re-encrypts one or all wallets under a new password.
+	ChangePassword(context.Context, *connect_go.Request[v1.ChangePasswordRequest]) (*connect_go.Response[v1.ChangePasswordResponse], error)
+	// Exports the private key of a wallet account. Attempts are audited and
+	// rate limited.
+	ExportKey(context.Context, *connect_go.Request[v1.ExportKeyRequest]) (*connect_go.Response[v1.ExportKeyResponse], error)
+	// Converts an address to all its equivalent forms, looking up chain state
+	// where needed.
+	ConvertAddress(context.Context, *connect_go.Request[v1.ConvertAddressRequest]) (*connect_go.Response[v1.ConvertAddressResponse], error)
 }
 
 // NewWalletServiceClient constructs a client for the wallet.v1.WalletService service. By default,
@@ -101,6 +170,31 @@ func NewWalletServiceClient(httpClient connect_go.HTTPClient, baseURL string, op
 			baseURL+WalletServiceRecoverWalletProcedure,
 			opts...,
 		),
+		createShareBackup: connect_go.NewClient[v1.CreateShareBackupRequest, v1.CreateShareBackupResponse](
+			httpClient,
+			baseURL+WalletServiceCreateShareBackupProcedure,
+			opts...,
+		),
+		recoverWalletFromShares: connect_go.NewClient[v1.RecoverWalletFromSharesRequest, v1.RecoverWalletFromSharesResponse](
+			httpClient,
+			baseURL+WalletServiceRecoverWalletFromSharesProcedure,
+			opts...,
+		),
+		revealSeedPhrase: connect_go.NewClient[v1.RevealSeedPhraseRequest, v1.RevealSeedPhraseResponse](
+			httpClient,
+			baseURL+WalletServiceRevealSeedPhraseProcedure,
+			opts...,
+		),
+		startBackupVerification: connect_go.NewClient[v1.StartBackupVerificationRequest, v1.StartBackupVerificationResponse](
+			httpClient,
+			baseURL+WalletServiceStartBackupVerificationProcedure,
+			opts...,
+		),
+		verifyBackup: connect_go.NewClient[v1.VerifyBackupRequest, v1.VerifyBackupResponse](
+			httpClient,
+			baseURL+WalletServiceVerifyBackupProcedure,
+			opts...,
+		),
 		updateWallet: connect_go.NewClient[v1.UpdateWalletRequest, v1.UpdateWalletResponse](
 			httpClient,
 			baseURL+WalletServiceUpdateWalletProcedure,
@@ -116,18 +210,71 @@ func NewWalletServiceClient(httpClient connect_go.HTTPClient, baseURL string, op
 			baseURL+WalletServiceUnlockWalletsProcedure,
 			opts...,
 		),
+		lockWallet: connect_go.NewClient[v1.LockWalletRequest, v1.LockWalletResponse](
+			httpClient,
+			baseURL+WalletServiceLockWalletProcedure,
+			opts...,
+		),
+		lockAll: connect_go.NewClient[v1.LockAllRequest, v1.LockAllResponse](
+			httpClient,
+			baseURL+WalletServiceLockAllProcedure,
+			opts...,
+		),
+		getSessionStatus: connect_go.NewClient[v1.GetSessionStatusRequest, v1.GetSessionStatusResponse](
+			httpClient,
+			baseURL+WalletServiceGetSessionStatusProcedure,
+			opts...,
+		),
+		importKey: connect_go.NewClient[v1.ImportKeyRequest, v1.ImportKeyResponse](
+			httpClient,
+			baseURL+WalletServiceImportKeyProcedure,
+			opts...,
+		),
+		importKeystore: connect_go.NewClient[v1.ImportKeystoreRequest, v1.ImportKeystoreResponse](
+			httpClient,
+			baseURL+WalletServiceImportKeystoreProcedure,
+			opts...,
+		),
+		changePassword: connect_go.NewClient[v1.ChangePasswordRequest, v1.ChangePasswordResponse](
+			httpClient,
+			baseURL+WalletServiceChangePasswordProcedure,
+			opts...,
+		),
+		exportKey: connect_go.NewClient[v1.ExportKeyRequest, v1.ExportKeyResponse](
+			httpClient,
+			baseURL+WalletServiceExportKeyProcedure,
+			opts...,
+		),
+		convertAddress: connect_go.NewClient[v1.ConvertAddressRequest, v1.ConvertAddressResponse](
+			httpClient,
+			baseURL+WalletServiceConvertAddressProcedure,
+			opts...,
+		),
 	}
 }
 
 // walletServiceClient implements WalletServiceClient.
 type walletServiceClient struct {
-	getWallet     *connect_go.Client[v1.GetWalletRequest, v1.GetWalletResponse]
-	getWallets    *connect_go.Client[v1.GetWalletsRequest, v1.GetWalletsResponse]
-	createWallet  *connect_go.Client[v1.CreateWalletRequest, v1.CreateWalletResponse]
-	recoverWallet *connect_go.Client[v1.RecoverWalletRequest, v1.RecoverWalletResponse]
-	updateWallet  *connect_go.Client[v1.UpdateWalletRequest, v1.UpdateWalletResponse]
-	deleteWallet  *connect_go.Client[v1.DeleteWalletRequest, v1.DeleteWalletResponse]
-	unlockWallets *connect_go.Client[v1.UnlockWalletsRequest, v1.UnlockWalletsResponse]
+	getWallet               *connect_go.Client[v1.GetWalletRequest, v1.GetWalletResponse]
+	getWallets              *connect_go.Client[v1.GetWalletsRequest, v1.GetWalletsResponse]
+	createWallet            *connect_go.Client[v1.CreateWalletRequest, v1.CreateWalletResponse]
+	recoverWallet           *connect_go.Client[v1.RecoverWalletRequest, v1.RecoverWalletResponse]
+	createShareBackup       *connect_go.Client[v1.CreateShareBackupRequest, v1.CreateShareBackupResponse]
+	recoverWalletFromShares *connect_go.Client[v1.RecoverWalletFromSharesRequest, v1.RecoverWalletFromSharesResponse]
+	revealSeedPhrase        *connect_go.Client[v1.RevealSeedPhraseRequest, v1.RevealSeedPhraseResponse]
+	startBackupVerification *connect_go.Client[v1.StartBackupVerificationRequest, v1.StartBackupVerificationResponse]
+	verifyBackup            *connect_go.Client[v1.VerifyBackupRequest, v1.VerifyBackupResponse]
+	updateWallet            *connect_go.Client[v1.UpdateWalletRequest, v1.UpdateWalletResponse]
+	deleteWallet            *connect_go.Client[v1.DeleteWalletRequest, v1.DeleteWalletResponse]
+	unlockWallets           *connect_go.Client[v1.UnlockWalletsRequest, v1.UnlockWalletsResponse]
+	lockWallet              *connect_go.Client[v1.LockWalletRequest, v1.LockWalletResponse]
+	lockAll                 *connect_go.Client[v1.LockAllRequest, v1.LockAllResponse]
+	getSessionStatus        *connect_go.Client[v1.GetSessionStatusRequest, v1.GetSessionStatusResponse]
+	importKey               *connect_go.Client[v1.ImportKeyRequest, v1.ImportKeyResponse]
+	importKeystore          *connect_go.Client[v1.ImportKeystoreRequest, v1.ImportKeystoreResponse]
+	changePassword          *connect_go.Client[v1.ChangePasswordRequest, v1.ChangePasswordResponse]
+	exportKey               *connect_go.Client[v1.ExportKeyRequest, v1.ExportKeyResponse]
+	convertAddress          *connect_go.Client[v1.ConvertAddressRequest, v1.ConvertAddressResponse]
 }
 
 // GetWallet calls wallet.v1.WalletService.GetWallet.
@@ -150,6 +297,31 @@ func (c *walletServiceClient) RecoverWallet(ctx context.Context, req *connect_go
 	return c.recoverWallet.CallUnary(ctx, req)
 }
 
+// CreateShareBackup calls wallet.v1.WalletService.CreateShareBackup.
+func (c *walletServiceClient) CreateShareBackup(ctx context.Context, req *connect_go.Request[v1.CreateShareBackupRequest]) (*connect_go.Response[v1.CreateShareBackupResponse], error) {
+	return c.createShareBackup.CallUnary(ctx, req)
+}
+
+// RecoverWalletFromShares calls wallet.v1.WalletService.RecoverWalletFromShares.
+func (c *walletServiceClient) RecoverWalletFromShares(ctx context.Context, req *connect_go.Request[v1.RecoverWalletFromSharesRequest]) (*connect_go.Response[v1.RecoverWalletFromSharesResponse], error) {
+	return c.recoverWalletFromShares.CallUnary(ctx, req)
+}
+
+// RevealSeedPhrase calls wallet.v1.WalletService.RevealSeedPhrase.
+func (c *walletServiceClient) RevealSeedPhrase(ctx context.Context, req *connect_go.Request[v1.RevealSeedPhraseRequest]) (*connect_go.Response[v1.RevealSeedPhraseResponse], error) {
+	return c.revealSeedPhrase.CallUnary(ctx, req)
+}
+
+// StartBackupVerification calls wallet.v1.WalletService.StartBackupVerification.
+func (c *walletServiceClient) StartBackupVerification(ctx context.Context, req *connect_go.Request[v1.StartBackupVerificationRequest]) (*connect_go.Response[v1.StartBackupVerificationResponse], error) {
+	return c.startBackupVerification.CallUnary(ctx, req)
+}
+
+// VerifyBackup calls wallet.v1.WalletService.VerifyBackup.
+func (c *walletServiceClient) VerifyBackup(ctx context.Context, req *connect_go.Request[v1.VerifyBackupRequest]) (*connect_go.Response[v1.VerifyBackupResponse], error) {
+	return c.verifyBackup.CallUnary(ctx, req)
+}
+
 // UpdateWallet calls wallet.v1.WalletService.UpdateWallet.
 func (c *walletServiceClient) UpdateWallet(ctx context.Context, req *connect_go.Request[v1.UpdateWalletRequest]) (*connect_go.Response[v1.UpdateWalletResponse], error) {
 	return c.updateWallet.CallUnary(ctx, req)
@@ -165,6 +337,46 @@ func (c *walletServiceClient) UnlockWallets(ctx context.Context, req *connect_go
 	return c.unlockWallets.CallUnary(ctx, req)
 }
 
+// LockWallet calls wallet.v1.WalletService.LockWallet.
+func (c *walletServiceClient) LockWallet(ctx context.Context, req *connect_go.Request[v1.LockWalletRequest]) (*connect_go.Response[v1.LockWalletResponse], error) {
+	return c.lockWallet.CallUnary(ctx, req)
+}
+
+// LockAll calls wallet.v1.WalletService.LockAll.
+func (c *walletServiceClient) LockAll(ctx context.Context, req *connect_go.Request[v1.LockAllRequest]) (*connect_go.Response[v1.LockAllResponse], error) {
+	return c.lockAll.CallUnary(ctx, req)
+}
+
+// GetSessionStatus calls wallet.v1.WalletService.GetSessionStatus.
+func (c *walletServiceClient) GetSessionStatus(ctx context.Context, req *connect_go.Request[v1.GetSessionStatusRequest]) (*connect_go.Response[v1.GetSessionStatusResponse], error) {
+	return c.getSessionStatus.CallUnary(ctx, req)
+}
+
+// ImportKey calls wallet.v1.WalletService.ImportKey.
+func (c *walletServiceClient) ImportKey(ctx context.Context, req *connect_go.Request[v1.ImportKeyRequest]) (*connect_go.Response[v1.ImportKeyResponse], error) {
+	return c.importKey.CallUnary(ctx, req)
+}
+
+// ImportKeystore calls wallet.v1.WalletService.ImportKeystore.
+func (c *walletServiceClient) ImportKeystore(ctx context.Context, req *connect_go.Request[v1.ImportKeystoreRequest]) (*connect_go.Response[v1.ImportKeystoreResponse], error) {
+	return c.importKeystore.CallUnary(ctx, req)
+}
+
+// ChangePassword calls wallet.v1.WalletService.ChangePassword.
+func (c *walletServiceClient) ChangePassword(ctx context.Context, req *connect_go.Request[v1.ChangePasswordRequest]) (*connect_go.Response[v1.ChangePasswordResponse], error) {
+	return c.changePassword.CallUnary(ctx, req)
+}
+
+// ExportKey calls wallet.v1.WalletService.ExportKey.
+func (c *walletServiceClient) ExportKey(ctx context.Context, req *connect_go.Request[v1.ExportKeyRequest]) (*connect_go.Response[v1.ExportKeyResponse], error) {
+	return c.exportKey.CallUnary(ctx, req)
+}
+
+// ConvertAddress calls wallet.v1.WalletService.ConvertAddress.
+func (c *walletServiceClient) ConvertAddress(ctx context.Context, req *connect_go.Request[v1.ConvertAddressRequest]) (*connect_go.Response[v1.ConvertAddressResponse], error) {
+	return c.convertAddress.CallUnary(ctx, req)
+}
+
 // WalletServiceHandler is an implementation of the wallet.v1.WalletService service.
 type WalletServiceHandler interface {
 	// Retrieves the detailed information for a single wallet.
@@ -174,11 +386,44 @@ type WalletServiceHandler interface {
 	// Creates a new cryptographic wallet and saves its metadata.
 	CreateWallet(context.Context, *connect_go.Request[v1.CreateWalletRequest]) (*connect_go.Response[v1.CreateWalletResponse], error)
 	RecoverWallet(context.Context, *connect_go.Request[v1.RecoverWalletRequest]) (*connect_go.Response[v1.RecoverWalletResponse], error)
+	// Splits the seed of a wallet into SLIP-39 shares, threshold of which
+	// recover it. Requires the wallet password and is audited.
+	CreateShareBackup(context.Context, *connect_go.Request[v1.CreateShareBackupRequest]) (*connect_go.Response[v1.CreateShareBackupResponse], error)
+	// Recovers a wallet from SLIP-39 shares instead of a seed phrase.
+	RecoverWalletFromShares(context.Context, *connect_go.Request[v1.RecoverWalletFromSharesRequest]) (*connect_go.Response[v1.RecoverWalletFromSharesResponse], error)
+	// Returns the seed phrase of a wallet after checking its password. Reveals
+	// are audited.
+	RevealSeedPhrase(context.Context, *connect_go.Request[v1.RevealSeedPhraseRequest]) (*connect_go.Response[v1.RevealSeedPhraseResponse], error)
+	// Picks random seed phrase positions for the user to fill in.
+	StartBackupVerification(context.Context, *connect_go.Request[v1.StartBackupVerificationRequest]) (*connect_go.Response[v1.StartBackupVerificationResponse], error)
+	// Checks the words of the pending challenge and marks the backup as
+	// verified. A wrong answer needs a new challenge.
+	VerifyBackup(context.Context, *connect_go.Request[v1.VerifyBackupRequest]) (*connect_go.Response[v1.VerifyBackupResponse], error)
 	// Updates mutable metadata associated with a wallet (name, default status).
 	UpdateWallet(context.Context, *connect_go.Request[v1.UpdateWalletRequest]) (*connect_go.Response[v1.UpdateWalletResponse], error)
 	// Permanently deletes or archives a wallet record.
 	DeleteWallet(context.Context, *connect_go.Request[v1.DeleteWalletRequest]) (*connect_go.Response[v1.DeleteWalletResponse], error)
+	// Unlocks every wallet that uses the given password and reports the
+	// outcome per wallet.
 	UnlockWallets(context.Context, *connect_go.Request[v1.UnlockWalletsRequest]) (*connect_go.Response[v1.UnlockWalletsResponse], error)
+	// Locks a single wallet.
+	LockWallet(context.Context, *connect_go.Request[v1.LockWalletRequest]) (*connect_go.Response[v1.LockWalletResponse], error)
+	// Locks every wallet.
+	LockAll(context.Context, *connect_go.Request[v1.LockAllRequest]) (*connect_go.Response[v1.LockAllResponse], error)
+	// Lists the unlocked wallets and when each one locks.
+	GetSessionStatus(context.Context, *connect_go.Request[v1.GetSessionStatusRequest]) (*connect_go.Response[v1.GetSessionStatusResponse], error)
+	// Creates a wallet from a single private key. It has no seed phrase.
+	ImportKey(context.Context, *connect_go.Request[v1.ImportKeyRequest]) (*connect_go.Response[v1.ImportKeyResponse], error)
+	// Creates a wallet from an Ethereum V3 keystore file.
+	ImportKeystore(context.Context, *connect_go.Request[v1.ImportKeystoreRequest]) (*connect_go.Response[v1.ImportKeystoreResponse], error)
+	// Re-encrypts one or all wallets under a new password.
+	ChangePassword(context.Context, *connect_go.Request[v1.ChangePasswordRequest]) (*connect_go.Response[v1.ChangePasswordResponse], error)
+	// Exports the private key of a wallet account. Attempts are audited and
+	// rate limited.
+	ExportKey(context.Context, *connect_go.Request[v1.ExportKeyRequest]) (*connect_go.Response[v1.ExportKeyResponse], error)
+	// Converts an address to all its equivalent forms, looking up chain state
+	// where needed.
+	ConvertAddress(context.Context, *connect_go.Request[v1.ConvertAddressRequest]) (*connect_go.Response[v1.ConvertAddressResponse], error)
 }
 
 // NewWalletServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -207,6 +452,31 @@ func NewWalletServiceHandler(svc WalletServiceHandler, opts ...connect_go.Handle
 		svc.RecoverWallet,
 		opts...,
 	)
+	walletServiceCreateShareBackupHandler := connect_go.NewUnaryHandler(
+		WalletServiceCreateShareBackupProcedure,
+		svc.CreateShareBackup,
+		opts...,
+	)
+	walletServiceRecoverWalletFromSharesHandler := connect_go.NewUnaryHandler(
+		WalletServiceRecoverWalletFromSharesProcedure,
+		svc.RecoverWalletFromShares,
+		opts...,
+	)
+	walletServiceRevealSeedPhraseHandler := connect_go.NewUnaryHandler(
+		WalletServiceRevealSeedPhraseProcedure,
+		svc.RevealSeedPhrase,
+		opts...,
+	)
+	walletServiceStartBackupVerificationHandler := connect_go.NewUnaryHandler(
+		WalletServiceStartBackupVerificationProcedure,
+		svc.StartBackupVerification,
+		opts...,
+	)
+	walletServiceVerifyBackupHandler := connect_go.NewUnaryHandler(
+		WalletServiceVerifyBackupProcedure,
+		svc.VerifyBackup,
+		opts...,
+	)
 	walletServiceUpdateWalletHandler := connect_go.NewUnaryHandler(
 		WalletServiceUpdateWalletProcedure,
 		svc.UpdateWallet,
@@ -222,6 +492,46 @@ func NewWalletServiceHandler(svc WalletServiceHandler, opts ...connect_go.Handle
 		svc.UnlockWallets,
 		opts...,
 	)
+	walletServiceLockWalletHandler := connect_go.NewUnaryHandler(
+		WalletServiceLockWalletProcedure,
+		svc.LockWallet,
+		opts...,
+	)
+	walletServiceLockAllHandler := connect_go.NewUnaryHandler(
+		WalletServiceLockAllProcedure,
+		svc.LockAll,
+		opts...,
+	)
+	walletServiceGetSessionStatusHandler := connect_go.NewUnaryHandler(
+		WalletServiceGetSessionStatusProcedure,
+		svc.GetSessionStatus,
+		opts...,
+	)
+	walletServiceImportKeyHandler := connect_go.NewUnaryHandler(
+		WalletServiceImportKeyProcedure,
+		svc.ImportKey,
+		opts...,
+	)
+	walletServiceImportKeystoreHandler := connect_go.NewUnaryHandler(
+		WalletServiceImportKeystoreProcedure,
+		svc.ImportKeystore,
+		opts...,
+	)
+	walletServiceChangePasswordHandler := connect_go.NewUnaryHandler(
+		WalletServiceChangePasswordProcedure,
+		svc.ChangePassword,
+		opts...,
+	)
+	walletServiceExportKeyHandler := connect_go.NewUnaryHandler(
+		WalletServiceExportKeyProcedure,
+		svc.ExportKey,
+		opts...,
+	)
+	walletServiceConvertAddressHandler := connect_go.NewUnaryHandler(
+		WalletServiceConvertAddressProcedure,
+		svc.ConvertAddress,
+		opts...,
+	)
 	return "/wallet.v1.WalletService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case WalletServiceGetWalletProcedure:
@@ -232,12 +542,38 @@ func NewWalletServiceHandler(svc WalletServiceHandler, opts ...connect_go.Handle
 			walletServiceCreateWalletHandler.ServeHTTP(w, r)
 		case WalletServiceRecoverWalletProcedure:
 			walletServiceRecoverWalletHandler.ServeHTTP(w, r)
+		case WalletServiceCreateShareBackupProcedure:
+			walletServiceCreateShareBackupHandler.ServeHTTP(w, r)
+		case WalletServiceRecoverWalletFromSharesProcedure:
+			walletServiceRecoverWalletFromSharesHandler.ServeHTTP(w, r)
+		case WalletServiceRevealSeedPhraseProcedure:
+			walletServiceRevealSeedPhraseHandler.ServeHTTP(w, r)
+		case WalletServiceStartBackupVerificationProcedure:
+			walletServiceStartBackupVerificationHandler.ServeHTTP(w, r)
+		case WalletServiceVerifyBackupProcedure:
+			walletServiceVerifyBackupHandler.ServeHTTP(w, r)
 		case WalletServiceUpdateWalletProcedure:
 			walletServiceUpdateWalletHandler.ServeHTTP(w, r)
 		case WalletServiceDeleteWalletProcedure:
 			walletServiceDeleteWalletHandler.ServeHTTP(w, r)
 		case WalletServiceUnlockWalletsProcedure:
 			walletServiceUnlockWalletsHandler.ServeHTTP(w, r)
+		case WalletServiceLockWalletProcedure:
+			walletServiceLockWalletHandler.ServeHTTP(w, r)
+		case WalletServiceLockAllProcedure:
+			walletServiceLockAllHandler.ServeHTTP(w, r)
+		case WalletServiceGetSessionStatusProcedure:
+			walletServiceGetSessionStatusHandler.ServeHTTP(w, r)
+		case WalletServiceImportKeyProcedure:
+			walletServiceImportKeyHandler.ServeHTTP(w, r)
+		case WalletServiceImportKeystoreProcedure:
+			walletServiceImportKeystoreHandler.ServeHTTP(w, r)
+		case WalletServiceChangePasswordProcedure:
+			walletServiceChangePasswordHandler.ServeHTTP(w, r)
+		case WalletServiceExportKeyProcedure:
+			walletServiceExportKeyHandler.ServeHTTP(w, r)
+		case WalletServiceConvertAddressProcedure:
+			walletServiceConvertAddressHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -263,6 +599,26 @@ func (UnimplementedWalletServiceHandler) RecoverWallet(context.Context, *connect
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("wallet.v1.WalletService.RecoverWallet is not implemented"))
 }
 
+func (UnimplementedWalletServiceHandler) CreateShareBackup(context.Context, *connect_go.Request[v1.CreateShareBackupRequest]) (*connect_go.Response[v1.CreateShareBackupResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("wallet.v1.WalletService.CreateShareBackup is not implemented"))
+}
+
+func (UnimplementedWalletServiceHandler) RecoverWalletFromShares(context.Context, *connect_go.Request[v1.RecoverWalletFromSharesRequest]) (*connect_go.Response[v1.RecoverWalletFromSharesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("wallet.v1.WalletService.RecoverWalletFromShares is not implemented"))
+}
+
+func (UnimplementedWalletServiceHandler) RevealSeedPhrase(context.Context, *connect_go.Request[v1.RevealSeedPhraseRequest]) (*connect_go.Response[v1.RevealSeedPhraseResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("wallet.v1.WalletService.RevealSeedPhrase is not implemented"))
+}
+
+func (UnimplementedWalletServiceHandler) StartBackupVerification(context.Context, *connect_go.Request[v1.StartBackupVerificationRequest]) (*connect_go.Response[v1.StartBackupVerificationResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("wallet.v1.WalletService.StartBackupVerification is not implemented"))
+}
+
+func (UnimplementedWalletServiceHandler) VerifyBackup(context.Context, *connect_go.Request[v1.VerifyBackupRequest]) (*connect_go.Response[v1.VerifyBackupResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("wallet.v1.WalletService.VerifyBackup is not implemented"))
+}
+
 func (UnimplementedWalletServiceHandler) UpdateWallet(context.Context, *connect_go.Request[v1.UpdateWalletRequest]) (*connect_go.Response[v1.UpdateWalletResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("wallet.v1.WalletService.UpdateWallet is not implemented"))
 }
@@ -274,3 +630,35 @@ func (UnimplementedWalletServiceHandler) DeleteWallet(context.Context, *connect_
 func (UnimplementedWalletServiceHandler) UnlockWallets(context.Context, *connect_go.Request[v1.UnlockWalletsRequest]) (*connect_go.Response[v1.UnlockWalletsResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("wallet.v1.WalletService.UnlockWallets is not implemented"))
 }
+
+func (UnimplementedWalletServiceHandler) LockWallet(context.Context, *connect_go.Request[v1.LockWalletRequest]) (*connect_go.Response[v1.LockWalletResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("wallet.v1.WalletService.LockWallet is not implemented"))
+}
+
+func (UnimplementedWalletServiceHandler) LockAll(context.Context, *connect_go.Request[v1.LockAllRequest]) (*connect_go.Response[v1.LockAllResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("wallet.v1.WalletService.LockAll is not implemented"))
+}
+
+func (UnimplementedWalletServiceHandler) GetSessionStatus(context.Context, *connect_go.Request[v1.GetSessionStatusRequest]) (*connect_go.Response[v1.GetSessionStatusResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("wallet.v1.WalletService.GetSessionStatus is not implemented"))
+}
+
+func (UnimplementedWalletServiceHandler) ImportKey(context.Context, *connect_go.Request[v1.ImportKeyRequest]) (*connect_go.Response[v1.ImportKeyResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("wallet.v1.WalletService.ImportKey is not implemented"))
+}
+
+func (UnimplementedWalletServiceHandler) ImportKeystore(context.Context, *connect_go.Request[v1.ImportKeystoreRequest]) (*connect_go.Response[v1.ImportKeystoreResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("wallet.v1.WalletService.ImportKeystore is not implemented"))
+}
+
+func (UnimplementedWalletServiceHandler) ChangePassword(context.Context, *connect_go.Request[v1.ChangePasswordRequest]) (*connect_go.Response[v1.ChangePasswordResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("wallet.v1.WalletService.ChangePassword is not implemented"))
+}
+
+func (UnimplementedWalletServiceHandler) ExportKey(context.Context, *connect_go.Request[v1.ExportKeyRequest]) (*connect_go.Response[v1.ExportKeyResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("wallet.v1.WalletService.ExportKey is not implemented"))
+}
+
+func (UnimplementedWalletServiceHandler) ConvertAddress(context.Context, *connect_go.Request[v1.ConvertAddressRequest]) (*connect_go.Response[v1.ConvertAddressResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("wallet.v1.WalletService.ConvertAddress is not implemented"))
+}
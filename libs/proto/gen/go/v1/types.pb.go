@@ -28,6 +28,9 @@ const (
 	AddressType_ADDRESS_TYPE_F1 AddressType = 0
 	AddressType_ADDRESS_TYPE_F4 AddressType = 1
 	AddressType_ADDRESS_TYPE_0X AddressType = 2
+	AddressType_ADDRESS_TYPE_F3 AddressType = 3
+	AddressType_ADDRESS_TYPE_F0 AddressType = 4
+	AddressType_ADDRESS_TYPE_F2 AddressType = 5
 )
 
 // Enum value maps for AddressType.
@@ -36,11 +39,17 @@ var (
 		0: "ADDRESS_TYPE_F1",
 		1: "ADDRESS_TYPE_F4",
 		2: "ADDRESS_TYPE_0X",
+		3: "ADDRESS_TYPE_F3",
+		4: "ADDRESS_TYPE_F0",
+		5: "ADDRESS_TYPE_F2",
 	}
 	AddressType_value = map[string]int32{
 		"ADDRESS_TYPE_F1": 0,
 		"ADDRESS_TYPE_F4": 1,
 		"ADDRESS_TYPE_0X": 2,
+		"ADDRESS_TYPE_F3": 3,
+		"ADDRESS_TYPE_F0": 4,
+		"ADDRESS_TYPE_F2": 5,
 	}
 )
 
@@ -71,6 +80,55 @@ func (AddressType) EnumDescriptor() ([]byte, []int) {
 	return file_v1_types_proto_rawDescGZIP(), []int{0}
 }
 
+type DerivationScheme int32
+
+const (
+	DerivationScheme_DERIVATION_SCHEME_LEGACY   DerivationScheme = 0
+	DerivationScheme_DERIVATION_SCHEME_BIP44    DerivationScheme = 1
+	DerivationScheme_DERIVATION_SCHEME_IMPORTED DerivationScheme = 2
+)
+
+// Enum value maps for DerivationScheme.
+var (
+	DerivationScheme_name = map[int32]string{
+		0: "DERIVATION_SCHEME_LEGACY",
+		1: "DERIVATION_SCHEME_BIP44",
+		2: "DERIVATION_SCHEME_IMPORTED",
+	}
+	DerivationScheme_value = map[string]int32{
+		"DERIVATION_SCHEME_LEGACY":   0,
+		"DERIVATION_SCHEME_BIP44":    1,
+		"DERIVATION_SCHEME_IMPORTED": 2,
+	}
+)
+
+func (x DerivationScheme) Enum() *DerivationScheme {
+	p := new(DerivationScheme)
+	*p = x
+	return p
+}
+
+func (x DerivationScheme) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DerivationScheme) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_types_proto_enumTypes[1].Descriptor()
+}
+
+func (DerivationScheme) Type() protoreflect.EnumType {
+	return &file_v1_types_proto_enumTypes[1]
+}
+
+func (x DerivationScheme) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DerivationScheme.Descriptor instead.
+func (DerivationScheme) EnumDescriptor() ([]byte, []int) {
+	return file_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
 type TransactionActionType int32
 
 const (
@@ -110,11 +168,11 @@ func (x TransactionActionType) String() string {
 }
 
 func (TransactionActionType) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_types_proto_enumTypes[1].Descriptor()
+	return file_v1_types_proto_enumTypes[2].Descriptor()
 }
 
 func (TransactionActionType) Type() protoreflect.EnumType {
-	return &file_v1_types_proto_enumTypes[1]
+	return &file_v1_types_proto_enumTypes[2]
 }
 
 func (x TransactionActionType) Number() protoreflect.EnumNumber {
@@ -123,7 +181,7 @@ func (x TransactionActionType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TransactionActionType.Descriptor instead.
 func (TransactionActionType) EnumDescriptor() ([]byte, []int) {
-	return file_v1_types_proto_rawDescGZIP(), []int{1}
+	return file_v1_types_proto_rawDescGZIP(), []int{2}
 }
 
 type TransactionStatusType int32
@@ -165,11 +223,11 @@ func (x TransactionStatusType) String() string {
 }
 
 func (TransactionStatusType) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_types_proto_enumTypes[2].Descriptor()
+	return file_v1_types_proto_enumTypes[3].Descriptor()
 }
 
 func (TransactionStatusType) Type() protoreflect.EnumType {
-	return &file_v1_types_proto_enumTypes[2]
+	return &file_v1_types_proto_enumTypes[3]
 }
 
 func (x TransactionStatusType) Number() protoreflect.EnumNumber {
@@ -178,7 +236,7 @@ func (x TransactionStatusType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TransactionStatusType.Descriptor instead.
 func (TransactionStatusType) EnumDescriptor() ([]byte, []int) {
-	return file_v1_types_proto_rawDescGZIP(), []int{2}
+	return file_v1_types_proto_rawDescGZIP(), []int{3}
 }
 
 type NetworkType int32
@@ -211,11 +269,11 @@ func (x NetworkType) String() string {
 }
 
 func (NetworkType) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_types_proto_enumTypes[3].Descriptor()
+	return file_v1_types_proto_enumTypes[4].Descriptor()
 }
 
 func (NetworkType) Type() protoreflect.EnumType {
-	return &file_v1_types_proto_enumTypes[3]
+	return &file_v1_types_proto_enumTypes[4]
 }
 
 func (x NetworkType) Number() protoreflect.EnumNumber {
@@ -224,13 +282,15 @@ func (x NetworkType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NetworkType.Descriptor instead.
 func (NetworkType) EnumDescriptor() ([]byte, []int) {
-	return file_v1_types_proto_rawDescGZIP(), []int{3}
+	return file_v1_types_proto_rawDescGZIP(), []int{4}
 }
 
 type Address struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          AddressType            `protobuf:"varint,1,opt,name=type,proto3,enum=wallet.v1.AddressType" json:"type,omitempty"`
-	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  AddressType            `protobuf:"varint,1,opt,name=type,proto3,enum=wallet.v1.AddressType" json:"type,omitempty"`
+	Value string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// The f0 ID address, empty until the actor exists on chain.
+	ActorId       string `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -279,6 +339,13 @@ func (x *Address) GetValue() string {
 	return ""
 }
 
+func (x *Address) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
 type Amount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
@@ -340,15 +407,19 @@ func (x *Amount) GetDecimals() uint32 {
 }
 
 type Wallet struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	WalletId      int64                  `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
-	IsDefault     bool                   `protobuf:"varint,2,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Addresses     []*Address             `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
-	Balance       *Amount                `protobuf:"bytes,5,opt,name=balance,proto3" json:"balance,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	WalletId  int64                  `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	IsDefault bool                   `protobuf:"varint,2,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Addresses []*Address             `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Balance   *Amount                `protobuf:"bytes,5,opt,name=balance,proto3" json:"balance,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The f0 ID of the primary f1 address, empty until it exists on chain.
+	ActorId string `protobuf:"bytes,7,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	// When the user last confirmed holding the seed phrase, unset until then.
+	BackupVerifiedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=backup_verified_at,json=backupVerifiedAt,proto3,oneof" json:"backup_verified_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Wallet) Reset() {
@@ -423,6 +494,177 @@ func (x *Wallet) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Wallet) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *Wallet) GetBackupVerifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BackupVerifiedAt
+	}
+	return nil
+}
+
+// An unsigned Filecoin message with its gas already estimated.
+type UnsignedMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Value         *Amount                `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Nonce         uint64                 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	GasLimit      int64                  `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	GasFeeCap     string                 `protobuf:"bytes,6,opt,name=gas_fee_cap,json=gasFeeCap,proto3" json:"gas_fee_cap,omitempty"`
+	GasPremium    string                 `protobuf:"bytes,7,opt,name=gas_premium,json=gasPremium,proto3" json:"gas_premium,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsignedMessage) Reset() {
+	*x = UnsignedMessage{}
+	mi := &file_v1_types_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsignedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsignedMessage) ProtoMessage() {}
+
+func (x *UnsignedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_types_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsignedMessage.ProtoReflect.Descriptor instead.
+func (*UnsignedMessage) Descriptor() ([]byte, []int) {
+	return file_v1_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UnsignedMessage) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *UnsignedMessage) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *UnsignedMessage) GetValue() *Amount {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *UnsignedMessage) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *UnsignedMessage) GetGasLimit() int64 {
+	if x != nil {
+		return x.GasLimit
+	}
+	return 0
+}
+
+func (x *UnsignedMessage) GetGasFeeCap() string {
+	if x != nil {
+		return x.GasFeeCap
+	}
+	return ""
+}
+
+func (x *UnsignedMessage) GetGasPremium() string {
+	if x != nil {
+		return x.GasPremium
+	}
+	return ""
+}
+
+// A Filecoin message with its signature, ready to be pushed to the mpool.
+type SignedMessage struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Message *UnsignedMessage       `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Cid     string                 `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	// The signature data, its type follows from message.from: secp256k1 over
+	// the message CID for f1, BLS over the CID for f3 and secp256k1 over the
+	// Keccak-256 hash of the equivalent Ethereum transaction for f4 senders.
+	Signature     []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignedMessage) Reset() {
+	*x = SignedMessage{}
+	mi := &file_v1_types_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedMessage) ProtoMessage() {}
+
+func (x *SignedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_types_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedMessage.ProtoReflect.Descriptor instead.
+func (*SignedMessage) Descriptor() ([]byte, []int) {
+	return file_v1_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SignedMessage) GetMessage() *UnsignedMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *SignedMessage) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *SignedMessage) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
 type TransactionType struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Type          TransactionActionType  `protobuf:"varint,1,opt,name=type,proto3,enum=wallet.v1.TransactionActionType" json:"type,omitempty"`
@@ -433,7 +675,7 @@ type TransactionType struct {
 
 func (x *TransactionType) Reset() {
 	*x = TransactionType{}
-	mi := &file_v1_types_proto_msgTypes[3]
+	mi := &file_v1_types_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -445,7 +687,7 @@ func (x *TransactionType) String() string {
 func (*TransactionType) ProtoMessage() {}
 
 func (x *TransactionType) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_types_proto_msgTypes[3]
+	mi := &file_v1_types_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -458,7 +700,7 @@ func (x *TransactionType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionType.ProtoReflect.Descriptor instead.
 func (*TransactionType) Descriptor() ([]byte, []int) {
-	return file_v1_types_proto_rawDescGZIP(), []int{3}
+	return file_v1_types_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *TransactionType) GetType() TransactionActionType {
@@ -487,7 +729,7 @@ type TransactionStatus struct {
 
 func (x *TransactionStatus) Reset() {
 	*x = TransactionStatus{}
-	mi := &file_v1_types_proto_msgTypes[4]
+	mi := &file_v1_types_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -499,7 +741,7 @@ func (x *TransactionStatus) String() string {
 func (*TransactionStatus) ProtoMessage() {}
 
 func (x *TransactionStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_types_proto_msgTypes[4]
+	mi := &file_v1_types_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -512,7 +754,7 @@ func (x *TransactionStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionStatus.ProtoReflect.Descriptor instead.
 func (*TransactionStatus) Descriptor() ([]byte, []int) {
-	return file_v1_types_proto_rawDescGZIP(), []int{4}
+	return file_v1_types_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *TransactionStatus) GetType() TransactionStatusType {
@@ -560,7 +802,7 @@ type Transaction struct {
 
 func (x *Transaction) Reset() {
 	*x = Transaction{}
-	mi := &file_v1_types_proto_msgTypes[5]
+	mi := &file_v1_types_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -572,7 +814,7 @@ func (x *Transaction) String() string {
 func (*Transaction) ProtoMessage() {}
 
 func (x *Transaction) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_types_proto_msgTypes[5]
+	mi := &file_v1_types_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -585,7 +827,7 @@ func (x *Transaction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
 func (*Transaction) Descriptor() ([]byte, []int) {
-	return file_v1_types_proto_rawDescGZIP(), []int{5}
+	return file_v1_types_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Transaction) GetId() string {
@@ -660,7 +902,7 @@ type Settings struct {
 
 func (x *Settings) Reset() {
 	*x = Settings{}
-	mi := &file_v1_types_proto_msgTypes[6]
+	mi := &file_v1_types_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -672,7 +914,7 @@ func (x *Settings) String() string {
 func (*Settings) ProtoMessage() {}
 
 func (x *Settings) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_types_proto_msgTypes[6]
+	mi := &file_v1_types_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -685,7 +927,7 @@ func (x *Settings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Settings.ProtoReflect.Descriptor instead.
 func (*Settings) Descriptor() ([]byte, []int) {
-	return file_v1_types_proto_rawDescGZIP(), []int{6}
+	return file_v1_types_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Settings) GetNetwork() NetworkType {
@@ -699,14 +941,15 @@ var File_v1_types_proto protoreflect.FileDescriptor
 
 const file_v1_types_proto_rawDesc = "" +
 	"\n" +
-	"\x0ev1/types.proto\x12\twallet.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"K\n" +
+	"\x0ev1/types.proto\x12\twallet.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"f\n" +
 	"\aAddress\x12*\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x16.wallet.v1.AddressTypeR\x04type\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value\"R\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\"R\n" +
 	"\x06Amount\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12\x16\n" +
 	"\x06ticker\x18\x02 \x01(\tR\x06ticker\x12\x1a\n" +
-	"\bdecimals\x18\x03 \x01(\rR\bdecimals\"\xf2\x01\n" +
+	"\bdecimals\x18\x03 \x01(\rR\bdecimals\"\xf3\x02\n" +
 	"\x06Wallet\x12\x1b\n" +
 	"\twallet_id\x18\x01 \x01(\x03R\bwalletId\x12\x1d\n" +
 	"\n" +
@@ -715,7 +958,23 @@ const file_v1_types_proto_rawDesc = "" +
 	"\taddresses\x18\x04 \x03(\v2\x12.wallet.v1.AddressR\taddresses\x12+\n" +
 	"\abalance\x18\x05 \x01(\v2\x11.wallet.v1.AmountR\abalance\x129\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"]\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x19\n" +
+	"\bactor_id\x18\a \x01(\tR\aactorId\x12M\n" +
+	"\x12backup_verified_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x10backupVerifiedAt\x88\x01\x01B\x15\n" +
+	"\x13_backup_verified_at\"\xd2\x01\n" +
+	"\x0fUnsignedMessage\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12'\n" +
+	"\x05value\x18\x03 \x01(\v2\x11.wallet.v1.AmountR\x05value\x12\x14\n" +
+	"\x05nonce\x18\x04 \x01(\x04R\x05nonce\x12\x1b\n" +
+	"\tgas_limit\x18\x05 \x01(\x03R\bgasLimit\x12\x1e\n" +
+	"\vgas_fee_cap\x18\x06 \x01(\tR\tgasFeeCap\x12\x1f\n" +
+	"\vgas_premium\x18\a \x01(\tR\n" +
+	"gasPremium\"u\n" +
+	"\rSignedMessage\x124\n" +
+	"\amessage\x18\x01 \x01(\v2\x1a.wallet.v1.UnsignedMessageR\amessage\x12\x10\n" +
+	"\x03cid\x18\x02 \x01(\tR\x03cid\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\fR\tsignature\"]\n" +
 	"\x0fTransactionType\x124\n" +
 	"\x04type\x18\x01 \x01(\x0e2 .wallet.v1.TransactionActionTypeR\x04type\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value\"\xac\x01\n" +
@@ -737,11 +996,18 @@ const file_v1_types_proto_rawDesc = "" +
 	"\vconfirmed_t\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"confirmedT\"<\n" +
 	"\bSettings\x120\n" +
-	"\anetwork\x18\x01 \x01(\x0e2\x16.wallet.v1.NetworkTypeR\anetwork*L\n" +
+	"\anetwork\x18\x01 \x01(\x0e2\x16.wallet.v1.NetworkTypeR\anetwork*\x8b\x01\n" +
 	"\vAddressType\x12\x13\n" +
 	"\x0fADDRESS_TYPE_F1\x10\x00\x12\x13\n" +
 	"\x0fADDRESS_TYPE_F4\x10\x01\x12\x13\n" +
-	"\x0fADDRESS_TYPE_0X\x10\x02*\xa7\x01\n" +
+	"\x0fADDRESS_TYPE_0X\x10\x02\x12\x13\n" +
+	"\x0fADDRESS_TYPE_F3\x10\x03\x12\x13\n" +
+	"\x0fADDRESS_TYPE_F0\x10\x04\x12\x13\n" +
+	"\x0fADDRESS_TYPE_F2\x10\x05*m\n" +
+	"\x10DerivationScheme\x12\x1c\n" +
+	"\x18DERIVATION_SCHEME_LEGACY\x10\x00\x12\x1b\n" +
+	"\x17DERIVATION_SCHEME_BIP44\x10\x01\x12\x1e\n" +
+	"\x1aDERIVATION_SCHEME_IMPORTED\x10\x02*\xa7\x01\n" +
 	"\x15TransactionActionType\x12\x1c\n" +
 	"\x18TRANSACTION_TYPE_UNKNOWN\x10\x00\x12\x19\n" +
 	"\x15TRANSACTION_TYPE_SEND\x10\x01\x12\x1c\n" +
@@ -770,43 +1036,49 @@ func file_v1_types_proto_rawDescGZIP() []byte {
 	return file_v1_types_proto_rawDescData
 }
 
-var file_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_v1_types_proto_goTypes = []any{
 	(AddressType)(0),              // 0: wallet.v1.AddressType
-	(TransactionActionType)(0),    // 1: wallet.v1.TransactionActionType
-	(TransactionStatusType)(0),    // 2: wallet.v1.TransactionStatusType
-	(NetworkType)(0),              // 3: wallet.v1.NetworkType
-	(*Address)(nil),               // 4: wallet.v1.Address
-	(*Amount)(nil),                // 5: wallet.v1.Amount
-	(*Wallet)(nil),                // 6: wallet.v1.Wallet
-	(*TransactionType)(nil),       // 7: wallet.v1.TransactionType
-	(*TransactionStatus)(nil),     // 8: wallet.v1.TransactionStatus
-	(*Transaction)(nil),           // 9: wallet.v1.Transaction
-	(*Settings)(nil),              // 10: wallet.v1.Settings
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(DerivationScheme)(0),         // 1: wallet.v1.DerivationScheme
+	(TransactionActionType)(0),    // 2: wallet.v1.TransactionActionType
+	(TransactionStatusType)(0),    // 3: wallet.v1.TransactionStatusType
+	(NetworkType)(0),              // 4: wallet.v1.NetworkType
+	(*Address)(nil),               // 5: wallet.v1.Address
+	(*Amount)(nil),                // 6: wallet.v1.Amount
+	(*Wallet)(nil),                // 7: wallet.v1.Wallet
+	(*UnsignedMessage)(nil),       // 8: wallet.v1.UnsignedMessage
+	(*SignedMessage)(nil),         // 9: wallet.v1.SignedMessage
+	(*TransactionType)(nil),       // 10: wallet.v1.TransactionType
+	(*TransactionStatus)(nil),     // 11: wallet.v1.TransactionStatus
+	(*Transaction)(nil),           // 12: wallet.v1.Transaction
+	(*Settings)(nil),              // 13: wallet.v1.Settings
+	(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
 }
 var file_v1_types_proto_depIdxs = []int32{
 	0,  // 0: wallet.v1.Address.type:type_name -> wallet.v1.AddressType
-	4,  // 1: wallet.v1.Wallet.addresses:type_name -> wallet.v1.Address
-	5,  // 2: wallet.v1.Wallet.balance:type_name -> wallet.v1.Amount
-	11, // 3: wallet.v1.Wallet.created_at:type_name -> google.protobuf.Timestamp
-	1,  // 4: wallet.v1.TransactionType.type:type_name -> wallet.v1.TransactionActionType
-	2,  // 5: wallet.v1.TransactionStatus.type:type_name -> wallet.v1.TransactionStatusType
-	7,  // 6: wallet.v1.Transaction.type:type_name -> wallet.v1.TransactionType
-	8,  // 7: wallet.v1.Transaction.status:type_name -> wallet.v1.TransactionStatus
-	5,  // 8: wallet.v1.Transaction.amount:type_name -> wallet.v1.Amount
-	4,  // 9: wallet.v1.Transaction.source_address:type_name -> wallet.v1.Address
-	4,  // 10: wallet.v1.Transaction.destination_address:type_name -> wallet.v1.Address
-	5,  // 11: wallet.v1.Transaction.fee:type_name -> wallet.v1.Amount
-	11, // 12: wallet.v1.Transaction.created_at:type_name -> google.protobuf.Timestamp
-	11, // 13: wallet.v1.Transaction.confirmed_t:type_name -> google.protobuf.Timestamp
-	3,  // 14: wallet.v1.Settings.network:type_name -> wallet.v1.NetworkType
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	5,  // 1: wallet.v1.Wallet.addresses:type_name -> wallet.v1.Address
+	6,  // 2: wallet.v1.Wallet.balance:type_name -> wallet.v1.Amount
+	14, // 3: wallet.v1.Wallet.created_at:type_name -> google.protobuf.Timestamp
+	14, // 4: wallet.v1.Wallet.backup_verified_at:type_name -> google.protobuf.Timestamp
+	6,  // 5: wallet.v1.UnsignedMessage.value:type_name -> wallet.v1.Amount
+	8,  // 6: wallet.v1.SignedMessage.message:type_name -> wallet.v1.UnsignedMessage
+	2,  // 7: wallet.v1.TransactionType.type:type_name -> wallet.v1.TransactionActionType
+	3,  // 8: wallet.v1.TransactionStatus.type:type_name -> wallet.v1.TransactionStatusType
+	10, // 9: wallet.v1.Transaction.type:type_name -> wallet.v1.TransactionType
+	11, // 10: wallet.v1.Transaction.status:type_name -> wallet.v1.TransactionStatus
+	6,  // 11: wallet.v1.Transaction.amount:type_name -> wallet.v1.Amount
+	5,  // 12: wallet.v1.Transaction.source_address:type_name -> wallet.v1.Address
+	5,  // 13: wallet.v1.Transaction.destination_address:type_name -> wallet.v1.Address
+	6,  // 14: wallet.v1.Transaction.fee:type_name -> wallet.v1.Amount
+	14, // 15: wallet.v1.Transaction.created_at:type_name -> google.protobuf.Timestamp
+	14, // 16: wallet.v1.Transaction.confirmed_t:type_name -> google.protobuf.Timestamp
+	4,  // 17: wallet.v1.Settings.network:type_name -> wallet.v1.NetworkType
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_v1_types_proto_init() }
@@ -814,13 +1086,14 @@ func file_v1_types_proto_init() {
 	if File_v1_types_proto != nil {
 		return
 	}
+	file_v1_types_proto_msgTypes[2].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_types_proto_rawDesc), len(file_v1_types_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   7,
+			NumEnums:      5,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -22,6 +22,180 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// BIP39 wordlist of a seed phrase.
+type MnemonicLanguage int32
+
+const (
+	// English when creating a wallet, detected when recovering one.
+	MnemonicLanguage_MNEMONIC_LANGUAGE_UNSPECIFIED         MnemonicLanguage = 0
+	MnemonicLanguage_MNEMONIC_LANGUAGE_ENGLISH             MnemonicLanguage = 1
+	MnemonicLanguage_MNEMONIC_LANGUAGE_CHINESE_SIMPLIFIED  MnemonicLanguage = 2
+	MnemonicLanguage_MNEMONIC_LANGUAGE_CHINESE_TRADITIONAL MnemonicLanguage = 3
+	MnemonicLanguage_MNEMONIC_LANGUAGE_CZECH               MnemonicLanguage = 4
+	MnemonicLanguage_MNEMONIC_LANGUAGE_FRENCH              MnemonicLanguage = 5
+	MnemonicLanguage_MNEMONIC_LANGUAGE_ITALIAN             MnemonicLanguage = 6
+	MnemonicLanguage_MNEMONIC_LANGUAGE_JAPANESE            MnemonicLanguage = 7
+	MnemonicLanguage_MNEMONIC_LANGUAGE_KOREAN              MnemonicLanguage = 8
+	MnemonicLanguage_MNEMONIC_LANGUAGE_SPANISH             MnemonicLanguage = 9
+)
+
+// Enum value maps for MnemonicLanguage.
+var (
+	MnemonicLanguage_name = map[int32]string{
+		0: "MNEMONIC_LANGUAGE_UNSPECIFIED",
+		1: "MNEMONIC_LANGUAGE_ENGLISH",
+		2: "MNEMONIC_LANGUAGE_CHINESE_SIMPLIFIED",
+		3: "MNEMONIC_LANGUAGE_CHINESE_TRADITIONAL",
+		4: "MNEMONIC_LANGUAGE_CZECH",
+		5: "MNEMONIC_LANGUAGE_FRENCH",
+		6: "MNEMONIC_LANGUAGE_ITALIAN",
+		7: "MNEMONIC_LANGUAGE_JAPANESE",
+		8: "MNEMONIC_LANGUAGE_KOREAN",
+		9: "MNEMONIC_LANGUAGE_SPANISH",
+	}
+	MnemonicLanguage_value = map[string]int32{
+		"MNEMONIC_LANGUAGE_UNSPECIFIED":         0,
+		"MNEMONIC_LANGUAGE_ENGLISH":             1,
+		"MNEMONIC_LANGUAGE_CHINESE_SIMPLIFIED":  2,
+		"MNEMONIC_LANGUAGE_CHINESE_TRADITIONAL": 3,
+		"MNEMONIC_LANGUAGE_CZECH":               4,
+		"MNEMONIC_LANGUAGE_FRENCH":              5,
+		"MNEMONIC_LANGUAGE_ITALIAN":             6,
+		"MNEMONIC_LANGUAGE_JAPANESE":            7,
+		"MNEMONIC_LANGUAGE_KOREAN":              8,
+		"MNEMONIC_LANGUAGE_SPANISH":             9,
+	}
+)
+
+func (x MnemonicLanguage) Enum() *MnemonicLanguage {
+	p := new(MnemonicLanguage)
+	*p = x
+	return p
+}
+
+func (x MnemonicLanguage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MnemonicLanguage) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_wallet_proto_enumTypes[0].Descriptor()
+}
+
+func (MnemonicLanguage) Type() protoreflect.EnumType {
+	return &file_v1_wallet_proto_enumTypes[0]
+}
+
+func (x MnemonicLanguage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MnemonicLanguage.Descriptor instead.
+func (MnemonicLanguage) EnumDescriptor() ([]byte, []int) {
+	return file_v1_wallet_proto_rawDescGZIP(), []int{0}
+}
+
+type UnlockStatus int32
+
+const (
+	UnlockStatus_UNLOCK_STATUS_UNLOCKED       UnlockStatus = 0
+	UnlockStatus_UNLOCK_STATUS_WRONG_PASSWORD UnlockStatus = 1
+	// The password was not the problem, the stored key could not be decrypted.
+	UnlockStatus_UNLOCK_STATUS_CORRUPTED UnlockStatus = 2
+	// Not tried, the wallet is backing off after too many wrong passwords.
+	UnlockStatus_UNLOCK_STATUS_LOCKED_OUT UnlockStatus = 3
+)
+
+// Enum value maps for UnlockStatus.
+var (
+	UnlockStatus_name = map[int32]string{
+		0: "UNLOCK_STATUS_UNLOCKED",
+		1: "UNLOCK_STATUS_WRONG_PASSWORD",
+		2: "UNLOCK_STATUS_CORRUPTED",
+		3: "UNLOCK_STATUS_LOCKED_OUT",
+	}
+	UnlockStatus_value = map[string]int32{
+		"UNLOCK_STATUS_UNLOCKED":       0,
+		"UNLOCK_STATUS_WRONG_PASSWORD": 1,
+		"UNLOCK_STATUS_CORRUPTED":      2,
+		"UNLOCK_STATUS_LOCKED_OUT":     3,
+	}
+)
+
+func (x UnlockStatus) Enum() *UnlockStatus {
+	p := new(UnlockStatus)
+	*p = x
+	return p
+}
+
+func (x UnlockStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnlockStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_wallet_proto_enumTypes[1].Descriptor()
+}
+
+func (UnlockStatus) Type() protoreflect.EnumType {
+	return &file_v1_wallet_proto_enumTypes[1]
+}
+
+func (x UnlockStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnlockStatus.Descriptor instead.
+func (UnlockStatus) EnumDescriptor() ([]byte, []int) {
+	return file_v1_wallet_proto_rawDescGZIP(), []int{1}
+}
+
+type KeyExportFormat int32
+
+const (
+	// Hex KeyInfo for `lotus wallet import`.
+	KeyExportFormat_KEY_EXPORT_FORMAT_KEY_INFO KeyExportFormat = 0
+	// Ethereum V3 keystore JSON, encrypted under export_password.
+	KeyExportFormat_KEY_EXPORT_FORMAT_KEYSTORE KeyExportFormat = 1
+)
+
+// Enum value maps for KeyExportFormat.
+var (
+	KeyExportFormat_name = map[int32]string{
+		0: "KEY_EXPORT_FORMAT_KEY_INFO",
+		1: "KEY_EXPORT_FORMAT_KEYSTORE",
+	}
+	KeyExportFormat_value = map[string]int32{
+		"KEY_EXPORT_FORMAT_KEY_INFO": 0,
+		"KEY_EXPORT_FORMAT_KEYSTORE": 1,
+	}
+)
+
+func (x KeyExportFormat) Enum() *KeyExportFormat {
+	p := new(KeyExportFormat)
+	*p = x
+	return p
+}
+
+func (x KeyExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KeyExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_wallet_proto_enumTypes[2].Descriptor()
+}
+
+func (KeyExportFormat) Type() protoreflect.EnumType {
+	return &file_v1_wallet_proto_enumTypes[2]
+}
+
+func (x KeyExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KeyExportFormat.Descriptor instead.
+func (KeyExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_v1_wallet_proto_rawDescGZIP(), []int{2}
+}
+
 type GetWalletRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	WalletId      int64                  `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
@@ -74,6 +248,7 @@ type GetWalletResponse struct {
 	Addresses     map[string]string      `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	Balance       *Amount                `protobuf:"bytes,5,opt,name=balance,proto3" json:"balance,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ActorId       string                 `protobuf:"bytes,7,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -150,6 +325,13 @@ func (x *GetWalletResponse) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *GetWalletResponse) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
 type GetWalletsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Wallet        *Wallet                `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
@@ -243,8 +425,13 @@ type CreateWalletRequest struct {
 	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Password        string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	ConfirmPassword string                 `protobuf:"bytes,3,opt,name=confirm_password,json=confirmPassword,proto3" json:"confirm_password,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Optional BIP39 passphrase, the "25th word" of the seed.
+	Passphrase string `protobuf:"bytes,4,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	// 12 or 24, 12 when unset.
+	WordCount     uint32           `protobuf:"varint,5,opt,name=word_count,json=wordCount,proto3" json:"word_count,omitempty"`
+	Language      MnemonicLanguage `protobuf:"varint,6,opt,name=language,proto3,enum=wallet.v1.MnemonicLanguage" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateWalletRequest) Reset() {
@@ -298,6 +485,27 @@ func (x *CreateWalletRequest) GetConfirmPassword() string {
 	return ""
 }
 
+func (x *CreateWalletRequest) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+func (x *CreateWalletRequest) GetWordCount() uint32 {
+	if x != nil {
+		return x.WordCount
+	}
+	return 0
+}
+
+func (x *CreateWalletRequest) GetLanguage() MnemonicLanguage {
+	if x != nil {
+		return x.Language
+	}
+	return MnemonicLanguage_MNEMONIC_LANGUAGE_UNSPECIFIED
+}
+
 type CreateWalletResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -364,8 +572,11 @@ type RecoverWalletRequest struct {
 	SeedPhrase      string                 `protobuf:"bytes,2,opt,name=seed_phrase,json=seedPhrase,proto3" json:"seed_phrase,omitempty"`
 	Password        string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
 	ConfirmPassword string                 `protobuf:"bytes,4,opt,name=confirm_password,json=confirmPassword,proto3" json:"confirm_password,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// BIP39 passphrase the seed was created with, empty for none.
+	Passphrase    string           `protobuf:"bytes,5,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Language      MnemonicLanguage `protobuf:"varint,6,opt,name=language,proto3,enum=wallet.v1.MnemonicLanguage" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RecoverWalletRequest) Reset() {
@@ -426,6 +637,20 @@ func (x *RecoverWalletRequest) GetConfirmPassword() string {
 	return ""
 }
 
+func (x *RecoverWalletRequest) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+func (x *RecoverWalletRequest) GetLanguage() MnemonicLanguage {
+	if x != nil {
+		return x.Language
+	}
+	return MnemonicLanguage_MNEMONIC_LANGUAGE_UNSPECIFIED
+}
+
 type RecoverWalletResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	WalletId      int64                  `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
@@ -470,27 +695,32 @@ func (x *RecoverWalletResponse) GetWalletId() int64 {
 	return 0
 }
 
-type UpdateWalletRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	WalletId      int64                  `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+type CreateShareBackupRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	WalletId int64                  `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Password string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// Number of shares needed to recover the wallet.
+	Threshold uint32 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// Number of shares to create, at most 16.
+	ShareCount    uint32 `protobuf:"varint,4,opt,name=share_count,json=shareCount,proto3" json:"share_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateWalletRequest) Reset() {
-	*x = UpdateWalletRequest{}
+func (x *CreateShareBackupRequest) Reset() {
+	*x = CreateShareBackupRequest{}
 	mi := &file_v1_wallet_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateWalletRequest) String() string {
+func (x *CreateShareBackupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateWalletRequest) ProtoMessage() {}
+func (*CreateShareBackupRequest) ProtoMessage() {}
 
-func (x *UpdateWalletRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateShareBackupRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_wallet_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -502,39 +732,66 @@ func (x *UpdateWalletRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateWalletRequest.ProtoReflect.Descriptor instead.
-func (*UpdateWalletRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateShareBackupRequest.ProtoReflect.Descriptor instead.
+func (*CreateShareBackupRequest) Descriptor() ([]byte, []int) {
 	return file_v1_wallet_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UpdateWalletRequest) GetWalletId() int64 {
+func (x *CreateShareBackupRequest) GetWalletId() int64 {
 	if x != nil {
 		return x.WalletId
 	}
 	return 0
 }
 
-type UpdateWalletResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Wallet        *Wallet                `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
+func (x *CreateShareBackupRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreateShareBackupRequest) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *CreateShareBackupRequest) GetShareCount() uint32 {
+	if x != nil {
+		return x.ShareCount
+	}
+	return 0
+}
+
+type CreateShareBackupResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// SLIP-39 mnemonics, one per share.
+	Shares []string `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+	// Wordlist of the seed. Recovery needs it, along with the BIP39
+	// passphrase, which is not part of the shares.
+	Language MnemonicLanguage `protobuf:"varint,2,opt,name=language,proto3,enum=wallet.v1.MnemonicLanguage" json:"language,omitempty"`
+	// Whether the seed also needs a BIP39 passphrase to recover the wallet.
+	HasPassphrase bool `protobuf:"varint,3,opt,name=has_passphrase,json=hasPassphrase,proto3" json:"has_passphrase,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateWalletResponse) Reset() {
-	*x = UpdateWalletResponse{}
+func (x *CreateShareBackupResponse) Reset() {
+	*x = CreateShareBackupResponse{}
 	mi := &file_v1_wallet_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateWalletResponse) String() string {
+func (x *CreateShareBackupResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateWalletResponse) ProtoMessage() {}
+func (*CreateShareBackupResponse) ProtoMessage() {}
 
-func (x *UpdateWalletResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateShareBackupResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_wallet_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -546,40 +803,60 @@ func (x *UpdateWalletResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateWalletResponse.ProtoReflect.Descriptor instead.
-func (*UpdateWalletResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateShareBackupResponse.ProtoReflect.Descriptor instead.
+func (*CreateShareBackupResponse) Descriptor() ([]byte, []int) {
 	return file_v1_wallet_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *UpdateWalletResponse) GetWallet() *Wallet {
+func (x *CreateShareBackupResponse) GetShares() []string {
 	if x != nil {
-		return x.Wallet
+		return x.Shares
 	}
 	return nil
 }
 
-type DeleteWalletRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	WalletId      int64                  `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+func (x *CreateShareBackupResponse) GetLanguage() MnemonicLanguage {
+	if x != nil {
+		return x.Language
+	}
+	return MnemonicLanguage_MNEMONIC_LANGUAGE_UNSPECIFIED
+}
+
+func (x *CreateShareBackupResponse) GetHasPassphrase() bool {
+	if x != nil {
+		return x.HasPassphrase
+	}
+	return false
+}
+
+type RecoverWalletFromSharesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	WalletName      string                 `protobuf:"bytes,1,opt,name=wallet_name,json=walletName,proto3" json:"wallet_name,omitempty"`
+	Shares          []string               `protobuf:"bytes,2,rep,name=shares,proto3" json:"shares,omitempty"`
+	Password        string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	ConfirmPassword string                 `protobuf:"bytes,4,opt,name=confirm_password,json=confirmPassword,proto3" json:"confirm_password,omitempty"`
+	// BIP39 passphrase the seed was created with, empty for none.
+	Passphrase string `protobuf:"bytes,5,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	// Wordlist of the seed, English when unspecified.
+	Language      MnemonicLanguage `protobuf:"varint,6,opt,name=language,proto3,enum=wallet.v1.MnemonicLanguage" json:"language,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteWalletRequest) Reset() {
-	*x = DeleteWalletRequest{}
+func (x *RecoverWalletFromSharesRequest) Reset() {
+	*x = RecoverWalletFromSharesRequest{}
 	mi := &file_v1_wallet_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteWalletRequest) String() string {
+func (x *RecoverWalletFromSharesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteWalletRequest) ProtoMessage() {}
+func (*RecoverWalletFromSharesRequest) ProtoMessage() {}
 
-func (x *DeleteWalletRequest) ProtoReflect() protoreflect.Message {
+func (x *RecoverWalletFromSharesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_wallet_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -591,45 +868,74 @@ func (x *DeleteWalletRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteWalletRequest.ProtoReflect.Descriptor instead.
-func (*DeleteWalletRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecoverWalletFromSharesRequest.ProtoReflect.Descriptor instead.
+func (*RecoverWalletFromSharesRequest) Descriptor() ([]byte, []int) {
 	return file_v1_wallet_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DeleteWalletRequest) GetWalletId() int64 {
+func (x *RecoverWalletFromSharesRequest) GetWalletName() string {
 	if x != nil {
-		return x.WalletId
+		return x.WalletName
 	}
-	return 0
+	return ""
 }
 
-func (x *DeleteWalletRequest) GetPassword() string {
+func (x *RecoverWalletFromSharesRequest) GetShares() []string {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+func (x *RecoverWalletFromSharesRequest) GetPassword() string {
 	if x != nil {
 		return x.Password
 	}
 	return ""
 }
 
-type DeleteWalletResponse struct {
+func (x *RecoverWalletFromSharesRequest) GetConfirmPassword() string {
+	if x != nil {
+		return x.ConfirmPassword
+	}
+	return ""
+}
+
+func (x *RecoverWalletFromSharesRequest) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+func (x *RecoverWalletFromSharesRequest) GetLanguage() MnemonicLanguage {
+	if x != nil {
+		return x.Language
+	}
+	return MnemonicLanguage_MNEMONIC_LANGUAGE_UNSPECIFIED
+}
+
+type RecoverWalletFromSharesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletId      int64                  `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteWalletResponse) Reset() {
-	*x = DeleteWalletResponse{}
+func (x *RecoverWalletFromSharesResponse) Reset() {
+	*x = RecoverWalletFromSharesResponse{}
 	mi := &file_v1_wallet_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteWalletResponse) String() string {
+func (x *RecoverWalletFromSharesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteWalletResponse) ProtoMessage() {}
+func (*RecoverWalletFromSharesResponse) ProtoMessage() {}
 
-func (x *DeleteWalletResponse) ProtoReflect() protoreflect.Message {
+func (x *RecoverWalletFromSharesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_wallet_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -641,32 +947,40 @@ func (x *DeleteWalletResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteWalletResponse.ProtoReflect.Descriptor instead.
-func (*DeleteWalletResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecoverWalletFromSharesResponse.ProtoReflect.Descriptor instead.
+func (*RecoverWalletFromSharesResponse) Descriptor() ([]byte, []int) {
 	return file_v1_wallet_proto_rawDescGZIP(), []int{11}
 }
 
-type UnlockWalletsRequest struct {
+func (x *RecoverWalletFromSharesResponse) GetWalletId() int64 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+type RevealSeedPhraseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	WalletId      int64                  `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnlockWalletsRequest) Reset() {
-	*x = UnlockWalletsRequest{}
+func (x *RevealSeedPhraseRequest) Reset() {
+	*x = RevealSeedPhraseRequest{}
 	mi := &file_v1_wallet_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnlockWalletsRequest) String() string {
+func (x *RevealSeedPhraseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnlockWalletsRequest) ProtoMessage() {}
+func (*RevealSeedPhraseRequest) ProtoMessage() {}
 
-func (x *UnlockWalletsRequest) ProtoReflect() protoreflect.Message {
+func (x *RevealSeedPhraseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_wallet_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -678,38 +992,50 @@ func (x *UnlockWalletsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnlockWalletsRequest.ProtoReflect.Descriptor instead.
-func (*UnlockWalletsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevealSeedPhraseRequest.ProtoReflect.Descriptor instead.
+func (*RevealSeedPhraseRequest) Descriptor() ([]byte, []int) {
 	return file_v1_wallet_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *UnlockWalletsRequest) GetPassword() string {
+func (x *RevealSeedPhraseRequest) GetWalletId() int64 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *RevealSeedPhraseRequest) GetPassword() string {
 	if x != nil {
 		return x.Password
 	}
 	return ""
 }
 
-type UnlockWalletsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+// The words are secret and must never be logged.
+type RevealSeedPhraseResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Words    []string               `protobuf:"bytes,1,rep,name=words,proto3" json:"words,omitempty"`
+	Language MnemonicLanguage       `protobuf:"varint,2,opt,name=language,proto3,enum=wallet.v1.MnemonicLanguage" json:"language,omitempty"`
+	// Whether the seed also needs a BIP39 passphrase, which is not revealed.
+	HasPassphrase bool `protobuf:"varint,3,opt,name=has_passphrase,json=hasPassphrase,proto3" json:"has_passphrase,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnlockWalletsResponse) Reset() {
-	*x = UnlockWalletsResponse{}
+func (x *RevealSeedPhraseResponse) Reset() {
+	*x = RevealSeedPhraseResponse{}
 	mi := &file_v1_wallet_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnlockWalletsResponse) String() string {
+func (x *RevealSeedPhraseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnlockWalletsResponse) ProtoMessage() {}
+func (*RevealSeedPhraseResponse) ProtoMessage() {}
 
-func (x *UnlockWalletsResponse) ProtoReflect() protoreflect.Message {
+func (x *RevealSeedPhraseResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_wallet_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -721,38 +1047,1512 @@ func (x *UnlockWalletsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnlockWalletsResponse.ProtoReflect.Descriptor instead.
-func (*UnlockWalletsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevealSeedPhraseResponse.ProtoReflect.Descriptor instead.
+func (*RevealSeedPhraseResponse) Descriptor() ([]byte, []int) {
 	return file_v1_wallet_proto_rawDescGZIP(), []int{13}
 }
 
-var File_v1_wallet_proto protoreflect.FileDescriptor
+func (x *RevealSeedPhraseResponse) GetWords() []string {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
 
-const file_v1_wallet_proto_rawDesc = "" +
-	"\n" +
-	"\x0fv1/wallet.proto\x12\twallet.v1\x1a\x0ev1/types.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"/\n" +
-	"\x10GetWalletRequest\x12\x1b\n" +
-	"\twallet_id\x18\x01 \x01(\x03R\bwalletId\"\xd4\x02\n" +
-	"\x11GetWalletResponse\x12\x1b\n" +
-	"\twallet_id\x18\x01 \x01(\x03R\bwalletId\x12\x1d\n" +
-	"\n" +
-	"is_default\x18\x02 \x01(\bR\tisDefault\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\x12I\n" +
-	"\taddresses\x18\x04 \x03(\v2+.wallet.v1.GetWalletResponse.AddressesEntryR\taddresses\x12+\n" +
-	"\abalance\x18\x05 \x01(\v2\x11.wallet.v1.AmountR\abalance\x129\n" +
-	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a<\n" +
-	"\x0eAddressesEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\">\n" +
-	"\x11GetWalletsRequest\x12)\n" +
-	"\x06wallet\x18\x01 \x01(\v2\x11.wallet.v1.WalletR\x06wallet\"A\n" +
-	"\x12GetWalletsResponse\x12+\n" +
-	"\awallets\x18\x01 \x03(\v2\x11.wallet.v1.WalletR\awallets\"p\n" +
+func (x *RevealSeedPhraseResponse) GetLanguage() MnemonicLanguage {
+	if x != nil {
+		return x.Language
+	}
+	return MnemonicLanguage_MNEMONIC_LANGUAGE_UNSPECIFIED
+}
+
+func (x *RevealSeedPhraseResponse) GetHasPassphrase() bool {
+	if x != nil {
+		return x.HasPassphrase
+	}
+	return false
+}
+
+type StartBackupVerificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletId      int64                  `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartBackupVerificationRequest) Reset() {
+	*x = StartBackupVerificationRequest{}
+	mi := &file_v1_wallet_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartBackupVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartBackupVerificationRequest) ProtoMessage() {}
+
+func (x *StartBackupVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wallet_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartBackupVerificationRequest.ProtoReflect.Descriptor instead.
+func (*StartBackupVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_v1_wallet_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *StartBackupVerificationRequest) GetWalletId() int64 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *StartBackupVerificationRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type StartBackupVerificationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 1-based positions of the seed phrase words to ask for, in order.
+	Positions     []uint32               `protobuf:"varint,1,rep,packed,name=positions,proto3" json:"positions,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartBackupVerificationResponse) Reset() {
+	*x = StartBackupVerificationResponse{}
+	mi := &file_v1_wallet_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartBackupVerificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartBackupVerificationResponse) ProtoMessage() {}
+
+func (x *StartBackupVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wallet_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartBackupVerificationResponse.ProtoReflect.Descriptor instead.
+func (*StartBackupVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_v1_wallet_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *StartBackupVerificationResponse) GetPositions() []uint32 {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *StartBackupVerificationResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type VerifyBackupRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	WalletId int64                  `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	// The words at the challenged positions, in order.
+	Words         []string `protobuf:"bytes,2,rep,name=words,proto3" json:"words,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyBackupRequest) Reset() {
+	*x = VerifyBackupRequest{}
+	mi := &file_v1_wallet_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyBackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyBackupRequest) ProtoMessage() {}
+
+func (x *VerifyBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wallet_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyBackupRequest.ProtoReflect.Descriptor instead.
+func (*VerifyBackupRequest) Descriptor() ([]byte, []int) {
+	return file_v1_wallet_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *VerifyBackupRequest) GetWalletId() int64 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *VerifyBackupRequest) GetWords() []string {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+type VerifyBackupResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	BackupVerifiedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=backup_verified_at,json=backupVerifiedAt,proto3" json:"backup_verified_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *VerifyBackupResponse) Reset() {
+	*x = VerifyBackupResponse{}
+	mi := &file_v1_wallet_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyBackupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyBackupResponse) ProtoMessage() {}
+
+func (x *VerifyBackupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wallet_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyBackupResponse.ProtoReflect.Descriptor instead.
+func (*VerifyBackupResponse) Descriptor() ([]byte, []int) {
+	return file_v1_wallet_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *VerifyBackupResponse) GetBackupVerifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BackupVerifiedAt
+	}
+	return nil
+}
+
+type UpdateWalletRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletId      int64                  `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWalletRequest) Reset() {
+	*x = UpdateWalletRequest{}
+	mi := &file_v1_wallet_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWalletRequest) ProtoMessage() {}
+
+func (x *UpdateWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wallet_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWalletRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWalletRequest) Descriptor() ([]byte, []int) {
+	return file_v1_wallet_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateWalletRequest) GetWalletId() int64 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+type UpdateWalletResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Wallet        *Wallet                `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWalletResponse) Reset() {
+	*x = UpdateWalletResponse{}
+	mi := &file_v1_wallet_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWalletResponse) ProtoMessage() {}
+
+func (x *UpdateWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wallet_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWalletResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWalletResponse) Descriptor() ([]byte, []int) {
+	return file_v1_wallet_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateWalletResponse) GetWallet() *Wallet {
+	if x != nil {
+		return x.Wallet
+	}
+	return nil
+}
+
+type DeleteWalletRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletId      int64                  `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWalletRequest) Reset() {
+	*x = DeleteWalletRequest{}
+	mi := &file_v1_wallet_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWalletRequest) ProtoMessage() {}
+
+func (x *DeleteWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wallet_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWalletRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWalletRequest) Descriptor() ([]byte, []int) {
+	return file_v1_wallet_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteWalletRequest) GetWalletId() int64 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *DeleteWalletRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type DeleteWalletResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWalletResponse) Reset() {
+	*x = DeleteWalletResponse{}
+	mi := &file_v1_wallet_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWalletResponse) ProtoMessage() {}
+
+func (x *DeleteWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wallet_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWalletResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWalletResponse) Descriptor() ([]byte, []int) {
+	return file_v1_wallet_proto_rawDescGZIP(), []int{21}
+}
+
+type UnlockWalletsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockWalletsRequest) Reset() {
+	*x = UnlockWalletsRequest{}
+	mi := &file_v1_wallet_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockWalletsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockWalletsRequest) ProtoMessage() {}
+
+func (x *UnlockWalletsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wallet_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockWalletsRequest.ProtoReflect.Descriptor instead.
+func (*UnlockWalletsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_wallet_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UnlockWalletsRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type WalletUnlockResult struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	WalletId int64                  `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Status   UnlockStatus           `protobuf:"varint,2,opt,name=status,proto3,enum=wallet.v1.UnlockStatus" json:"status,omitempty"`
+	// Set for locked out wallets.
+	RetryAfter    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=retry_after,json=retryAfter,proto3,oneof" json:"retry_after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WalletUnlockResult) Reset() {
+	*x = WalletUnlockResult{}
+	mi := &file_v1_wallet_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletUnlockResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletUnlockResult) ProtoMessage() {}
+
+func (x *WalletUnlockResult) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wallet_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletUnlockResult.ProtoReflect.Descriptor instead.
+func (*WalletUnlockResult) Descriptor() ([]byte, []int) {
+	return file_v1_wallet_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *WalletUnlockResult) GetWalletId() int64 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *WalletUnlockResult) GetStatus() UnlockStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UnlockStatus_UNLOCK_STATUS_UNLOCKED
+}
+
+func (x *WalletUnlockResult) GetRetryAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RetryAfter
+	}
+	return nil
+}
+
+type UnlockWalletsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*WalletUnlockResult  `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockWalletsResponse) Reset() {
+	*x = UnlockWalletsResponse{}
+	mi := &file_v1_wallet_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockWalletsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockWalletsResponse) ProtoMessage() {}
+
+func (x *UnlockWalletsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wallet_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockWalletsResponse.ProtoReflect.Descriptor instead.
+func (*UnlockWalletsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_wallet_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UnlockWalletsResponse) GetResults() []*WalletUnlockResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type LockWalletRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletId      int64                  `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LockWalletRequest) Reset() {
+	*x = LockWalletRequest{}
+	mi := &file_v1_wallet_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockWalletRequest) ProtoMessage() {}
+
+func (x *LockWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wallet_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockWalletRequest.ProtoReflect.Descriptor instead.
+func (*LockWalletRequest) Descriptor() ([]byte, []int) {
+	return file_v1_wallet_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *LockWalletRequest) GetWalletId() int64 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+type LockWalletResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LockWalletResponse) Reset() {
+	*x = LockWalletResponse{}
+	mi := &file_v1_wallet_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockWalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockWalletResponse) ProtoMessage() {}
+
+func (x *LockWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wallet_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockWalletResponse.ProtoReflect.Descriptor instead.
+func (*LockWalletResponse) Descriptor() ([]byte, []int) {
+	return file_v1_wallet_proto_rawDescGZIP(), []int{26}
+}
+
+type LockAllRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LockAllRequest) Reset() {
+	*x = LockAllRequest{}
+	mi := &file_v1_wallet_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockAllRequest) ProtoMessage() {}
+
+func (x *LockAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wallet_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockAllRequest.ProtoReflect.Descriptor instead.
+func (*LockAllRequest) Descriptor() ([]byte, []int) {
+	return file_v1_wallet_proto_rawDescGZIP(), []int{27}
+}
+
+type LockAllResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LockAllResponse) Reset() {
+	*x = LockAllResponse{}
+	mi := &file_v1_wallet_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockAllResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockAllResponse) ProtoMessage() {}
+
+func (x *LockAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wallet_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockAllResponse.ProtoReflect.Descriptor instead.
+func (*LockAllResponse) Descriptor() ([]byte, []int) {
+	return file_v1_wallet_proto_rawDescGZIP(), []int{28}
+}
+
+type GetSessionStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSessionStatusRequest) Reset() {
+	*x = GetSessionStatusRequest{}
+	mi := &file_v1_wallet_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionStatusRequest) ProtoMessage() {}
+
+func (x *GetSessionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wallet_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_v1_wallet_proto_rawDescGZIP(), []int{29}
+}
+
+type WalletSession struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	WalletId   int64                  `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	UnlockedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=unlocked_at,json=unlockedAt,proto3" json:"unlocked_at,omitempty"`
+	// The wallet locks at expires_at unless it is used before, which moves
+	// expires_at forward up to deadline.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Deadline      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WalletSession) Reset() {
+	*x = WalletSession{}
+	mi := &file_v1_wallet_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletSession) ProtoMessage() {}
+
+func (x *WalletSession) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wallet_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletSession.ProtoReflect.Descriptor instead.
+func (*WalletSession) Descriptor() ([]byte, []int) {
+	return file_v1_wallet_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *WalletSession) GetWalletId() int64 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *WalletSession) GetUnlockedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnlockedAt
+	}
+	return nil
+}
+
+func (x *WalletSession) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *WalletSession) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+type GetSessionStatusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only unlocked wallets are listed.
+	Sessions      []*WalletSession `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSessionStatusResponse) Reset() {
+	*x = GetSessionStatusResponse{}
+	mi := &file_v1_wallet_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionStatusResponse) ProtoMessage() {}
+
+func (x *GetSessionStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wallet_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetSessionStatusResponse) Descriptor() ([]byte, []int) {
+	return file_v1_wallet_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetSessionStatusResponse) GetSessions() []*WalletSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type ImportKeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// A raw hex secp256k1 key or the hex KeyInfo of `lotus wallet export`,
+	// holding a secp256k1 or BLS key.
+	PrivateKey      string `protobuf:"bytes,2,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	Password        string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	ConfirmPassword string `protobuf:"bytes,4,opt,name=confirm_password,json=confirmPassword,proto3" json:"confirm_password,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ImportKeyRequest) Reset() {
+	*x = ImportKeyRequest{}
+	mi := &file_v1_wallet_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportKeyRequest) ProtoMessage() {}
+
+func (x *ImportKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wallet_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportKeyRequest.ProtoReflect.Descriptor instead.
+func (*ImportKeyRequest) Descriptor() ([]byte, []int) {
+	return file_v1_wallet_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ImportKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportKeyRequest) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *ImportKeyRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ImportKeyRequest) GetConfirmPassword() string {
+	if x != nil {
+		return x.ConfirmPassword
+	}
+	return ""
+}
+
+type ImportKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletId      int64                  `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Addresses     map[string]string      `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportKeyResponse) Reset() {
+	*x = ImportKeyResponse{}
+	mi := &file_v1_wallet_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportKeyResponse) ProtoMessage() {}
+
+func (x *ImportKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wallet_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportKeyResponse.ProtoReflect.Descriptor instead.
+func (*ImportKeyResponse) Descriptor() ([]byte, []int) {
+	return file_v1_wallet_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ImportKeyResponse) GetWalletId() int64 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *ImportKeyResponse) GetAddresses() map[string]string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type ImportKeystoreRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Ethereum V3 keystore JSON, as written by geth or MetaMask.
+	KeystoreJson     string `protobuf:"bytes,2,opt,name=keystore_json,json=keystoreJson,proto3" json:"keystore_json,omitempty"`
+	KeystorePassword string `protobuf:"bytes,3,opt,name=keystore_password,json=keystorePassword,proto3" json:"keystore_password,omitempty"`
+	Password         string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	ConfirmPassword  string `protobuf:"bytes,5,opt,name=confirm_password,json=confirmPassword,proto3" json:"confirm_password,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ImportKeystoreRequest) Reset() {
+	*x = ImportKeystoreRequest{}
+	mi := &file_v1_wallet_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportKeystoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportKeystoreRequest) ProtoMessage() {}
+
+func (x *ImportKeystoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wallet_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportKeystoreRequest.ProtoReflect.Descriptor instead.
+func (*ImportKeystoreRequest) Descriptor() ([]byte, []int) {
+	return file_v1_wallet_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ImportKeystoreRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportKeystoreRequest) GetKeystoreJson() string {
+	if x != nil {
+		return x.KeystoreJson
+	}
+	return ""
+}
+
+func (x *ImportKeystoreRequest) GetKeystorePassword() string {
+	if x != nil {
+		return x.KeystorePassword
+	}
+	return ""
+}
+
+func (x *ImportKeystoreRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ImportKeystoreRequest) GetConfirmPassword() string {
+	if x != nil {
+		return x.ConfirmPassword
+	}
+	return ""
+}
+
+type ImportKeystoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletId      int64                  `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Addresses     map[string]string      `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportKeystoreResponse) Reset() {
+	*x = ImportKeystoreResponse{}
+	mi := &file_v1_wallet_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportKeystoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportKeystoreResponse) ProtoMessage() {}
+
+func (x *ImportKeystoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wallet_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportKeystoreResponse.ProtoReflect.Descriptor instead.
+func (*ImportKeystoreResponse) Descriptor() ([]byte, []int) {
+	return file_v1_wallet_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ImportKeystoreResponse) GetWalletId() int64 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *ImportKeystoreResponse) GetAddresses() map[string]string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type ChangePasswordRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ignored when all_wallets is set.
+	WalletId        int64  `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	OldPassword     string `protobuf:"bytes,2,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
+	NewPassword     string `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	ConfirmPassword string `protobuf:"bytes,4,opt,name=confirm_password,json=confirmPassword,proto3" json:"confirm_password,omitempty"`
+	// Changes the password of every wallet that uses old_password.
+	AllWallets    bool `protobuf:"varint,5,opt,name=all_wallets,json=allWallets,proto3" json:"all_wallets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_v1_wallet_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wallet_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_v1_wallet_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ChangePasswordRequest) GetWalletId() int64 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *ChangePasswordRequest) GetOldPassword() string {
+	if x != nil {
+		return x.OldPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetConfirmPassword() string {
+	if x != nil {
+		return x.ConfirmPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetAllWallets() bool {
+	if x != nil {
+		return x.AllWallets
+	}
+	return false
+}
+
+type ChangePasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletIds     []int64                `protobuf:"varint,1,rep,packed,name=wallet_ids,json=walletIds,proto3" json:"wallet_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordResponse) Reset() {
+	*x = ChangePasswordResponse{}
+	mi := &file_v1_wallet_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordResponse) ProtoMessage() {}
+
+func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wallet_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_v1_wallet_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ChangePasswordResponse) GetWalletIds() []int64 {
+	if x != nil {
+		return x.WalletIds
+	}
+	return nil
+}
+
+type ExportKeyRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	WalletId       int64                  `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	AccountIndex   uint32                 `protobuf:"varint,2,opt,name=account_index,json=accountIndex,proto3" json:"account_index,omitempty"`
+	Password       string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Format         KeyExportFormat        `protobuf:"varint,4,opt,name=format,proto3,enum=wallet.v1.KeyExportFormat" json:"format,omitempty"`
+	ExportPassword string                 `protobuf:"bytes,5,opt,name=export_password,json=exportPassword,proto3" json:"export_password,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ExportKeyRequest) Reset() {
+	*x = ExportKeyRequest{}
+	mi := &file_v1_wallet_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportKeyRequest) ProtoMessage() {}
+
+func (x *ExportKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wallet_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportKeyRequest.ProtoReflect.Descriptor instead.
+func (*ExportKeyRequest) Descriptor() ([]byte, []int) {
+	return file_v1_wallet_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ExportKeyRequest) GetWalletId() int64 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *ExportKeyRequest) GetAccountIndex() uint32 {
+	if x != nil {
+		return x.AccountIndex
+	}
+	return 0
+}
+
+func (x *ExportKeyRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ExportKeyRequest) GetFormat() KeyExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return KeyExportFormat_KEY_EXPORT_FORMAT_KEY_INFO
+}
+
+func (x *ExportKeyRequest) GetExportPassword() string {
+	if x != nil {
+		return x.ExportPassword
+	}
+	return ""
+}
+
+type ExportKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportKeyResponse) Reset() {
+	*x = ExportKeyResponse{}
+	mi := &file_v1_wallet_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportKeyResponse) ProtoMessage() {}
+
+func (x *ExportKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wallet_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportKeyResponse.ProtoReflect.Descriptor instead.
+func (*ExportKeyResponse) Descriptor() ([]byte, []int) {
+	return file_v1_wallet_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ExportKeyResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type ConvertAddressRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Any f0, f1, f2, f3, f410, 0x or masked 0xff address.
+	Address       string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertAddressRequest) Reset() {
+	*x = ConvertAddressRequest{}
+	mi := &file_v1_wallet_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertAddressRequest) ProtoMessage() {}
+
+func (x *ConvertAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wallet_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertAddressRequest.ProtoReflect.Descriptor instead.
+func (*ConvertAddressRequest) Descriptor() ([]byte, []int) {
+	return file_v1_wallet_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ConvertAddressRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// Forms that do not exist for the actor, or need an actor that is not yet on
+// chain, are empty.
+type ConvertAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Robust        string                 `protobuf:"bytes,2,opt,name=robust,proto3" json:"robust,omitempty"`
+	Delegated     string                 `protobuf:"bytes,3,opt,name=delegated,proto3" json:"delegated,omitempty"`
+	Eth           string                 `protobuf:"bytes,4,opt,name=eth,proto3" json:"eth,omitempty"`
+	MaskedId      string                 `protobuf:"bytes,5,opt,name=masked_id,json=maskedId,proto3" json:"masked_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertAddressResponse) Reset() {
+	*x = ConvertAddressResponse{}
+	mi := &file_v1_wallet_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertAddressResponse) ProtoMessage() {}
+
+func (x *ConvertAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wallet_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertAddressResponse.ProtoReflect.Descriptor instead.
+func (*ConvertAddressResponse) Descriptor() ([]byte, []int) {
+	return file_v1_wallet_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ConvertAddressResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ConvertAddressResponse) GetRobust() string {
+	if x != nil {
+		return x.Robust
+	}
+	return ""
+}
+
+func (x *ConvertAddressResponse) GetDelegated() string {
+	if x != nil {
+		return x.Delegated
+	}
+	return ""
+}
+
+func (x *ConvertAddressResponse) GetEth() string {
+	if x != nil {
+		return x.Eth
+	}
+	return ""
+}
+
+func (x *ConvertAddressResponse) GetMaskedId() string {
+	if x != nil {
+		return x.MaskedId
+	}
+	return ""
+}
+
+var File_v1_wallet_proto protoreflect.FileDescriptor
+
+const file_v1_wallet_proto_rawDesc = "" +
+	"\n" +
+	"\x0fv1/wallet.proto\x12\twallet.v1\x1a\x0ev1/types.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"/\n" +
+	"\x10GetWalletRequest\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\x03R\bwalletId\"\xef\x02\n" +
+	"\x11GetWalletResponse\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\x03R\bwalletId\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\x02 \x01(\bR\tisDefault\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12I\n" +
+	"\taddresses\x18\x04 \x03(\v2+.wallet.v1.GetWalletResponse.AddressesEntryR\taddresses\x12+\n" +
+	"\abalance\x18\x05 \x01(\v2\x11.wallet.v1.AmountR\abalance\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x19\n" +
+	"\bactor_id\x18\a \x01(\tR\aactorId\x1a<\n" +
+	"\x0eAddressesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\">\n" +
+	"\x11GetWalletsRequest\x12)\n" +
+	"\x06wallet\x18\x01 \x01(\v2\x11.wallet.v1.WalletR\x06wallet\"A\n" +
+	"\x12GetWalletsResponse\x12+\n" +
+	"\awallets\x18\x01 \x03(\v2\x11.wallet.v1.WalletR\awallets\"\xe8\x01\n" +
 	"\x13CreateWalletRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12)\n" +
-	"\x10confirm_password\x18\x03 \x01(\tR\x0fconfirmPassword\"\xd3\x01\n" +
+	"\x10confirm_password\x18\x03 \x01(\tR\x0fconfirmPassword\x12\x1e\n" +
+	"\n" +
+	"passphrase\x18\x04 \x01(\tR\n" +
+	"passphrase\x12\x1d\n" +
+	"\n" +
+	"word_count\x18\x05 \x01(\rR\twordCount\x127\n" +
+	"\blanguage\x18\x06 \x01(\x0e2\x1b.wallet.v1.MnemonicLanguageR\blanguage\"\xd3\x01\n" +
 	"\x14CreateWalletResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
 	"\vseed_phrase\x18\x02 \x01(\tR\n" +
@@ -760,16 +2560,61 @@ const file_v1_wallet_proto_rawDesc = "" +
 	"\taddresses\x18\x03 \x03(\v2..wallet.v1.CreateWalletResponse.AddressesEntryR\taddresses\x1a<\n" +
 	"\x0eAddressesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9f\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf8\x01\n" +
 	"\x14RecoverWalletRequest\x12\x1f\n" +
 	"\vwallet_name\x18\x01 \x01(\tR\n" +
 	"walletName\x12\x1f\n" +
 	"\vseed_phrase\x18\x02 \x01(\tR\n" +
 	"seedPhrase\x12\x1a\n" +
 	"\bpassword\x18\x03 \x01(\tR\bpassword\x12)\n" +
-	"\x10confirm_password\x18\x04 \x01(\tR\x0fconfirmPassword\"4\n" +
+	"\x10confirm_password\x18\x04 \x01(\tR\x0fconfirmPassword\x12\x1e\n" +
+	"\n" +
+	"passphrase\x18\x05 \x01(\tR\n" +
+	"passphrase\x127\n" +
+	"\blanguage\x18\x06 \x01(\x0e2\x1b.wallet.v1.MnemonicLanguageR\blanguage\"4\n" +
 	"\x15RecoverWalletResponse\x12\x1b\n" +
-	"\twallet_id\x18\x01 \x01(\x03R\bwalletId\"2\n" +
+	"\twallet_id\x18\x01 \x01(\x03R\bwalletId\"\x92\x01\n" +
+	"\x18CreateShareBackupRequest\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\x03R\bwalletId\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1c\n" +
+	"\tthreshold\x18\x03 \x01(\rR\tthreshold\x12\x1f\n" +
+	"\vshare_count\x18\x04 \x01(\rR\n" +
+	"shareCount\"\x93\x01\n" +
+	"\x19CreateShareBackupResponse\x12\x16\n" +
+	"\x06shares\x18\x01 \x03(\tR\x06shares\x127\n" +
+	"\blanguage\x18\x02 \x01(\x0e2\x1b.wallet.v1.MnemonicLanguageR\blanguage\x12%\n" +
+	"\x0ehas_passphrase\x18\x03 \x01(\bR\rhasPassphrase\"\xf9\x01\n" +
+	"\x1eRecoverWalletFromSharesRequest\x12\x1f\n" +
+	"\vwallet_name\x18\x01 \x01(\tR\n" +
+	"walletName\x12\x16\n" +
+	"\x06shares\x18\x02 \x03(\tR\x06shares\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12)\n" +
+	"\x10confirm_password\x18\x04 \x01(\tR\x0fconfirmPassword\x12\x1e\n" +
+	"\n" +
+	"passphrase\x18\x05 \x01(\tR\n" +
+	"passphrase\x127\n" +
+	"\blanguage\x18\x06 \x01(\x0e2\x1b.wallet.v1.MnemonicLanguageR\blanguage\">\n" +
+	"\x1fRecoverWalletFromSharesResponse\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\x03R\bwalletId\"R\n" +
+	"\x17RevealSeedPhraseRequest\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\x03R\bwalletId\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x90\x01\n" +
+	"\x18RevealSeedPhraseResponse\x12\x14\n" +
+	"\x05words\x18\x01 \x03(\tR\x05words\x127\n" +
+	"\blanguage\x18\x02 \x01(\x0e2\x1b.wallet.v1.MnemonicLanguageR\blanguage\x12%\n" +
+	"\x0ehas_passphrase\x18\x03 \x01(\bR\rhasPassphrase\"Y\n" +
+	"\x1eStartBackupVerificationRequest\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\x03R\bwalletId\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"z\n" +
+	"\x1fStartBackupVerificationResponse\x12\x1c\n" +
+	"\tpositions\x18\x01 \x03(\rR\tpositions\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"H\n" +
+	"\x13VerifyBackupRequest\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\x03R\bwalletId\x12\x14\n" +
+	"\x05words\x18\x02 \x03(\tR\x05words\"`\n" +
+	"\x14VerifyBackupResponse\x12H\n" +
+	"\x12backup_verified_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x10backupVerifiedAt\"2\n" +
 	"\x13UpdateWalletRequest\x12\x1b\n" +
 	"\twallet_id\x18\x01 \x01(\x03R\bwalletId\"A\n" +
 	"\x14UpdateWalletResponse\x12)\n" +
@@ -779,17 +2624,122 @@ const file_v1_wallet_proto_rawDesc = "" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x16\n" +
 	"\x14DeleteWalletResponse\"2\n" +
 	"\x14UnlockWalletsRequest\x12\x1a\n" +
-	"\bpassword\x18\x01 \x01(\tR\bpassword\"\x17\n" +
-	"\x15UnlockWalletsResponse2\xbd\x04\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\"\xb4\x01\n" +
+	"\x12WalletUnlockResult\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\x03R\bwalletId\x12/\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x17.wallet.v1.UnlockStatusR\x06status\x12@\n" +
+	"\vretry_after\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"retryAfter\x88\x01\x01B\x0e\n" +
+	"\f_retry_after\"P\n" +
+	"\x15UnlockWalletsResponse\x127\n" +
+	"\aresults\x18\x01 \x03(\v2\x1d.wallet.v1.WalletUnlockResultR\aresults\"0\n" +
+	"\x11LockWalletRequest\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\x03R\bwalletId\"\x14\n" +
+	"\x12LockWalletResponse\"\x10\n" +
+	"\x0eLockAllRequest\"\x11\n" +
+	"\x0fLockAllResponse\"\x19\n" +
+	"\x17GetSessionStatusRequest\"\xdc\x01\n" +
+	"\rWalletSession\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\x03R\bwalletId\x12;\n" +
+	"\vunlocked_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"unlockedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x126\n" +
+	"\bdeadline\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\"P\n" +
+	"\x18GetSessionStatusResponse\x124\n" +
+	"\bsessions\x18\x01 \x03(\v2\x18.wallet.v1.WalletSessionR\bsessions\"\x8e\x01\n" +
+	"\x10ImportKeyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vprivate_key\x18\x02 \x01(\tR\n" +
+	"privateKey\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12)\n" +
+	"\x10confirm_password\x18\x04 \x01(\tR\x0fconfirmPassword\"\xb9\x01\n" +
+	"\x11ImportKeyResponse\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\x03R\bwalletId\x12I\n" +
+	"\taddresses\x18\x02 \x03(\v2+.wallet.v1.ImportKeyResponse.AddressesEntryR\taddresses\x1a<\n" +
+	"\x0eAddressesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc4\x01\n" +
+	"\x15ImportKeystoreRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
+	"\rkeystore_json\x18\x02 \x01(\tR\fkeystoreJson\x12+\n" +
+	"\x11keystore_password\x18\x03 \x01(\tR\x10keystorePassword\x12\x1a\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\x12)\n" +
+	"\x10confirm_password\x18\x05 \x01(\tR\x0fconfirmPassword\"\xc3\x01\n" +
+	"\x16ImportKeystoreResponse\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\x03R\bwalletId\x12N\n" +
+	"\taddresses\x18\x02 \x03(\v20.wallet.v1.ImportKeystoreResponse.AddressesEntryR\taddresses\x1a<\n" +
+	"\x0eAddressesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc6\x01\n" +
+	"\x15ChangePasswordRequest\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\x03R\bwalletId\x12!\n" +
+	"\fold_password\x18\x02 \x01(\tR\voldPassword\x12!\n" +
+	"\fnew_password\x18\x03 \x01(\tR\vnewPassword\x12)\n" +
+	"\x10confirm_password\x18\x04 \x01(\tR\x0fconfirmPassword\x12\x1f\n" +
+	"\vall_wallets\x18\x05 \x01(\bR\n" +
+	"allWallets\"7\n" +
+	"\x16ChangePasswordResponse\x12\x1d\n" +
+	"\n" +
+	"wallet_ids\x18\x01 \x03(\x03R\twalletIds\"\xcd\x01\n" +
+	"\x10ExportKeyRequest\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\x03R\bwalletId\x12#\n" +
+	"\raccount_index\x18\x02 \x01(\rR\faccountIndex\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x122\n" +
+	"\x06format\x18\x04 \x01(\x0e2\x1a.wallet.v1.KeyExportFormatR\x06format\x12'\n" +
+	"\x0fexport_password\x18\x05 \x01(\tR\x0eexportPassword\"%\n" +
+	"\x11ExportKeyResponse\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"1\n" +
+	"\x15ConvertAddressRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\"\x8d\x01\n" +
+	"\x16ConvertAddressResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06robust\x18\x02 \x01(\tR\x06robust\x12\x1c\n" +
+	"\tdelegated\x18\x03 \x01(\tR\tdelegated\x12\x10\n" +
+	"\x03eth\x18\x04 \x01(\tR\x03eth\x12\x1b\n" +
+	"\tmasked_id\x18\x05 \x01(\tR\bmaskedId*\xe0\x02\n" +
+	"\x10MnemonicLanguage\x12!\n" +
+	"\x1dMNEMONIC_LANGUAGE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19MNEMONIC_LANGUAGE_ENGLISH\x10\x01\x12(\n" +
+	"$MNEMONIC_LANGUAGE_CHINESE_SIMPLIFIED\x10\x02\x12)\n" +
+	"%MNEMONIC_LANGUAGE_CHINESE_TRADITIONAL\x10\x03\x12\x1b\n" +
+	"\x17MNEMONIC_LANGUAGE_CZECH\x10\x04\x12\x1c\n" +
+	"\x18MNEMONIC_LANGUAGE_FRENCH\x10\x05\x12\x1d\n" +
+	"\x19MNEMONIC_LANGUAGE_ITALIAN\x10\x06\x12\x1e\n" +
+	"\x1aMNEMONIC_LANGUAGE_JAPANESE\x10\a\x12\x1c\n" +
+	"\x18MNEMONIC_LANGUAGE_KOREAN\x10\b\x12\x1d\n" +
+	"\x19MNEMONIC_LANGUAGE_SPANISH\x10\t*\x87\x01\n" +
+	"\fUnlockStatus\x12\x1a\n" +
+	"\x16UNLOCK_STATUS_UNLOCKED\x10\x00\x12 \n" +
+	"\x1cUNLOCK_STATUS_WRONG_PASSWORD\x10\x01\x12\x1b\n" +
+	"\x17UNLOCK_STATUS_CORRUPTED\x10\x02\x12\x1c\n" +
+	"\x18UNLOCK_STATUS_LOCKED_OUT\x10\x03*Q\n" +
+	"\x0fKeyExportFormat\x12\x1e\n" +
+	"\x1aKEY_EXPORT_FORMAT_KEY_INFO\x10\x00\x12\x1e\n" +
+	"\x1aKEY_EXPORT_FORMAT_KEYSTORE\x10\x012\xae\r\n" +
 	"\rWalletService\x12F\n" +
 	"\tGetWallet\x12\x1b.wallet.v1.GetWalletRequest\x1a\x1c.wallet.v1.GetWalletResponse\x12I\n" +
 	"\n" +
 	"GetWallets\x12\x1c.wallet.v1.GetWalletsRequest\x1a\x1d.wallet.v1.GetWalletsResponse\x12O\n" +
 	"\fCreateWallet\x12\x1e.wallet.v1.CreateWalletRequest\x1a\x1f.wallet.v1.CreateWalletResponse\x12R\n" +
-	"\rRecoverWallet\x12\x1f.wallet.v1.RecoverWalletRequest\x1a .wallet.v1.RecoverWalletResponse\x12O\n" +
+	"\rRecoverWallet\x12\x1f.wallet.v1.RecoverWalletRequest\x1a .wallet.v1.RecoverWalletResponse\x12^\n" +
+	"\x11CreateShareBackup\x12#.wallet.v1.CreateShareBackupRequest\x1a$.wallet.v1.CreateShareBackupResponse\x12p\n" +
+	"\x17RecoverWalletFromShares\x12).wallet.v1.RecoverWalletFromSharesRequest\x1a*.wallet.v1.RecoverWalletFromSharesResponse\x12[\n" +
+	"\x10RevealSeedPhrase\x12\".wallet.v1.RevealSeedPhraseRequest\x1a#.wallet.v1.RevealSeedPhraseResponse\x12p\n" +
+	"\x17StartBackupVerification\x12).wallet.v1.StartBackupVerificationRequest\x1a*.wallet.v1.StartBackupVerificationResponse\x12O\n" +
+	"\fVerifyBackup\x12\x1e.wallet.v1.VerifyBackupRequest\x1a\x1f.wallet.v1.VerifyBackupResponse\x12O\n" +
 	"\fUpdateWallet\x12\x1e.wallet.v1.UpdateWalletRequest\x1a\x1f.wallet.v1.UpdateWalletResponse\x12O\n" +
 	"\fDeleteWallet\x12\x1e.wallet.v1.DeleteWalletRequest\x1a\x1f.wallet.v1.DeleteWalletResponse\x12R\n" +
-	"\rUnlockWallets\x12\x1f.wallet.v1.UnlockWalletsRequest\x1a .wallet.v1.UnlockWalletsResponseB=Z;github.com/codemaestro64/filament/libs/proto/gen/go/v1;pbv1b\x06proto3"
+	"\rUnlockWallets\x12\x1f.wallet.v1.UnlockWalletsRequest\x1a .wallet.v1.UnlockWalletsResponse\x12I\n" +
+	"\n" +
+	"LockWallet\x12\x1c.wallet.v1.LockWalletRequest\x1a\x1d.wallet.v1.LockWalletResponse\x12@\n" +
+	"\aLockAll\x12\x19.wallet.v1.LockAllRequest\x1a\x1a.wallet.v1.LockAllResponse\x12[\n" +
+	"\x10GetSessionStatus\x12\".wallet.v1.GetSessionStatusRequest\x1a#.wallet.v1.GetSessionStatusResponse\x12F\n" +
+	"\tImportKey\x12\x1b.wallet.v1.ImportKeyRequest\x1a\x1c.wallet.v1.ImportKeyResponse\x12U\n" +
+	"\x0eImportKeystore\x12 .wallet.v1.ImportKeystoreRequest\x1a!.wallet.v1.ImportKeystoreResponse\x12U\n" +
+	"\x0eChangePassword\x12 .wallet.v1.ChangePasswordRequest\x1a!.wallet.v1.ChangePasswordResponse\x12F\n" +
+	"\tExportKey\x12\x1b.wallet.v1.ExportKeyRequest\x1a\x1c.wallet.v1.ExportKeyResponse\x12U\n" +
+	"\x0eConvertAddress\x12 .wallet.v1.ConvertAddressRequest\x1a!.wallet.v1.ConvertAddressResponseB=Z;github.com/codemaestro64/filament/libs/proto/gen/go/v1;pbv1b\x06proto3"
 
 var (
 	file_v1_wallet_proto_rawDescOnce sync.Once
@@ -803,55 +2753,132 @@ func file_v1_wallet_proto_rawDescGZIP() []byte {
 	return file_v1_wallet_proto_rawDescData
 }
 
-var file_v1_wallet_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_v1_wallet_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_v1_wallet_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_v1_wallet_proto_goTypes = []any{
-	(*GetWalletRequest)(nil),      // 0: wallet.v1.GetWalletRequest
-	(*GetWalletResponse)(nil),     // 1: wallet.v1.GetWalletResponse
-	(*GetWalletsRequest)(nil),     // 2: wallet.v1.GetWalletsRequest
-	(*GetWalletsResponse)(nil),    // 3: wallet.v1.GetWalletsResponse
-	(*CreateWalletRequest)(nil),   // 4: wallet.v1.CreateWalletRequest
-	(*CreateWalletResponse)(nil),  // 5: wallet.v1.CreateWalletResponse
-	(*RecoverWalletRequest)(nil),  // 6: wallet.v1.RecoverWalletRequest
-	(*RecoverWalletResponse)(nil), // 7: wallet.v1.RecoverWalletResponse
-	(*UpdateWalletRequest)(nil),   // 8: wallet.v1.UpdateWalletRequest
-	(*UpdateWalletResponse)(nil),  // 9: wallet.v1.UpdateWalletResponse
-	(*DeleteWalletRequest)(nil),   // 10: wallet.v1.DeleteWalletRequest
-	(*DeleteWalletResponse)(nil),  // 11: wallet.v1.DeleteWalletResponse
-	(*UnlockWalletsRequest)(nil),  // 12: wallet.v1.UnlockWalletsRequest
-	(*UnlockWalletsResponse)(nil), // 13: wallet.v1.UnlockWalletsResponse
-	nil,                           // 14: wallet.v1.GetWalletResponse.AddressesEntry
-	nil,                           // 15: wallet.v1.CreateWalletResponse.AddressesEntry
-	(*Amount)(nil),                // 16: wallet.v1.Amount
-	(*timestamppb.Timestamp)(nil), // 17: google.protobuf.Timestamp
-	(*Wallet)(nil),                // 18: wallet.v1.Wallet
+	(MnemonicLanguage)(0),                   // 0: wallet.v1.MnemonicLanguage
+	(UnlockStatus)(0),                       // 1: wallet.v1.UnlockStatus
+	(KeyExportFormat)(0),                    // 2: wallet.v1.KeyExportFormat
+	(*GetWalletRequest)(nil),                // 3: wallet.v1.GetWalletRequest
+	(*GetWalletResponse)(nil),               // 4: wallet.v1.GetWalletResponse
+	(*GetWalletsRequest)(nil),               // 5: wallet.v1.GetWalletsRequest
+	(*GetWalletsResponse)(nil),              // 6: wallet.v1.GetWalletsResponse
+	(*CreateWalletRequest)(nil),             // 7: wallet.v1.CreateWalletRequest
+	(*CreateWalletResponse)(nil),            // 8: wallet.v1.CreateWalletResponse
+	(*RecoverWalletRequest)(nil),            // 9: wallet.v1.RecoverWalletRequest
+	(*RecoverWalletResponse)(nil),           // 10: wallet.v1.RecoverWalletResponse
+	(*CreateShareBackupRequest)(nil),        // 11: wallet.v1.CreateShareBackupRequest
+	(*CreateShareBackupResponse)(nil),       // 12: wallet.v1.CreateShareBackupResponse
+	(*RecoverWalletFromSharesRequest)(nil),  // 13: wallet.v1.RecoverWalletFromSharesRequest
+	(*RecoverWalletFromSharesResponse)(nil), // 14: wallet.v1.RecoverWalletFromSharesResponse
+	(*RevealSeedPhraseRequest)(nil),         // 15: wallet.v1.RevealSeedPhraseRequest
+	(*RevealSeedPhraseResponse)(nil),        // 16: wallet.v1.RevealSeedPhraseResponse
+	(*StartBackupVerificationRequest)(nil),  // 17: wallet.v1.StartBackupVerificationRequest
+	(*StartBackupVerificationResponse)(nil), // 18: wallet.v1.StartBackupVerificationResponse
+	(*VerifyBackupRequest)(nil),             // 19: wallet.v1.VerifyBackupRequest
+	(*VerifyBackupResponse)(nil),            // 20: wallet.v1.VerifyBackupResponse
+	(*UpdateWalletRequest)(nil),             // 21: wallet.v1.UpdateWalletRequest
+	(*UpdateWalletResponse)(nil),            // 22: wallet.v1.UpdateWalletResponse
+	(*DeleteWalletRequest)(nil),             // 23: wallet.v1.DeleteWalletRequest
+	(*DeleteWalletResponse)(nil),            // 24: wallet.v1.DeleteWalletResponse
+	(*UnlockWalletsRequest)(nil),            // 25: wallet.v1.UnlockWalletsRequest
+	(*WalletUnlockResult)(nil),              // 26: wallet.v1.WalletUnlockResult
+	(*UnlockWalletsResponse)(nil),           // 27: wallet.v1.UnlockWalletsResponse
+	(*LockWalletRequest)(nil),               // 28: wallet.v1.LockWalletRequest
+	(*LockWalletResponse)(nil),              // 29: wallet.v1.LockWalletResponse
+	(*LockAllRequest)(nil),                  // 30: wallet.v1.LockAllRequest
+	(*LockAllResponse)(nil),                 // 31: wallet.v1.LockAllResponse
+	(*GetSessionStatusRequest)(nil),         // 32: wallet.v1.GetSessionStatusRequest
+	(*WalletSession)(nil),                   // 33: wallet.v1.WalletSession
+	(*GetSessionStatusResponse)(nil),        // 34: wallet.v1.GetSessionStatusResponse
+	(*ImportKeyRequest)(nil),                // 35: wallet.v1.ImportKeyRequest
+	(*ImportKeyResponse)(nil),               // 36: wallet.v1.ImportKeyResponse
+	(*ImportKeystoreRequest)(nil),           // 37: wallet.v1.ImportKeystoreRequest
+	(*ImportKeystoreResponse)(nil),          // 38: wallet.v1.ImportKeystoreResponse
+	(*ChangePasswordRequest)(nil),           // 39: wallet.v1.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),          // 40: wallet.v1.ChangePasswordResponse
+	(*ExportKeyRequest)(nil),                // 41: wallet.v1.ExportKeyRequest
+	(*ExportKeyResponse)(nil),               // 42: wallet.v1.ExportKeyResponse
+	(*ConvertAddressRequest)(nil),           // 43: wallet.v1.ConvertAddressRequest
+	(*ConvertAddressResponse)(nil),          // 44: wallet.v1.ConvertAddressResponse
+	nil,                                     // 45: wallet.v1.GetWalletResponse.AddressesEntry
+	nil,                                     // 46: wallet.v1.CreateWalletResponse.AddressesEntry
+	nil,                                     // 47: wallet.v1.ImportKeyResponse.AddressesEntry
+	nil,                                     // 48: wallet.v1.ImportKeystoreResponse.AddressesEntry
+	(*Amount)(nil),                          // 49: wallet.v1.Amount
+	(*timestamppb.Timestamp)(nil),           // 50: google.protobuf.Timestamp
+	(*Wallet)(nil),                          // 51: wallet.v1.Wallet
 }
 var file_v1_wallet_proto_depIdxs = []int32{
-	14, // 0: wallet.v1.GetWalletResponse.addresses:type_name -> wallet.v1.GetWalletResponse.AddressesEntry
-	16, // 1: wallet.v1.GetWalletResponse.balance:type_name -> wallet.v1.Amount
-	17, // 2: wallet.v1.GetWalletResponse.created_at:type_name -> google.protobuf.Timestamp
-	18, // 3: wallet.v1.GetWalletsRequest.wallet:type_name -> wallet.v1.Wallet
-	18, // 4: wallet.v1.GetWalletsResponse.wallets:type_name -> wallet.v1.Wallet
-	15, // 5: wallet.v1.CreateWalletResponse.addresses:type_name -> wallet.v1.CreateWalletResponse.AddressesEntry
-	18, // 6: wallet.v1.UpdateWalletResponse.wallet:type_name -> wallet.v1.Wallet
-	0,  // 7: wallet.v1.WalletService.GetWallet:input_type -> wallet.v1.GetWalletRequest
-	2,  // 8: wallet.v1.WalletService.GetWallets:input_type -> wallet.v1.GetWalletsRequest
-	4,  // 9: wallet.v1.WalletService.CreateWallet:input_type -> wallet.v1.CreateWalletRequest
-	6,  // 10: wallet.v1.WalletService.RecoverWallet:input_type -> wallet.v1.RecoverWalletRequest
-	8,  // 11: wallet.v1.WalletService.UpdateWallet:input_type -> wallet.v1.UpdateWalletRequest
-	10, // 12: wallet.v1.WalletService.DeleteWallet:input_type -> wallet.v1.DeleteWalletRequest
-	12, // 13: wallet.v1.WalletService.UnlockWallets:input_type -> wallet.v1.UnlockWalletsRequest
-	1,  // 14: wallet.v1.WalletService.GetWallet:output_type -> wallet.v1.GetWalletResponse
-	3,  // 15: wallet.v1.WalletService.GetWallets:output_type -> wallet.v1.GetWalletsResponse
-	5,  // 16: wallet.v1.WalletService.CreateWallet:output_type -> wallet.v1.CreateWalletResponse
-	7,  // 17: wallet.v1.WalletService.RecoverWallet:output_type -> wallet.v1.RecoverWalletResponse
-	9,  // 18: wallet.v1.WalletService.UpdateWallet:output_type -> wallet.v1.UpdateWalletResponse
-	11, // 19: wallet.v1.WalletService.DeleteWallet:output_type -> wallet.v1.DeleteWalletResponse
-	13, // 20: wallet.v1.WalletService.UnlockWallets:output_type -> wallet.v1.UnlockWalletsResponse
-	14, // [14:21] is the sub-list for method output_type
-	7,  // [7:14] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	45, // 0: wallet.v1.GetWalletResponse.addresses:type_name -> wallet.v1.GetWalletResponse.AddressesEntry
+	49, // 1: wallet.v1.GetWalletResponse.balance:type_name -> wallet.v1.Amount
+	50, // 2: wallet.v1.GetWalletResponse.created_at:type_name -> google.protobuf.Timestamp
+	51, // 3: wallet.v1.GetWalletsRequest.wallet:type_name -> wallet.v1.Wallet
+	51, // 4: wallet.v1.GetWalletsResponse.wallets:type_name -> wallet.v1.Wallet
+	0,  // 5: wallet.v1.CreateWalletRequest.language:type_name -> wallet.v1.MnemonicLanguage
+	46, // 6: wallet.v1.CreateWalletResponse.addresses:type_name -> wallet.v1.CreateWalletResponse.AddressesEntry
+	0,  // 7: wallet.v1.RecoverWalletRequest.language:type_name -> wallet.v1.MnemonicLanguage
+	0,  // 8: wallet.v1.CreateShareBackupResponse.language:type_name -> wallet.v1.MnemonicLanguage
+	0,  // 9: wallet.v1.RecoverWalletFromSharesRequest.language:type_name -> wallet.v1.MnemonicLanguage
+	0,  // 10: wallet.v1.RevealSeedPhraseResponse.language:type_name -> wallet.v1.MnemonicLanguage
+	50, // 11: wallet.v1.StartBackupVerificationResponse.expires_at:type_name -> google.protobuf.Timestamp
+	50, // 12: wallet.v1.VerifyBackupResponse.backup_verified_at:type_name -> google.protobuf.Timestamp
+	51, // 13: wallet.v1.UpdateWalletResponse.wallet:type_name -> wallet.v1.Wallet
+	1,  // 14: wallet.v1.WalletUnlockResult.status:type_name -> wallet.v1.UnlockStatus
+	50, // 15: wallet.v1.WalletUnlockResult.retry_after:type_name -> google.protobuf.Timestamp
+	26, // 16: wallet.v1.UnlockWalletsResponse.results:type_name -> wallet.v1.WalletUnlockResult
+	50, // 17: wallet.v1.WalletSession.unlocked_at:type_name -> google.protobuf.Timestamp
+	50, // 18: wallet.v1.WalletSession.expires_at:type_name -> google.protobuf.Timestamp
+	50, // 19: wallet.v1.WalletSession.deadline:type_name -> google.protobuf.Timestamp
+	33, // 20: wallet.v1.GetSessionStatusResponse.sessions:type_name -> wallet.v1.WalletSession
+	47, // 21: wallet.v1.ImportKeyResponse.addresses:type_name -> wallet.v1.ImportKeyResponse.AddressesEntry
+	48, // 22: wallet.v1.ImportKeystoreResponse.addresses:type_name -> wallet.v1.ImportKeystoreResponse.AddressesEntry
+	2,  // 23: wallet.v1.ExportKeyRequest.format:type_name -> wallet.v1.KeyExportFormat
+	3,  // 24: wallet.v1.WalletService.GetWallet:input_type -> wallet.v1.GetWalletRequest
+	5,  // 25: wallet.v1.WalletService.GetWallets:input_type -> wallet.v1.GetWalletsRequest
+	7,  // 26: wallet.v1.WalletService.CreateWallet:input_type -> wallet.v1.CreateWalletRequest
+	9,  // 27: wallet.v1.WalletService.RecoverWallet:input_type -> wallet.v1.RecoverWalletRequest
+	11, // 28: wallet.v1.WalletService.CreateShareBackup:input_type -> wallet.v1.CreateShareBackupRequest
+	13, // 29: wallet.v1.WalletService.RecoverWalletFromShares:input_type -> wallet.v1.RecoverWalletFromSharesRequest
+	15, // 30: wallet.v1.WalletService.RevealSeedPhrase:input_type -> wallet.v1.RevealSeedPhraseRequest
+	17, // 31: wallet.v1.WalletService.StartBackupVerification:input_type -> wallet.v1.StartBackupVerificationRequest
+	19, // 32: wallet.v1.WalletService.VerifyBackup:input_type -> wallet.v1.VerifyBackupRequest
+	21, // 33: wallet.v1.WalletService.UpdateWallet:input_type -> wallet.v1.UpdateWalletRequest
+	23, // 34: wallet.v1.WalletService.DeleteWallet:input_type -> wallet.v1.DeleteWalletRequest
+	25, // 35: wallet.v1.WalletService.UnlockWallets:input_type -> wallet.v1.UnlockWalletsRequest
+	28, // 36: wallet.v1.WalletService.LockWallet:input_type -> wallet.v1.LockWalletRequest
+	30, // 37: wallet.v1.WalletService.LockAll:input_type -> wallet.v1.LockAllRequest
+	32, // 38: wallet.v1.WalletService.GetSessionStatus:input_type -> wallet.v1.GetSessionStatusRequest
+	35, // 39: wallet.v1.WalletService.ImportKey:input_type -> wallet.v1.ImportKeyRequest
+	37, // 40: wallet.v1.WalletService.ImportKeystore:input_type -> wallet.v1.ImportKeystoreRequest
+	39, // 41: wallet.v1.WalletService.ChangePassword:input_type -> wallet.v1.ChangePasswordRequest
+	41, // 42: wallet.v1.WalletService.ExportKey:input_type -> wallet.v1.ExportKeyRequest
+	43, // 43: wallet.v1.WalletService.ConvertAddress:input_type -> wallet.v1.ConvertAddressRequest
+	4,  // 44: wallet.v1.WalletService.GetWallet:output_type -> wallet.v1.GetWalletResponse
+	6,  // 45: wallet.v1.WalletService.GetWallets:output_type -> wallet.v1.GetWalletsResponse
+	8,  // 46: wallet.v1.WalletService.CreateWallet:output_type -> wallet.v1.CreateWalletResponse
+	10, // 47: wallet.v1.WalletService.RecoverWallet:output_type -> wallet.v1.RecoverWalletResponse
+	12, // 48: wallet.v1.WalletService.CreateShareBackup:output_type -> wallet.v1.CreateShareBackupResponse
+	14, // 49: wallet.v1.WalletService.RecoverWalletFromShares:output_type -> wallet.v1.RecoverWalletFromSharesResponse
+	16, // 50: wallet.v1.WalletService.RevealSeedPhrase:output_type -> wallet.v1.RevealSeedPhraseResponse
+	18, // 51: wallet.v1.WalletService.StartBackupVerification:output_type -> wallet.v1.StartBackupVerificationResponse
+	20, // 52: wallet.v1.WalletService.VerifyBackup:output_type -> wallet.v1.VerifyBackupResponse
+	22, // 53: wallet.v1.WalletService.UpdateWallet:output_type -> wallet.v1.UpdateWalletResponse
+	24, // 54: wallet.v1.WalletService.DeleteWallet:output_type -> wallet.v1.DeleteWalletResponse
+	27, // 55: wallet.v1.WalletService.UnlockWallets:output_type -> wallet.v1.UnlockWalletsResponse
+	29, // 56: wallet.v1.WalletService.LockWallet:output_type -> wallet.v1.LockWalletResponse
+	31, // 57: wallet.v1.WalletService.LockAll:output_type -> wallet.v1.LockAllResponse
+	34, // 58: wallet.v1.WalletService.GetSessionStatus:output_type -> wallet.v1.GetSessionStatusResponse
+	36, // 59: wallet.v1.WalletService.ImportKey:output_type -> wallet.v1.ImportKeyResponse
+	38, // 60: wallet.v1.WalletService.ImportKeystore:output_type -> wallet.v1.ImportKeystoreResponse
+	40, // 61: wallet.v1.WalletService.ChangePassword:output_type -> wallet.v1.ChangePasswordResponse
+	42, // 62: wallet.v1.WalletService.ExportKey:output_type -> wallet.v1.ExportKeyResponse
+	44, // 63: wallet.v1.WalletService.ConvertAddress:output_type -> wallet.v1.ConvertAddressResponse
+	44, // [44:64] is the sub-list for method output_type
+	24, // [24:44] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_v1_wallet_proto_init() }
@@ -860,18 +2887,20 @@ func file_v1_wallet_proto_init() {
 		return
 	}
 	file_v1_types_proto_init()
+	file_v1_wallet_proto_msgTypes[23].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_wallet_proto_rawDesc), len(file_v1_wallet_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   16,
+			NumEnums:      3,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_v1_wallet_proto_goTypes,
 		DependencyIndexes: file_v1_wallet_proto_depIdxs,
+		EnumInfos:         file_v1_wallet_proto_enumTypes,
 		MessageInfos:      file_v1_wallet_proto_msgTypes,
 	}.Build()
 	File_v1_wallet_proto = out.File
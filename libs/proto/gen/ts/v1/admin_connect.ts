@@ -0,0 +1,41 @@
+// @generated by protoc-gen-connect-es v1.7.0 with parameter "target=ts"
+// @generated from file v1/admin.proto (package wallet.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { GetEndpointHealthRequest, GetEndpointHealthResponse, MigrateWalletRequest, MigrateWalletResponse } from "./admin_pb.js";
+import { MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * Maintenance operations on stored wallets and the node connection.
+ *
+ * @generated from service wallet.v1.AdminService
+ */
+export const AdminService = {
+  typeName: "wallet.v1.AdminService",
+  methods: {
+    /**
+     * Moves a wallet created with the legacy SHA-256 key derivation to BIP44.
+     *
+     * @generated from rpc wallet.v1.AdminService.MigrateWallet
+     */
+    migrateWallet: {
+      name: "MigrateWallet",
+      I: MigrateWalletRequest,
+      O: MigrateWalletResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Reports the health of the configured Lotus RPC endpoints in failover order.
+     *
+     * @generated from rpc wallet.v1.AdminService.GetEndpointHealth
+     */
+    getEndpointHealth: {
+      name: "GetEndpointHealth",
+      I: GetEndpointHealthRequest,
+      O: GetEndpointHealthResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
@@ -0,0 +1,215 @@
+// @generated by protoc-gen-es v2.10.0 with parameter "target=ts"
+// @generated from file v1/admin.proto (package wallet.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Address, DerivationScheme, SignedMessage } from "./types_pb";
+import { file_v1_types } from "./types_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file v1/admin.proto.
+ */
+export const file_v1_admin: GenFile = /*@__PURE__*/
+  fileDesc("Cg52MS9hZG1pbi5wcm90bxIJd2FsbGV0LnYxImAKFE1pZ3JhdGVXYWxsZXRSZXF1ZXN0EhEKCXdhbGxldF9pZBgBIAEoAxIQCghwYXNzd29yZBgCIAEoCRIOCgZjb21taXQYAyABKAgSEwoLYnVpbGRfc3dlZXAYBCABKAgi5QEKFU1pZ3JhdGVXYWxsZXRSZXNwb25zZRIRCgl3YWxsZXRfaWQYASABKAMSNgoRZGVyaXZhdGlvbl9zY2hlbWUYAiABKA4yGy53YWxsZXQudjEuRGVyaXZhdGlvblNjaGVtZRIsChBsZWdhY3lfYWRkcmVzc2VzGAMgAygLMhIud2FsbGV0LnYxLkFkZHJlc3MSKQoNbmV3X2FkZHJlc3NlcxgEIAMoCzISLndhbGxldC52MS5BZGRyZXNzEigKBnN3ZWVwcxgFIAMoCzIYLndhbGxldC52MS5TaWduZWRNZXNzYWdlIooCCg5FbmRwb2ludEhlYWx0aBILCgN1cmwYASABKAkSDwoHaGVhbHRoeRgCIAEoCBIPCgdpbl9zeW5jGAMgASgIEhMKC2hlYWRfaGVpZ2h0GAQgASgDEhIKCmxhdGVuY3lfbXMYBSABKAMSHAoUY29uc2VjdXRpdmVfZmFpbHVyZXMYBiABKA0SEgoKbGFzdF9lcnJvchgHIAEoCRIuCgpjaGVja2VkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIxCghyZXRyeV9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAIgBAUILCglfcmV0cnlfYXQiGgoYR2V0RW5kcG9pbnRIZWFsdGhSZXF1ZXN0IkkKGUdldEVuZHBvaW50SGVhbHRoUmVzcG9uc2USLAoJZW5kcG9pbnRzGAEgAygLMhkud2FsbGV0LnYxLkVuZHBvaW50SGVhbHRoMsIBCgxBZG1pblNlcnZpY2USUgoNTWlncmF0ZVdhbGxldBIfLndhbGxldC52MS5NaWdyYXRlV2FsbGV0UmVxdWVzdBogLndhbGxldC52MS5NaWdyYXRlV2FsbGV0UmVzcG9uc2USXgoRR2V0RW5kcG9pbnRIZWFsdGgSIy53YWxsZXQudjEuR2V0RW5kcG9pbnRIZWFsdGhSZXF1ZXN0GiQud2FsbGV0LnYxLkdldEVuZHBvaW50SGVhbHRoUmVzcG9uc2VCPVo7Z2l0aHViLmNvbS9jb2RlbWFlc3RybzY0L2ZpbGFtZW50L2xpYnMvcHJvdG8vZ2VuL2dvL3YxO3BidjFiBnByb3RvMw", [file_v1_types, file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message wallet.v1.MigrateWalletRequest
+ */
+export type MigrateWalletRequest = Message<"wallet.v1.MigrateWalletRequest"> & {
+  /**
+   * @generated from field: int64 wallet_id = 1;
+   */
+  walletId: bigint;
+
+  /**
+   * @generated from field: string password = 2;
+   */
+  password: string;
+
+  /**
+   * Persist the migration. When false only a preview is returned. Refused
+   * while the legacy addresses hold funds.
+   *
+   * @generated from field: bool commit = 3;
+   */
+  commit: boolean;
+
+  /**
+   * Build messages sweeping the legacy f1 and f4 balances to the new
+   * addresses, signed with the legacy key. With commit they are pushed
+   * instead of persisting the migration, which takes another commit once
+   * they landed.
+   *
+   * @generated from field: bool build_sweep = 4;
+   */
+  buildSweep: boolean;
+};
+
+/**
+ * Describes the message wallet.v1.MigrateWalletRequest.
+ * Use `create(MigrateWalletRequestSchema)` to create a new message.
+ */
+export const MigrateWalletRequestSchema: GenMessage<MigrateWalletRequest> = /*@__PURE__*/
+  messageDesc(file_v1_admin, 0);
+
+/**
+ * @generated from message wallet.v1.MigrateWalletResponse
+ */
+export type MigrateWalletResponse = Message<"wallet.v1.MigrateWalletResponse"> & {
+  /**
+   * @generated from field: int64 wallet_id = 1;
+   */
+  walletId: bigint;
+
+  /**
+   * @generated from field: wallet.v1.DerivationScheme derivation_scheme = 2;
+   */
+  derivationScheme: DerivationScheme;
+
+  /**
+   * @generated from field: repeated wallet.v1.Address legacy_addresses = 3;
+   */
+  legacyAddresses: Address[];
+
+  /**
+   * @generated from field: repeated wallet.v1.Address new_addresses = 4;
+   */
+  newAddresses: Address[];
+
+  /**
+   * @generated from field: repeated wallet.v1.SignedMessage sweeps = 5;
+   */
+  sweeps: SignedMessage[];
+};
+
+/**
+ * Describes the message wallet.v1.MigrateWalletResponse.
+ * Use `create(MigrateWalletResponseSchema)` to create a new message.
+ */
+export const MigrateWalletResponseSchema: GenMessage<MigrateWalletResponse> = /*@__PURE__*/
+  messageDesc(file_v1_admin, 1);
+
+/**
+ * @generated from message wallet.v1.EndpointHealth
+ */
+export type EndpointHealth = Message<"wallet.v1.EndpointHealth"> & {
+  /**
+   * @generated from field: string url = 1;
+   */
+  url: string;
+
+  /**
+   * False while the endpoint is failing and being backed off.
+   *
+   * @generated from field: bool healthy = 2;
+   */
+  healthy: boolean;
+
+  /**
+   * False when the endpoint's head trails the highest known head.
+   *
+   * @generated from field: bool in_sync = 3;
+   */
+  inSync: boolean;
+
+  /**
+   * @generated from field: int64 head_height = 4;
+   */
+  headHeight: bigint;
+
+  /**
+   * @generated from field: int64 latency_ms = 5;
+   */
+  latencyMs: bigint;
+
+  /**
+   * @generated from field: uint32 consecutive_failures = 6;
+   */
+  consecutiveFailures: number;
+
+  /**
+   * @generated from field: string last_error = 7;
+   */
+  lastError: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp checked_at = 8;
+   */
+  checkedAt?: Timestamp;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp retry_at = 9;
+   */
+  retryAt?: Timestamp;
+};
+
+/**
+ * Describes the message wallet.v1.EndpointHealth.
+ * Use `create(EndpointHealthSchema)` to create a new message.
+ */
+export const EndpointHealthSchema: GenMessage<EndpointHealth> = /*@__PURE__*/
+  messageDesc(file_v1_admin, 2);
+
+/**
+ * @generated from message wallet.v1.GetEndpointHealthRequest
+ */
+export type GetEndpointHealthRequest = Message<"wallet.v1.GetEndpointHealthRequest"> & {
+};
+
+/**
+ * Describes the message wallet.v1.GetEndpointHealthRequest.
+ * Use `create(GetEndpointHealthRequestSchema)` to create a new message.
+ */
+export const GetEndpointHealthRequestSchema: GenMessage<GetEndpointHealthRequest> = /*@__PURE__*/
+  messageDesc(file_v1_admin, 3);
+
+/**
+ * @generated from message wallet.v1.GetEndpointHealthResponse
+ */
+export type GetEndpointHealthResponse = Message<"wallet.v1.GetEndpointHealthResponse"> & {
+  /**
+   * @generated from field: repeated wallet.v1.EndpointHealth endpoints = 1;
+   */
+  endpoints: EndpointHealth[];
+};
+
+/**
+ * Describes the message wallet.v1.GetEndpointHealthResponse.
+ * Use `create(GetEndpointHealthResponseSchema)` to create a new message.
+ */
+export const GetEndpointHealthResponseSchema: GenMessage<GetEndpointHealthResponse> = /*@__PURE__*/
+  messageDesc(file_v1_admin, 4);
+
+/**
+ * Maintenance operations on stored wallets and the node connection.
+ *
+ * @generated from service wallet.v1.AdminService
+ */
+export const AdminService: GenService<{
+  /**
+   * Moves a wallet created with the legacy SHA-256 key derivation to BIP44.
+   *
+   * @generated from rpc wallet.v1.AdminService.MigrateWallet
+   */
+  migrateWallet: {
+    methodKind: "unary";
+    input: typeof MigrateWalletRequestSchema;
+    output: typeof MigrateWalletResponseSchema;
+  },
+  /**
+   * Reports the health of the configured Lotus RPC endpoints in failover order.
+   *
+   * @generated from rpc wallet.v1.AdminService.GetEndpointHealth
+   */
+  getEndpointHealth: {
+    methodKind: "unary";
+    input: typeof GetEndpointHealthRequestSchema;
+    output: typeof GetEndpointHealthResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_v1_admin, 0);
+
@@ -4,13 +4,15 @@
 
 import type { GenEnum, GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
 import { enumDesc, fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file v1/errors.proto.
  */
 export const file_v1_errors: GenFile = /*@__PURE__*/
-  fileDesc("Cg92MS9lcnJvcnMucHJvdG8SCXdhbGxldC52MSIwCg5GaWVsZFZpb2xhdGlvbhINCgVmaWVsZBgBIAEoCRIPCgdtZXNzYWdlGAIgASgJInIKDEVycm9yRGV0YWlscxIiCgRjb2RlGAEgASgOMhQud2FsbGV0LnYxLkVycm9yQ29kZRIPCgdtZXNzYWdlGAIgASgJEi0KCnZpb2xhdGlvbnMYAyADKAsyGS53YWxsZXQudjEuRmllbGRWaW9sYXRpb24qUwoJRXJyb3JDb2RlEggKBE5PTkUQABINCglOT1RfRk9VTkQQARIVChFWQUxJREFUSU9OX0ZBSUxFRBACEhYKEklOU1VGRklDSUVOVF9GVU5EUxADQj1aO2dpdGh1Yi5jb20vY29kZW1hZXN0cm82NC9maWxhbWVudC9saWJzL3Byb3RvL2dlbi9nby92MTtwYnYxYgZwcm90bzM");
+  fileDesc("Cg92MS9lcnJvcnMucHJvdG8SCXdhbGxldC52MSIwCg5GaWVsZFZpb2xhdGlvbhINCgVmaWVsZBgBIAEoCRIPCgdtZXNzYWdlGAIgASgJIvABCgxFcnJvckRldGFpbHMSIgoEY29kZRgBIAEoDjIULndhbGxldC52MS5FcnJvckNvZGUSDwoHbWVzc2FnZRgCIAEoCRItCgp2aW9sYXRpb25zGAMgAygLMhkud2FsbGV0LnYxLkZpZWxkVmlvbGF0aW9uEh8KEnJlbWFpbmluZ19hdHRlbXB0cxgEIAEoBUgAiAEBEjQKC3JldHJ5X2FmdGVyGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgBiAEBQhUKE19yZW1haW5pbmdfYXR0ZW1wdHNCDgoMX3JldHJ5X2FmdGVyKpABCglFcnJvckNvZGUSCAoETk9ORRAAEg0KCU5PVF9GT1VORBABEhUKEVZBTElEQVRJT05fRkFJTEVEEAISFgoSSU5TVUZGSUNJRU5UX0ZVTkRTEAMSEgoOQUxSRUFEWV9FWElTVFMQBBIQCgxSQVRFX0xJTUlURUQQBRIVChFUT09fTUFOWV9BVFRFTVBUUxAGQj1aO2dpdGh1Yi5jb20vY29kZW1hZXN0cm82NC9maWxhbWVudC9saWJzL3Byb3RvL2dlbi9nby92MTtwYnYxYgZwcm90bzM", [file_google_protobuf_timestamp]);
 
 /**
  * @generated from message wallet.v1.FieldViolation
@@ -52,6 +54,21 @@ export type ErrorDetails = Message<"wallet.v1.ErrorDetails"> & {
    * @generated from field: repeated wallet.v1.FieldViolation violations = 3;
    */
   violations: FieldViolation[];
+
+  /**
+   * Set when a password check failed or was refused, counting down to a
+   * lockout of the wallet.
+   *
+   * @generated from field: optional int32 remaining_attempts = 4;
+   */
+  remainingAttempts?: number;
+
+  /**
+   * When the next password check is allowed.
+   *
+   * @generated from field: optional google.protobuf.Timestamp retry_after = 5;
+   */
+  retryAfter?: Timestamp;
 };
 
 /**
@@ -84,6 +101,21 @@ export enum ErrorCode {
    * @generated from enum value: INSUFFICIENT_FUNDS = 3;
    */
   INSUFFICIENT_FUNDS = 3,
+
+  /**
+   * @generated from enum value: ALREADY_EXISTS = 4;
+   */
+  ALREADY_EXISTS = 4,
+
+  /**
+   * @generated from enum value: RATE_LIMITED = 5;
+   */
+  RATE_LIMITED = 5,
+
+  /**
+   * @generated from enum value: TOO_MANY_ATTEMPTS = 6;
+   */
+  TOO_MANY_ATTEMPTS = 6,
 }
 
 /**
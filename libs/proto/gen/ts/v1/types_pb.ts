@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file v1/types.proto.
  */
 export const file_v1_types: GenFile = /*@__PURE__*/
-  fileDesc("Cg52MS90eXBlcy5wcm90bxIJd2FsbGV0LnYxIj4KB0FkZHJlc3MSJAoEdHlwZRgBIAEoDjIWLndhbGxldC52MS5BZGRyZXNzVHlwZRINCgV2YWx1ZRgCIAEoCSI5CgZBbW91bnQSDQoFdmFsdWUYASABKAkSDgoGdGlja2VyGAIgASgJEhAKCGRlY2ltYWxzGAMgASgNIrgBCgZXYWxsZXQSEQoJd2FsbGV0X2lkGAEgASgDEhIKCmlzX2RlZmF1bHQYAiABKAgSDAoEbmFtZRgDIAEoCRIlCglhZGRyZXNzZXMYBCADKAsyEi53YWxsZXQudjEuQWRkcmVzcxIiCgdiYWxhbmNlGAUgASgLMhEud2FsbGV0LnYxLkFtb3VudBIuCgpjcmVhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJQCg9UcmFuc2FjdGlvblR5cGUSLgoEdHlwZRgBIAEoDjIgLndhbGxldC52MS5UcmFuc2FjdGlvbkFjdGlvblR5cGUSDQoFdmFsdWUYAiABKAkigQEKEVRyYW5zYWN0aW9uU3RhdHVzEi4KBHR5cGUYASABKA4yIC53YWxsZXQudjEuVHJhbnNhY3Rpb25TdGF0dXNUeXBlEg8KB21lc3NhZ2UYAiABKAkSFQoNY29uZmlybWF0aW9ucxgDIAEoBBIUCgxibG9ja19oZWlnaHQYBCABKAQi8gIKC1RyYW5zYWN0aW9uEgoKAmlkGAEgASgJEigKBHR5cGUYAiABKAsyGi53YWxsZXQudjEuVHJhbnNhY3Rpb25UeXBlEiwKBnN0YXR1cxgDIAEoCzIcLndhbGxldC52MS5UcmFuc2FjdGlvblN0YXR1cxIhCgZhbW91bnQYBCABKAsyES53YWxsZXQudjEuQW1vdW50EioKDnNvdXJjZV9hZGRyZXNzGAUgASgLMhIud2FsbGV0LnYxLkFkZHJlc3MSLwoTZGVzdGluYXRpb25fYWRkcmVzcxgGIAEoCzISLndhbGxldC52MS5BZGRyZXNzEh4KA2ZlZRgHIAEoCzIRLndhbGxldC52MS5BbW91bnQSLgoKY3JlYXRlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLwoLY29uZmlybWVkX3QYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIjMKCFNldHRpbmdzEicKB25ldHdvcmsYASABKA4yFi53YWxsZXQudjEuTmV0d29ya1R5cGUqTAoLQWRkcmVzc1R5cGUSEwoPQUREUkVTU19UWVBFX0YxEAASEwoPQUREUkVTU19UWVBFX0Y0EAESEwoPQUREUkVTU19UWVBFXzBYEAIqpwEKFVRyYW5zYWN0aW9uQWN0aW9uVHlwZRIcChhUUkFOU0FDVElPTl9UWVBFX1VOS05PV04QABIZChVUUkFOU0FDVElPTl9UWVBFX1NFTkQQARIcChhUUkFOU0FDVElPTl9UWVBFX1JFQ0VJVkUQAhIYChRUUkFOU0FDVElPTl9UWVBFX0ZFRRADEh0KGVRSQU5TQUNUSU9OX1RZUEVfSU5URVJOQUwQBCq5AQoVVHJhbnNhY3Rpb25TdGF0dXNUeXBlEh4KGlRSQU5TQUNUSU9OX1NUQVRVU19VTktOT1dOEAASHgoaVFJBTlNBQ1RJT05fU1RBVFVTX1BFTkRJTkcQARIgChxUUkFOU0FDVElPTl9TVEFUVVNfQ09ORklSTUVEEAISHQoZVFJBTlNBQ1RJT05fU1RBVFVTX0ZBSUxFRBADEh8KG1RSQU5TQUNUSU9OX1NUQVRVU19DQU5DRUxFRBAEKj8KC05ldHdvcmtUeXBlEhMKD05FVFdPUktfTUFJTk5FVBAAEhsKF05FVFdPUktfQ0FMSUJSQVRJT05fTkVUEAFCPVo7Z2l0aHViLmNvbS9jb2RlbWFlc3RybzY0L2ZpbGFtZW50L2xpYnMvcHJvdG8vZ2VuL2dvL3YxO3BidjFiBnByb3RvMw", [file_google_protobuf_timestamp]);
+  fileDesc("Cg52MS90eXBlcy5wcm90bxIJd2FsbGV0LnYxIlAKB0FkZHJlc3MSJAoEdHlwZRgBIAEoDjIWLndhbGxldC52MS5BZGRyZXNzVHlwZRINCgV2YWx1ZRgCIAEoCRIQCghhY3Rvcl9pZBgDIAEoCSI5CgZBbW91bnQSDQoFdmFsdWUYASABKAkSDgoGdGlja2VyGAIgASgJEhAKCGRlY2ltYWxzGAMgASgNIp4CCgZXYWxsZXQSEQoJd2FsbGV0X2lkGAEgASgDEhIKCmlzX2RlZmF1bHQYAiABKAgSDAoEbmFtZRgDIAEoCRIlCglhZGRyZXNzZXMYBCADKAsyEi53YWxsZXQudjEuQWRkcmVzcxIiCgdiYWxhbmNlGAUgASgLMhEud2FsbGV0LnYxLkFtb3VudBIuCgpjcmVhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIQCghhY3Rvcl9pZBgHIAEoCRI7ChJiYWNrdXBfdmVyaWZpZWRfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSACIAQFCFQoTX2JhY2t1cF92ZXJpZmllZF9hdCKZAQoPVW5zaWduZWRNZXNzYWdlEgwKBGZyb20YASABKAkSCgoCdG8YAiABKAkSIAoFdmFsdWUYAyABKAsyES53YWxsZXQudjEuQW1vdW50Eg0KBW5vbmNlGAQgASgEEhEKCWdhc19saW1pdBgFIAEoAxITCgtnYXNfZmVlX2NhcBgGIAEoCRITCgtnYXNfcHJlbWl1bRgHIAEoCSJcCg1TaWduZWRNZXNzYWdlEisKB21lc3NhZ2UYASABKAsyGi53YWxsZXQudjEuVW5zaWduZWRNZXNzYWdlEgsKA2NpZBgCIAEoCRIRCglzaWduYXR1cmUYAyABKAwiUAoPVHJhbnNhY3Rpb25UeXBlEi4KBHR5cGUYASABKA4yIC53YWxsZXQudjEuVHJhbnNhY3Rpb25BY3Rpb25UeXBlEg0KBXZhbHVlGAIgASgJIoEBChFUcmFuc2FjdGlvblN0YXR1cxIuCgR0eXBlGAEgASgOMiAud2FsbGV0LnYxLlRyYW5zYWN0aW9uU3RhdHVzVHlwZRIPCgdtZXNzYWdlGAIgASgJEhUKDWNvbmZpcm1hdGlvbnMYAyABKAQSFAoMYmxvY2tfaGVpZ2h0GAQgASgEIvICCgtUcmFuc2FjdGlvbhIKCgJpZBgBIAEoCRIoCgR0eXBlGAIgASgLMhoud2FsbGV0LnYxLlRyYW5zYWN0aW9uVHlwZRIsCgZzdGF0dXMYAyABKAsyHC53YWxsZXQudjEuVHJhbnNhY3Rpb25TdGF0dXMSIQoGYW1vdW50GAQgASgLMhEud2FsbGV0LnYxLkFtb3VudBIqCg5zb3VyY2VfYWRkcmVzcxgFIAEoCzISLndhbGxldC52MS5BZGRyZXNzEi8KE2Rlc3RpbmF0aW9uX2FkZHJlc3MYBiABKAsyEi53YWxsZXQudjEuQWRkcmVzcxIeCgNmZWUYByABKAsyES53YWxsZXQudjEuQW1vdW50Ei4KCmNyZWF0ZWRfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi8KC2NvbmZpcm1lZF90GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIzCghTZXR0aW5ncxInCgduZXR3b3JrGAEgASgOMhYud2FsbGV0LnYxLk5ldHdvcmtUeXBlKosBCgtBZGRyZXNzVHlwZRITCg9BRERSRVNTX1RZUEVfRjEQABITCg9BRERSRVNTX1RZUEVfRjQQARITCg9BRERSRVNTX1RZUEVfMFgQAhITCg9BRERSRVNTX1RZUEVfRjMQAxITCg9BRERSRVNTX1RZUEVfRjAQBBITCg9BRERSRVNTX1RZUEVfRjIQBSptChBEZXJpdmF0aW9uU2NoZW1lEhwKGERFUklWQVRJT05fU0NIRU1FX0xFR0FDWRAAEhsKF0RFUklWQVRJT05fU0NIRU1FX0JJUDQ0EAESHgoaREVSSVZBVElPTl9TQ0hFTUVfSU1QT1JURUQQAiqnAQoVVHJhbnNhY3Rpb25BY3Rpb25UeXBlEhwKGFRSQU5TQUNUSU9OX1RZUEVfVU5LTk9XThAAEhkKFVRSQU5TQUNUSU9OX1RZUEVfU0VORBABEhwKGFRSQU5TQUNUSU9OX1RZUEVfUkVDRUlWRRACEhgKFFRSQU5TQUNUSU9OX1RZUEVfRkVFEAMSHQoZVFJBTlNBQ1RJT05fVFlQRV9JTlRFUk5BTBAEKrkBChVUcmFuc2FjdGlvblN0YXR1c1R5cGUSHgoaVFJBTlNBQ1RJT05fU1RBVFVTX1VOS05PV04QABIeChpUUkFOU0FDVElPTl9TVEFUVVNfUEVORElORxABEiAKHFRSQU5TQUNUSU9OX1NUQVRVU19DT05GSVJNRUQQAhIdChlUUkFOU0FDVElPTl9TVEFUVVNfRkFJTEVEEAMSHwobVFJBTlNBQ1RJT05fU1RBVFVTX0NBTkNFTEVEEAQqPwoLTmV0d29ya1R5cGUSEwoPTkVUV09SS19NQUlOTkVUEAASGwoXTkVUV09SS19DQUxJQlJBVElPTl9ORVQQAUI9WjtnaXRodWIuY29tL2NvZGVtYWVzdHJvNjQvZmlsYW1lbnQvbGlicy9wcm90by9nZW4vZ28vdjE7cGJ2MWIGcHJvdG8z", [file_google_protobuf_timestamp]);
 
 /**
  * @generated from message wallet.v1.Address
@@ -27,6 +27,13 @@ export type Address = Message<"wallet.v1.Address"> & {
    * @generated from field: string value = 2;
    */
   value: string;
+
+  /**
+   * The f0 ID address, empty until the actor exists on chain.
+   *
+   * @generated from field: string actor_id = 3;
+   */
+  actorId: string;
 };
 
 /**
@@ -96,6 +103,20 @@ export type Wallet = Message<"wallet.v1.Wallet"> & {
    * @generated from field: google.protobuf.Timestamp created_at = 6;
    */
   createdAt?: Timestamp;
+
+  /**
+   * The f0 ID of the primary f1 address, empty until it exists on chain.
+   *
+   * @generated from field: string actor_id = 7;
+   */
+  actorId: string;
+
+  /**
+   * When the user last confirmed holding the seed phrase, unset until then.
+   *
+   * @generated from field: optional google.protobuf.Timestamp backup_verified_at = 8;
+   */
+  backupVerifiedAt?: Timestamp;
 };
 
 /**
@@ -105,6 +126,88 @@ export type Wallet = Message<"wallet.v1.Wallet"> & {
 export const WalletSchema: GenMessage<Wallet> = /*@__PURE__*/
   messageDesc(file_v1_types, 2);
 
+/**
+ * An unsigned Filecoin message with its gas already estimated.
+ *
+ * @generated from message wallet.v1.UnsignedMessage
+ */
+export type UnsignedMessage = Message<"wallet.v1.UnsignedMessage"> & {
+  /**
+   * @generated from field: string from = 1;
+   */
+  from: string;
+
+  /**
+   * @generated from field: string to = 2;
+   */
+  to: string;
+
+  /**
+   * @generated from field: wallet.v1.Amount value = 3;
+   */
+  value?: Amount;
+
+  /**
+   * @generated from field: uint64 nonce = 4;
+   */
+  nonce: bigint;
+
+  /**
+   * @generated from field: int64 gas_limit = 5;
+   */
+  gasLimit: bigint;
+
+  /**
+   * @generated from field: string gas_fee_cap = 6;
+   */
+  gasFeeCap: string;
+
+  /**
+   * @generated from field: string gas_premium = 7;
+   */
+  gasPremium: string;
+};
+
+/**
+ * Describes the message wallet.v1.UnsignedMessage.
+ * Use `create(UnsignedMessageSchema)` to create a new message.
+ */
+export const UnsignedMessageSchema: GenMessage<UnsignedMessage> = /*@__PURE__*/
+  messageDesc(file_v1_types, 3);
+
+/**
+ * A Filecoin message with its signature, ready to be pushed to the mpool.
+ *
+ * @generated from message wallet.v1.SignedMessage
+ */
+export type SignedMessage = Message<"wallet.v1.SignedMessage"> & {
+  /**
+   * @generated from field: wallet.v1.UnsignedMessage message = 1;
+   */
+  message?: UnsignedMessage;
+
+  /**
+   * @generated from field: string cid = 2;
+   */
+  cid: string;
+
+  /**
+   * The signature data, its type follows from message.from: secp256k1 over
+   * the message CID for f1, BLS over the CID for f3 and secp256k1 over the
+   * Keccak-256 hash of the equivalent Ethereum transaction for f4 senders.
+   *
+   * @generated from field: bytes signature = 3;
+   */
+  signature: Uint8Array;
+};
+
+/**
+ * Describes the message wallet.v1.SignedMessage.
+ * Use `create(SignedMessageSchema)` to create a new message.
+ */
+export const SignedMessageSchema: GenMessage<SignedMessage> = /*@__PURE__*/
+  messageDesc(file_v1_types, 4);
+
 /**
  * @generated from message wallet.v1.TransactionType
  */
@@ -125,7 +228,7 @@ export type TransactionType = Message<"wallet.v1.TransactionType"> & {
  * Use `create(TransactionTypeSchema)` to create a new message.
  */
 export const TransactionTypeSchema: GenMessage<TransactionType> = /*@__PURE__*/
-  messageDesc(file_v1_types, 3);
+  messageDesc(file_v1_types, 5);
 
 /**
  * @generated from message wallet.v1.TransactionStatus
@@ -163,7 +266,7 @@ export type TransactionStatus = Message<"wallet.v1.TransactionStatus"> & {
  * Use `create(TransactionStatusSchema)` to create a new message.
  */
 export const TransactionStatusSchema: GenMessage<TransactionStatus> = /*@__PURE__*/
-  messageDesc(file_v1_types, 4);
+  messageDesc(file_v1_types, 6);
 
 /**
  * @generated from message wallet.v1.Transaction
@@ -220,7 +323,7 @@ export type Transaction = Message<"wallet.v1.Transaction"> & {
  * Use `create(TransactionSchema)` to create a new message.
  */
 export const TransactionSchema: GenMessage<Transaction> = /*@__PURE__*/
-  messageDesc(file_v1_types, 5);
+  messageDesc(file_v1_types, 7);
 
 /**
  * @generated from message wallet.v1.Settings
@@ -237,7 +340,7 @@ export type Settings = Message<"wallet.v1.Settings"> & {
  * Use `create(SettingsSchema)` to create a new message.
  */
 export const SettingsSchema: GenMessage<Settings> = /*@__PURE__*/
-  messageDesc(file_v1_types, 6);
+  messageDesc(file_v1_types, 8);
 
 /**
  * @generated from enum wallet.v1.AddressType
@@ -257,6 +360,21 @@ export enum AddressType {
    * @generated from enum value: ADDRESS_TYPE_0X = 2;
    */
   ADDRESS_TYPE_0X = 2,
+
+  /**
+   * @generated from enum value: ADDRESS_TYPE_F3 = 3;
+   */
+  ADDRESS_TYPE_F3 = 3,
+
+  /**
+   * @generated from enum value: ADDRESS_TYPE_F0 = 4;
+   */
+  ADDRESS_TYPE_F0 = 4,
+
+  /**
+   * @generated from enum value: ADDRESS_TYPE_F2 = 5;
+   */
+  ADDRESS_TYPE_F2 = 5,
 }
 
 /**
@@ -265,6 +383,32 @@ export enum AddressType {
 export const AddressTypeSchema: GenEnum<AddressType> = /*@__PURE__*/
   enumDesc(file_v1_types, 0);
 
+/**
+ * @generated from enum wallet.v1.DerivationScheme
+ */
+export enum DerivationScheme {
+  /**
+   * @generated from enum value: DERIVATION_SCHEME_LEGACY = 0;
+   */
+  DERIVATION_SCHEME_LEGACY = 0,
+
+  /**
+   * @generated from enum value: DERIVATION_SCHEME_BIP44 = 1;
+   */
+  DERIVATION_SCHEME_BIP44 = 1,
+
+  /**
+   * @generated from enum value: DERIVATION_SCHEME_IMPORTED = 2;
+   */
+  DERIVATION_SCHEME_IMPORTED = 2,
+}
+
+/**
+ * Describes the enum wallet.v1.DerivationScheme.
+ */
+export const DerivationSchemeSchema: GenEnum<DerivationScheme> = /*@__PURE__*/
+  enumDesc(file_v1_types, 1);
+
 /**
  * @generated from enum wallet.v1.TransactionActionType
  */
@@ -299,7 +443,7 @@ export enum TransactionActionType {
  * Describes the enum wallet.v1.TransactionActionType.
  */
 export const TransactionActionTypeSchema: GenEnum<TransactionActionType> = /*@__PURE__*/
-  enumDesc(file_v1_types, 1);
+  enumDesc(file_v1_types, 2);
 
 /**
  * @generated from enum wallet.v1.TransactionStatusType
@@ -335,7 +479,7 @@ export enum TransactionStatusType {
  * Describes the enum wallet.v1.TransactionStatusType.
  */
 export const TransactionStatusTypeSchema: GenEnum<TransactionStatusType> = /*@__PURE__*/
-  enumDesc(file_v1_types, 2);
+  enumDesc(file_v1_types, 3);
 
 /**
  * @generated from enum wallet.v1.NetworkType
@@ -356,5 +500,5 @@ export enum NetworkType {
  * Describes the enum wallet.v1.NetworkType.
  */
 export const NetworkTypeSchema: GenEnum<NetworkType> = /*@__PURE__*/
-  enumDesc(file_v1_types, 3);
+  enumDesc(file_v1_types, 4);
 
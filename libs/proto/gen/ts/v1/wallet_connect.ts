@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CreateWalletRequest, CreateWalletResponse, DeleteWalletRequest, DeleteWalletResponse, GetWalletRequest, GetWalletResponse, GetWalletsRequest, GetWalletsResponse, RecoverWalletRequest, RecoverWalletResponse, UnlockWalletsRequest, UnlockWalletsResponse, UpdateWalletRequest, UpdateWalletResponse } from "./wallet_pb.js";
+import { ChangePasswordRequest, ChangePasswordResponse, ConvertAddressRequest, ConvertAddressResponse, CreateShareBackupRequest, CreateShareBackupResponse, CreateWalletRequest, CreateWalletResponse, DeleteWalletRequest, DeleteWalletResponse, ExportKeyRequest, ExportKeyResponse, GetSessionStatusRequest, GetSessionStatusResponse, GetWalletRequest, GetWalletResponse, GetWalletsRequest, GetWalletsResponse, ImportKeyRequest, ImportKeyResponse, ImportKeystoreRequest, ImportKeystoreResponse, LockAllRequest, LockAllResponse, LockWalletRequest, LockWalletResponse, RecoverWalletFromSharesRequest, RecoverWalletFromSharesResponse, RecoverWalletRequest, RecoverWalletResponse, RevealSeedPhraseRequest, RevealSeedPhraseResponse, StartBackupVerificationRequest, StartBackupVerificationResponse, UnlockWalletsRequest, UnlockWalletsResponse, UpdateWalletRequest, UpdateWalletResponse, VerifyBackupRequest, VerifyBackupResponse } from "./wallet_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -56,6 +56,64 @@ export const WalletService = {
       O: RecoverWalletResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Splits the seed of a wallet into SLIP-39 shares, threshold of which
+     * recover it. Requires the wallet password and is audited.
+     *
+     * @generated from rpc wallet.v1.WalletService.CreateShareBackup
+     */
+    createShareBackup: {
+      name: "CreateShareBackup",
+      I: CreateShareBackupRequest,
+      O: CreateShareBackupResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Recovers a wallet from SLIP-39 shares instead of a seed phrase.
+     *
+     * @generated from rpc wallet.v1.WalletService.RecoverWalletFromShares
+     */
+    recoverWalletFromShares: {
+      name: "RecoverWalletFromShares",
+      I: RecoverWalletFromSharesRequest,
+      O: RecoverWalletFromSharesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Returns the seed phrase of a wallet after checking its password. Reveals
+     * are audited.
+     *
+     * @generated from rpc wallet.v1.WalletService.RevealSeedPhrase
+     */
+    revealSeedPhrase: {
+      name: "RevealSeedPhrase",
+      I: RevealSeedPhraseRequest,
+      O: RevealSeedPhraseResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Picks random seed phrase positions for the user to fill in.
+     *
+     * @generated from rpc wallet.v1.WalletService.StartBackupVerification
+     */
+    startBackupVerification: {
+      name: "StartBackupVerification",
+      I: StartBackupVerificationRequest,
+      O: StartBackupVerificationResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Checks the words of the pending challenge and marks the backup as
+     * verified. A wrong answer needs a new challenge.
+     *
+     * @generated from rpc wallet.v1.WalletService.VerifyBackup
+     */
+    verifyBackup: {
+      name: "VerifyBackup",
+      I: VerifyBackupRequest,
+      O: VerifyBackupResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Updates mutable metadata associated with a wallet (name, default status).
      *
@@ -79,6 +137,9 @@ export const WalletService = {
       kind: MethodKind.Unary,
     },
     /**
+     * Unlocks every wallet that uses the given password and reports the
+     * outcome per wallet.
+     *
      * @generated from rpc wallet.v1.WalletService.UnlockWallets
      */
     unlockWallets: {
@@ -87,6 +148,96 @@ export const WalletService = {
       O: UnlockWalletsResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Locks a single wallet.
+     *
+     * @generated from rpc wallet.v1.WalletService.LockWallet
+     */
+    lockWallet: {
+      name: "LockWallet",
+      I: LockWalletRequest,
+      O: LockWalletResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Locks every wallet.
+     *
+     * @generated from rpc wallet.v1.WalletService.LockAll
+     */
+    lockAll: {
+      name: "LockAll",
+      I: LockAllRequest,
+      O: LockAllResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Lists the unlocked wallets and when each one locks.
+     *
+     * @generated from rpc wallet.v1.WalletService.GetSessionStatus
+     */
+    getSessionStatus: {
+      name: "GetSessionStatus",
+      I: GetSessionStatusRequest,
+      O: GetSessionStatusResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Creates a wallet from a single private key. It has no seed phrase.
+     *
+     * @generated from rpc wallet.v1.WalletService.ImportKey
+     */
+    importKey: {
+      name: "ImportKey",
+      I: ImportKeyRequest,
+      O: ImportKeyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Creates a wallet from an Ethereum V3 keystore file.
+     *
+     * @generated from rpc wallet.v1.WalletService.ImportKeystore
+     */
+    importKeystore: {
+      name: "ImportKeystore",
+      I: ImportKeystoreRequest,
+      O: ImportKeystoreResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Re-encrypts one or all wallets under a new password.
+     *
+     * @generated from rpc wallet.v1.WalletService.ChangePassword
+     */
+    changePassword: {
+      name: "ChangePassword",
+      I: ChangePasswordRequest,
+      O: ChangePasswordResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Exports the private key of a wallet account. Attempts are audited and
+     * rate limited.
+     *
+     * @generated from rpc wallet.v1.WalletService.ExportKey
+     */
+    exportKey: {
+      name: "ExportKey",
+      I: ExportKeyRequest,
+      O: ExportKeyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Converts an address to all its equivalent forms, looking up chain state
+     * where needed.
+     *
+     * @generated from rpc wallet.v1.WalletService.ConvertAddress
+     */
+    convertAddress: {
+      name: "ConvertAddress",
+      I: ConvertAddressRequest,
+      O: ConvertAddressResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
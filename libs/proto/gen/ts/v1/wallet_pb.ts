@@ -2,8 +2,8 @@
 // @generated from file v1/wallet.proto (package wallet.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import type { Amount, Wallet } from "./types_pb";
 import { file_v1_types } from "./types_pb";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
@@ -14,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file v1/wallet.proto.
  */
 export const file_v1_wallet: GenFile = /*@__PURE__*/
-  fileDesc("Cg92MS93YWxsZXQucHJvdG8SCXdhbGxldC52MSIlChBHZXRXYWxsZXRSZXF1ZXN0EhEKCXdhbGxldF9pZBgBIAEoAyKOAgoRR2V0V2FsbGV0UmVzcG9uc2USEQoJd2FsbGV0X2lkGAEgASgDEhIKCmlzX2RlZmF1bHQYAiABKAgSDAoEbmFtZRgDIAEoCRI+CglhZGRyZXNzZXMYBCADKAsyKy53YWxsZXQudjEuR2V0V2FsbGV0UmVzcG9uc2UuQWRkcmVzc2VzRW50cnkSIgoHYmFsYW5jZRgFIAEoCzIRLndhbGxldC52MS5BbW91bnQSLgoKY3JlYXRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAaMAoOQWRkcmVzc2VzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASI2ChFHZXRXYWxsZXRzUmVxdWVzdBIhCgZ3YWxsZXQYASABKAsyES53YWxsZXQudjEuV2FsbGV0IjgKEkdldFdhbGxldHNSZXNwb25zZRIiCgd3YWxsZXRzGAEgAygLMhEud2FsbGV0LnYxLldhbGxldCJPChNDcmVhdGVXYWxsZXRSZXF1ZXN0EgwKBG5hbWUYASABKAkSEAoIcGFzc3dvcmQYAiABKAkSGAoQY29uZmlybV9wYXNzd29yZBgDIAEoCSKsAQoUQ3JlYXRlV2FsbGV0UmVzcG9uc2USCgoCaWQYASABKAMSEwoLc2VlZF9waHJhc2UYAiABKAkSQQoJYWRkcmVzc2VzGAMgAygLMi4ud2FsbGV0LnYxLkNyZWF0ZVdhbGxldFJlc3BvbnNlLkFkZHJlc3Nlc0VudHJ5GjAKDkFkZHJlc3Nlc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEibAoUUmVjb3ZlcldhbGxldFJlcXVlc3QSEwoLd2FsbGV0X25hbWUYASABKAkSEwoLc2VlZF9waHJhc2UYAiABKAkSEAoIcGFzc3dvcmQYAyABKAkSGAoQY29uZmlybV9wYXNzd29yZBgEIAEoCSIqChVSZWNvdmVyV2FsbGV0UmVzcG9uc2USEQoJd2FsbGV0X2lkGAEgASgDIigKE1VwZGF0ZVdhbGxldFJlcXVlc3QSEQoJd2FsbGV0X2lkGAEgASgDIjkKFFVwZGF0ZVdhbGxldFJlc3BvbnNlEiEKBndhbGxldBgBIAEoCzIRLndhbGxldC52MS5XYWxsZXQiOgoTRGVsZXRlV2FsbGV0UmVxdWVzdBIRCgl3YWxsZXRfaWQYASABKAMSEAoIcGFzc3dvcmQYAiABKAkiFgoURGVsZXRlV2FsbGV0UmVzcG9uc2UiKAoUVW5sb2NrV2FsbGV0c1JlcXVlc3QSEAoIcGFzc3dvcmQYASABKAkiFwoVVW5sb2NrV2FsbGV0c1Jlc3BvbnNlMr0ECg1XYWxsZXRTZXJ2aWNlEkYKCUdldFdhbGxldBIbLndhbGxldC52MS5HZXRXYWxsZXRSZXF1ZXN0Ghwud2FsbGV0LnYxLkdldFdhbGxldFJlc3BvbnNlEkkKCkdldFdhbGxldHMSHC53YWxsZXQudjEuR2V0V2FsbGV0c1JlcXVlc3QaHS53YWxsZXQudjEuR2V0V2FsbGV0c1Jlc3BvbnNlEk8KDENyZWF0ZVdhbGxldBIeLndhbGxldC52MS5DcmVhdGVXYWxsZXRSZXF1ZXN0Gh8ud2FsbGV0LnYxLkNyZWF0ZVdhbGxldFJlc3BvbnNlElIKDVJlY292ZXJXYWxsZXQSHy53YWxsZXQudjEuUmVjb3ZlcldhbGxldFJlcXVlc3QaIC53YWxsZXQudjEuUmVjb3ZlcldhbGxldFJlc3BvbnNlEk8KDFVwZGF0ZVdhbGxldBIeLndhbGxldC52MS5VcGRhdGVXYWxsZXRSZXF1ZXN0Gh8ud2FsbGV0LnYxLlVwZGF0ZVdhbGxldFJlc3BvbnNlEk8KDERlbGV0ZVdhbGxldBIeLndhbGxldC52MS5EZWxldGVXYWxsZXRSZXF1ZXN0Gh8ud2FsbGV0LnYxLkRlbGV0ZVdhbGxldFJlc3BvbnNlElIKDVVubG9ja1dhbGxldHMSHy53YWxsZXQudjEuVW5sb2NrV2FsbGV0c1JlcXVlc3QaIC53YWxsZXQudjEuVW5sb2NrV2FsbGV0c1Jlc3BvbnNlQj1aO2dpdGh1Yi5jb20vY29kZW1hZXN0cm82NC9maWxhbWVudC9saWJzL3Byb3RvL2dlbi9nby92MTtwYnYxYgZwcm90bzM", [file_v1_types, file_google_protobuf_timestamp]);
+  fileDesc("Cg92MS93YWxsZXQucHJvdG8SCXdhbGxldC52MSIlChBHZXRXYWxsZXRSZXF1ZXN0EhEKCXdhbGxldF9pZBgBIAEoAyKgAgoRR2V0V2FsbGV0UmVzcG9uc2USEQoJd2FsbGV0X2lkGAEgASgDEhIKCmlzX2RlZmF1bHQYAiABKAgSDAoEbmFtZRgDIAEoCRI+CglhZGRyZXNzZXMYBCADKAsyKy53YWxsZXQudjEuR2V0V2FsbGV0UmVzcG9uc2UuQWRkcmVzc2VzRW50cnkSIgoHYmFsYW5jZRgFIAEoCzIRLndhbGxldC52MS5BbW91bnQSLgoKY3JlYXRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEAoIYWN0b3JfaWQYByABKAkaMAoOQWRkcmVzc2VzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASI2ChFHZXRXYWxsZXRzUmVxdWVzdBIhCgZ3YWxsZXQYASABKAsyES53YWxsZXQudjEuV2FsbGV0IjgKEkdldFdhbGxldHNSZXNwb25zZRIiCgd3YWxsZXRzGAEgAygLMhEud2FsbGV0LnYxLldhbGxldCKmAQoTQ3JlYXRlV2FsbGV0UmVxdWVzdBIMCgRuYW1lGAEgASgJEhAKCHBhc3N3b3JkGAIgASgJEhgKEGNvbmZpcm1fcGFzc3dvcmQYAyABKAkSEgoKcGFzc3BocmFzZRgEIAEoCRISCgp3b3JkX2NvdW50GAUgASgNEi0KCGxhbmd1YWdlGAYgASgOMhsud2FsbGV0LnYxLk1uZW1vbmljTGFuZ3VhZ2UirAEKFENyZWF0ZVdhbGxldFJlc3BvbnNlEgoKAmlkGAEgASgDEhMKC3NlZWRfcGhyYXNlGAIgASgJEkEKCWFkZHJlc3NlcxgDIAMoCzIuLndhbGxldC52MS5DcmVhdGVXYWxsZXRSZXNwb25zZS5BZGRyZXNzZXNFbnRyeRowCg5BZGRyZXNzZXNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIq8BChRSZWNvdmVyV2FsbGV0UmVxdWVzdBITCgt3YWxsZXRfbmFtZRgBIAEoCRITCgtzZWVkX3BocmFzZRgCIAEoCRIQCghwYXNzd29yZBgDIAEoCRIYChBjb25maXJtX3Bhc3N3b3JkGAQgASgJEhIKCnBhc3NwaHJhc2UYBSABKAkSLQoIbGFuZ3VhZ2UYBiABKA4yGy53YWxsZXQudjEuTW5lbW9uaWNMYW5ndWFnZSIqChVSZWNvdmVyV2FsbGV0UmVzcG9uc2USEQoJd2FsbGV0X2lkGAEgASgDImcKGENyZWF0ZVNoYXJlQmFja3VwUmVxdWVzdBIRCgl3YWxsZXRfaWQYASABKAMSEAoIcGFzc3dvcmQYAiABKAkSEQoJdGhyZXNob2xkGAMgASgNEhMKC3NoYXJlX2NvdW50GAQgASgNInIKGUNyZWF0ZVNoYXJlQmFja3VwUmVzcG9uc2USDgoGc2hhcmVzGAEgAygJEi0KCGxhbmd1YWdlGAIgASgOMhsud2FsbGV0LnYxLk1uZW1vbmljTGFuZ3VhZ2USFgoOaGFzX3Bhc3NwaHJhc2UYAyABKAgitAEKHlJlY292ZXJXYWxsZXRGcm9tU2hhcmVzUmVxdWVzdBITCgt3YWxsZXRfbmFtZRgBIAEoCRIOCgZzaGFyZXMYAiADKAkSEAoIcGFzc3dvcmQYAyABKAkSGAoQY29uZmlybV9wYXNzd29yZBgEIAEoCRISCgpwYXNzcGhyYXNlGAUgASgJEi0KCGxhbmd1YWdlGAYgASgOMhsud2FsbGV0LnYxLk1uZW1vbmljTGFuZ3VhZ2UiNAofUmVjb3ZlcldhbGxldEZyb21TaGFyZXNSZXNwb25zZRIRCgl3YWxsZXRfaWQYASABKAMiPgoXUmV2ZWFsU2VlZFBocmFzZVJlcXVlc3QSEQoJd2FsbGV0X2lkGAEgASgDEhAKCHBhc3N3b3JkGAIgASgJInAKGFJldmVhbFNlZWRQaHJhc2VSZXNwb25zZRINCgV3b3JkcxgBIAMoCRItCghsYW5ndWFnZRgCIAEoDjIbLndhbGxldC52MS5NbmVtb25pY0xhbmd1YWdlEhYKDmhhc19wYXNzcGhyYXNlGAMgASgIIkUKHlN0YXJ0QmFja3VwVmVyaWZpY2F0aW9uUmVxdWVzdBIRCgl3YWxsZXRfaWQYASABKAMSEAoIcGFzc3dvcmQYAiABKAkiZAofU3RhcnRCYWNrdXBWZXJpZmljYXRpb25SZXNwb25zZRIRCglwb3NpdGlvbnMYASADKA0SLgoKZXhwaXJlc19hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiNwoTVmVyaWZ5QmFja3VwUmVxdWVzdBIRCgl3YWxsZXRfaWQYASABKAMSDQoFd29yZHMYAiADKAkiTgoUVmVyaWZ5QmFja3VwUmVzcG9uc2USNgoSYmFja3VwX3ZlcmlmaWVkX2F0GAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIoChNVcGRhdGVXYWxsZXRSZXF1ZXN0EhEKCXdhbGxldF9pZBgBIAEoAyI5ChRVcGRhdGVXYWxsZXRSZXNwb25zZRIhCgZ3YWxsZXQYASABKAsyES53YWxsZXQudjEuV2FsbGV0IjoKE0RlbGV0ZVdhbGxldFJlcXVlc3QSEQoJd2FsbGV0X2lkGAEgASgDEhAKCHBhc3N3b3JkGAIgASgJIhYKFERlbGV0ZVdhbGxldFJlc3BvbnNlIigKFFVubG9ja1dhbGxldHNSZXF1ZXN0EhAKCHBhc3N3b3JkGAEgASgJIpYBChJXYWxsZXRVbmxvY2tSZXN1bHQSEQoJd2FsbGV0X2lkGAEgASgDEicKBnN0YXR1cxgCIAEoDjIXLndhbGxldC52MS5VbmxvY2tTdGF0dXMSNAoLcmV0cnlfYWZ0ZXIYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSACIAQFCDgoMX3JldHJ5X2FmdGVyIkcKFVVubG9ja1dhbGxldHNSZXNwb25zZRIuCgdyZXN1bHRzGAEgAygLMh0ud2FsbGV0LnYxLldhbGxldFVubG9ja1Jlc3VsdCImChFMb2NrV2FsbGV0UmVxdWVzdBIRCgl3YWxsZXRfaWQYASABKAMiFAoSTG9ja1dhbGxldFJlc3BvbnNlIhAKDkxvY2tBbGxSZXF1ZXN0IhEKD0xvY2tBbGxSZXNwb25zZSIZChdHZXRTZXNzaW9uU3RhdHVzUmVxdWVzdCKxAQoNV2FsbGV0U2Vzc2lvbhIRCgl3YWxsZXRfaWQYASABKAMSLwoLdW5sb2NrZWRfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmV4cGlyZXNfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGRlYWRsaW5lGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJGChhHZXRTZXNzaW9uU3RhdHVzUmVzcG9uc2USKgoIc2Vzc2lvbnMYASADKAsyGC53YWxsZXQudjEuV2FsbGV0U2Vzc2lvbiJhChBJbXBvcnRLZXlSZXF1ZXN0EgwKBG5hbWUYASABKAkSEwoLcHJpdmF0ZV9rZXkYAiABKAkSEAoIcGFzc3dvcmQYAyABKAkSGAoQY29uZmlybV9wYXNzd29yZBgEIAEoCSKYAQoRSW1wb3J0S2V5UmVzcG9uc2USEQoJd2FsbGV0X2lkGAEgASgDEj4KCWFkZHJlc3NlcxgCIAMoCzIrLndhbGxldC52MS5JbXBvcnRLZXlSZXNwb25zZS5BZGRyZXNzZXNFbnRyeRowCg5BZGRyZXNzZXNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIoMBChVJbXBvcnRLZXlzdG9yZVJlcXVlc3QSDAoEbmFtZRgBIAEoCRIVCg1rZXlzdG9yZV9qc29uGAIgASgJEhkKEWtleXN0b3JlX3Bhc3N3b3JkGAMgASgJEhAKCHBhc3N3b3JkGAQgASgJEhgKEGNvbmZpcm1fcGFzc3dvcmQYBSABKAkiogEKFkltcG9ydEtleXN0b3JlUmVzcG9uc2USEQoJd2FsbGV0X2lkGAEgASgDEkMKCWFkZHJlc3NlcxgCIAMoCzIwLndhbGxldC52MS5JbXBvcnRLZXlzdG9yZVJlc3BvbnNlLkFkZHJlc3Nlc0VudHJ5GjAKDkFkZHJlc3Nlc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEihQEKFUNoYW5nZVBhc3N3b3JkUmVxdWVzdBIRCgl3YWxsZXRfaWQYASABKAMSFAoMb2xkX3Bhc3N3b3JkGAIgASgJEhQKDG5ld19wYXNzd29yZBgDIAEoCRIYChBjb25maXJtX3Bhc3N3b3JkGAQgASgJEhMKC2FsbF93YWxsZXRzGAUgASgIIiwKFkNoYW5nZVBhc3N3b3JkUmVzcG9uc2USEgoKd2FsbGV0X2lkcxgBIAMoAyKTAQoQRXhwb3J0S2V5UmVxdWVzdBIRCgl3YWxsZXRfaWQYASABKAMSFQoNYWNjb3VudF9pbmRleBgCIAEoDRIQCghwYXNzd29yZBgDIAEoCRIqCgZmb3JtYXQYBCABKA4yGi53YWxsZXQudjEuS2V5RXhwb3J0Rm9ybWF0EhcKD2V4cG9ydF9wYXNzd29yZBgFIAEoCSIgChFFeHBvcnRLZXlSZXNwb25zZRILCgNrZXkYASABKAkiKAoVQ29udmVydEFkZHJlc3NSZXF1ZXN0Eg8KB2FkZHJlc3MYASABKAkiZwoWQ29udmVydEFkZHJlc3NSZXNwb25zZRIKCgJpZBgBIAEoCRIOCgZyb2J1c3QYAiABKAkSEQoJZGVsZWdhdGVkGAMgASgJEgsKA2V0aBgEIAEoCRIRCgltYXNrZWRfaWQYBSABKAkq4AIKEE1uZW1vbmljTGFuZ3VhZ2USIQodTU5FTU9OSUNfTEFOR1VBR0VfVU5TUEVDSUZJRUQQABIdChlNTkVNT05JQ19MQU5HVUFHRV9FTkdMSVNIEAESKAokTU5FTU9OSUNfTEFOR1VBR0VfQ0hJTkVTRV9TSU1QTElGSUVEEAISKQolTU5FTU9OSUNfTEFOR1VBR0VfQ0hJTkVTRV9UUkFESVRJT05BTBADEhsKF01ORU1PTklDX0xBTkdVQUdFX0NaRUNIEAQSHAoYTU5FTU9OSUNfTEFOR1VBR0VfRlJFTkNIEAUSHQoZTU5FTU9OSUNfTEFOR1VBR0VfSVRBTElBThAGEh4KGk1ORU1PTklDX0xBTkdVQUdFX0pBUEFORVNFEAcSHAoYTU5FTU9OSUNfTEFOR1VBR0VfS09SRUFOEAgSHQoZTU5FTU9OSUNfTEFOR1VBR0VfU1BBTklTSBAJKocBCgxVbmxvY2tTdGF0dXMSGgoWVU5MT0NLX1NUQVRVU19VTkxPQ0tFRBAAEiAKHFVOTE9DS19TVEFUVVNfV1JPTkdfUEFTU1dPUkQQARIbChdVTkxPQ0tfU1RBVFVTX0NPUlJVUFRFRBACEhwKGFVOTE9DS19TVEFUVVNfTE9DS0VEX09VVBADKlEKD0tleUV4cG9ydEZvcm1hdBIeChpLRVlfRVhQT1JUX0ZPUk1BVF9LRVlfSU5GTxAAEh4KGktFWV9FWFBPUlRfRk9STUFUX0tFWVNUT1JFEAEyrg0KDVdhbGxldFNlcnZpY2USRgoJR2V0V2FsbGV0Ehsud2FsbGV0LnYxLkdldFdhbGxldFJlcXVlc3QaHC53YWxsZXQudjEuR2V0V2FsbGV0UmVzcG9uc2USSQoKR2V0V2FsbGV0cxIcLndhbGxldC52MS5HZXRXYWxsZXRzUmVxdWVzdBodLndhbGxldC52MS5HZXRXYWxsZXRzUmVzcG9uc2USTwoMQ3JlYXRlV2FsbGV0Eh4ud2FsbGV0LnYxLkNyZWF0ZVdhbGxldFJlcXVlc3QaHy53YWxsZXQudjEuQ3JlYXRlV2FsbGV0UmVzcG9uc2USUgoNUmVjb3ZlcldhbGxldBIfLndhbGxldC52MS5SZWNvdmVyV2FsbGV0UmVxdWVzdBogLndhbGxldC52MS5SZWNvdmVyV2FsbGV0UmVzcG9uc2USXgoRQ3JlYXRlU2hhcmVCYWNrdXASIy53YWxsZXQudjEuQ3JlYXRlU2hhcmVCYWNrdXBSZXF1ZXN0GiQud2FsbGV0LnYxLkNyZWF0ZVNoYXJlQmFja3VwUmVzcG9uc2UScAoXUmVjb3ZlcldhbGxldEZyb21TaGFyZXMSKS53YWxsZXQudjEuUmVjb3ZlcldhbGxldEZyb21TaGFyZXNSZXF1ZXN0Gioud2FsbGV0LnYxLlJlY292ZXJXYWxsZXRGcm9tU2hhcmVzUmVzcG9uc2USWwoQUmV2ZWFsU2VlZFBocmFzZRIiLndhbGxldC52MS5SZXZlYWxTZWVkUGhyYXNlUmVxdWVzdBojLndhbGxldC52MS5SZXZlYWxTZWVkUGhyYXNlUmVzcG9uc2UScAoXU3RhcnRCYWNrdXBWZXJpZmljYXRpb24SKS53YWxsZXQudjEuU3RhcnRCYWNrdXBWZXJpZmljYXRpb25SZXF1ZXN0Gioud2FsbGV0LnYxLlN0YXJ0QmFja3VwVmVyaWZpY2F0aW9uUmVzcG9uc2USTwoMVmVyaWZ5QmFja3VwEh4ud2FsbGV0LnYxLlZlcmlmeUJhY2t1cFJlcXVlc3QaHy53YWxsZXQudjEuVmVyaWZ5QmFja3VwUmVzcG9uc2USTwoMVXBkYXRlV2FsbGV0Eh4ud2FsbGV0LnYxLlVwZGF0ZVdhbGxldFJlcXVlc3QaHy53YWxsZXQudjEuVXBkYXRlV2FsbGV0UmVzcG9uc2USTwoMRGVsZXRlV2FsbGV0Eh4ud2FsbGV0LnYxLkRlbGV0ZVdhbGxldFJlcXVlc3QaHy53YWxsZXQudjEuRGVsZXRlV2FsbGV0UmVzcG9uc2USUgoNVW5sb2NrV2FsbGV0cxIfLndhbGxldC52MS5VbmxvY2tXYWxsZXRzUmVxdWVzdBogLndhbGxldC52MS5VbmxvY2tXYWxsZXRzUmVzcG9uc2USSQoKTG9ja1dhbGxldBIcLndhbGxldC52MS5Mb2NrV2FsbGV0UmVxdWVzdBodLndhbGxldC52MS5Mb2NrV2FsbGV0UmVzcG9uc2USQAoHTG9ja0FsbBIZLndhbGxldC52MS5Mb2NrQWxsUmVxdWVzdBoaLndhbGxldC52MS5Mb2NrQWxsUmVzcG9uc2USWwoQR2V0U2Vzc2lvblN0YXR1cxIiLndhbGxldC52MS5HZXRTZXNzaW9uU3RhdHVzUmVxdWVzdBojLndhbGxldC52MS5HZXRTZXNzaW9uU3RhdHVzUmVzcG9uc2USRgoJSW1wb3J0S2V5Ehsud2FsbGV0LnYxLkltcG9ydEtleVJlcXVlc3QaHC53YWxsZXQudjEuSW1wb3J0S2V5UmVzcG9uc2USVQoOSW1wb3J0S2V5c3RvcmUSIC53YWxsZXQudjEuSW1wb3J0S2V5c3RvcmVSZXF1ZXN0GiEud2FsbGV0LnYxLkltcG9ydEtleXN0b3JlUmVzcG9uc2USVQoOQ2hhbmdlUGFzc3dvcmQSIC53YWxsZXQudjEuQ2hhbmdlUGFzc3dvcmRSZXF1ZXN0GiEud2FsbGV0LnYxLkNoYW5nZVBhc3N3b3JkUmVzcG9uc2USRgoJRXhwb3J0S2V5Ehsud2FsbGV0LnYxLkV4cG9ydEtleVJlcXVlc3QaHC53YWxsZXQudjEuRXhwb3J0S2V5UmVzcG9uc2USVQoOQ29udmVydEFkZHJlc3MSIC53YWxsZXQudjEuQ29udmVydEFkZHJlc3NSZXF1ZXN0GiEud2FsbGV0LnYxLkNvbnZlcnRBZGRyZXNzUmVzcG9uc2VCPVo7Z2l0aHViLmNvbS9jb2RlbWFlc3RybzY0L2ZpbGFtZW50L2xpYnMvcHJvdG8vZ2VuL2dvL3YxO3BidjFiBnByb3RvMw", [file_v1_types, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message wallet.v1.GetWalletRequest
@@ -66,6 +66,11 @@ export type GetWalletResponse = Message<"wallet.v1.GetWalletResponse"> & {
    * @generated from field: google.protobuf.Timestamp created_at = 6;
    */
   createdAt?: Timestamp;
+
+  /**
+   * @generated from field: string actor_id = 7;
+   */
+  actorId: string;
 };
 
 /**
@@ -127,6 +132,25 @@ export type CreateWalletRequest = Message<"wallet.v1.CreateWalletRequest"> & {
    * @generated from field: string confirm_password = 3;
    */
   confirmPassword: string;
+
+  /**
+   * Optional BIP39 passphrase, the "25th word" of the seed.
+   *
+   * @generated from field: string passphrase = 4;
+   */
+  passphrase: string;
+
+  /**
+   * 12 or 24, 12 when unset.
+   *
+   * @generated from field: uint32 word_count = 5;
+   */
+  wordCount: number;
+
+  /**
+   * @generated from field: wallet.v1.MnemonicLanguage language = 6;
+   */
+  language: MnemonicLanguage;
 };
 
 /**
@@ -186,6 +210,18 @@ export type RecoverWalletRequest = Message<"wallet.v1.RecoverWalletRequest"> & {
    * @generated from field: string confirm_password = 4;
    */
   confirmPassword: string;
+
+  /**
+   * BIP39 passphrase the seed was created with, empty for none.
+   *
+   * @generated from field: string passphrase = 5;
+   */
+  passphrase: string;
+
+  /**
+   * @generated from field: wallet.v1.MnemonicLanguage language = 6;
+   */
+  language: MnemonicLanguage;
 };
 
 /**
@@ -212,6 +248,279 @@ export type RecoverWalletResponse = Message<"wallet.v1.RecoverWalletResponse"> &
 export const RecoverWalletResponseSchema: GenMessage<RecoverWalletResponse> = /*@__PURE__*/
   messageDesc(file_v1_wallet, 7);
 
+/**
+ * @generated from message wallet.v1.CreateShareBackupRequest
+ */
+export type CreateShareBackupRequest = Message<"wallet.v1.CreateShareBackupRequest"> & {
+  /**
+   * @generated from field: int64 wallet_id = 1;
+   */
+  walletId: bigint;
+
+  /**
+   * @generated from field: string password = 2;
+   */
+  password: string;
+
+  /**
+   * Number of shares needed to recover the wallet.
+   *
+   * @generated from field: uint32 threshold = 3;
+   */
+  threshold: number;
+
+  /**
+   * Number of shares to create, at most 16.
+   *
+   * @generated from field: uint32 share_count = 4;
+   */
+  shareCount: number;
+};
+
+/**
+ * Describes the message wallet.v1.CreateShareBackupRequest.
+ * Use `create(CreateShareBackupRequestSchema)` to create a new message.
+ */
+export const CreateShareBackupRequestSchema: GenMessage<CreateShareBackupRequest> = /*@__PURE__*/
+  messageDesc(file_v1_wallet, 8);
+
+/**
+ * @generated from message wallet.v1.CreateShareBackupResponse
+ */
+export type CreateShareBackupResponse = Message<"wallet.v1.CreateShareBackupResponse"> & {
+  /**
+   * SLIP-39 mnemonics, one per share.
+   *
+   * @generated from field: repeated string shares = 1;
+   */
+  shares: string[];
+
+  /**
+   * Wordlist of the seed. Recovery needs it, along with the BIP39
+   * passphrase, which is not part of the shares.
+   *
+   * @generated from field: wallet.v1.MnemonicLanguage language = 2;
+   */
+  language: MnemonicLanguage;
+
+  /**
+   * Whether the seed also needs a BIP39 passphrase to recover the wallet.
+   *
+   * @generated from field: bool has_passphrase = 3;
+   */
+  hasPassphrase: boolean;
+};
+
+/**
+ * Describes the message wallet.v1.CreateShareBackupResponse.
+ * Use `create(CreateShareBackupResponseSchema)` to create a new message.
+ */
+export const CreateShareBackupResponseSchema: GenMessage<CreateShareBackupResponse> = /*@__PURE__*/
+  messageDesc(file_v1_wallet, 9);
+
+/**
+ * @generated from message wallet.v1.RecoverWalletFromSharesRequest
+ */
+export type RecoverWalletFromSharesRequest = Message<"wallet.v1.RecoverWalletFromSharesRequest"> & {
+  /**
+   * @generated from field: string wallet_name = 1;
+   */
+  walletName: string;
+
+  /**
+   * @generated from field: repeated string shares = 2;
+   */
+  shares: string[];
+
+  /**
+   * @generated from field: string password = 3;
+   */
+  password: string;
+
+  /**
+   * @generated from field: string confirm_password = 4;
+   */
+  confirmPassword: string;
+
+  /**
+   * BIP39 passphrase the seed was created with, empty for none.
+   *
+   * @generated from field: string passphrase = 5;
+   */
+  passphrase: string;
+
+  /**
+   * Wordlist of the seed, English when unspecified.
+   *
+   * @generated from field: wallet.v1.MnemonicLanguage language = 6;
+   */
+  language: MnemonicLanguage;
+};
+
+/**
+ * Describes the message wallet.v1.RecoverWalletFromSharesRequest.
+ * Use `create(RecoverWalletFromSharesRequestSchema)` to create a new message.
+ */
+export const RecoverWalletFromSharesRequestSchema: GenMessage<RecoverWalletFromSharesRequest> = /*@__PURE__*/
+  messageDesc(file_v1_wallet, 10);
+
+/**
+ * @generated from message wallet.v1.RecoverWalletFromSharesResponse
+ */
+export type RecoverWalletFromSharesResponse = Message<"wallet.v1.RecoverWalletFromSharesResponse"> & {
+  /**
+   * @generated from field: int64 wallet_id = 1;
+   */
+  walletId: bigint;
+};
+
+/**
+ * Describes the message wallet.v1.RecoverWalletFromSharesResponse.
+ * Use `create(RecoverWalletFromSharesResponseSchema)` to create a new message.
+ */
+export const RecoverWalletFromSharesResponseSchema: GenMessage<RecoverWalletFromSharesResponse> = /*@__PURE__*/
+  messageDesc(file_v1_wallet, 11);
+
+/**
+ * @generated from message wallet.v1.RevealSeedPhraseRequest
+ */
+export type RevealSeedPhraseRequest = Message<"wallet.v1.RevealSeedPhraseRequest"> & {
+  /**
+   * @generated from field: int64 wallet_id = 1;
+   */
+  walletId: bigint;
+
+  /**
+   * @generated from field: string password = 2;
+   */
+  password: string;
+};
+
+/**
+ * Describes the message wallet.v1.RevealSeedPhraseRequest.
+ * Use `create(RevealSeedPhraseRequestSchema)` to create a new message.
+ */
+export const RevealSeedPhraseRequestSchema: GenMessage<RevealSeedPhraseRequest> = /*@__PURE__*/
+  messageDesc(file_v1_wallet, 12);
+
+/**
+ * The words are secret and must never be logged.
+ *
+ * @generated from message wallet.v1.RevealSeedPhraseResponse
+ */
+export type RevealSeedPhraseResponse = Message<"wallet.v1.RevealSeedPhraseResponse"> & {
+  /**
+   * @generated from field: repeated string words = 1;
+   */
+  words: string[];
+
+  /**
+   * @generated from field: wallet.v1.MnemonicLanguage language = 2;
+   */
+  language: MnemonicLanguage;
+
+  /**
+   * Whether the seed also needs a BIP39 passphrase, which is not revealed.
+   *
+   * @generated from field: bool has_passphrase = 3;
+   */
+  hasPassphrase: boolean;
+};
+
+/**
+ * Describes the message wallet.v1.RevealSeedPhraseResponse.
+ * Use `create(RevealSeedPhraseResponseSchema)` to create a new message.
+ */
+export const RevealSeedPhraseResponseSchema: GenMessage<RevealSeedPhraseResponse> = /*@__PURE__*/
+  messageDesc(file_v1_wallet, 13);
+
+/**
+ * @generated from message wallet.v1.StartBackupVerificationRequest
+ */
+export type StartBackupVerificationRequest = Message<"wallet.v1.StartBackupVerificationRequest"> & {
+  /**
+   * @generated from field: int64 wallet_id = 1;
+   */
+  walletId: bigint;
+
+  /**
+   * @generated from field: string password = 2;
+   */
+  password: string;
+};
+
+/**
+ * Describes the message wallet.v1.StartBackupVerificationRequest.
+ * Use `create(StartBackupVerificationRequestSchema)` to create a new message.
+ */
+export const StartBackupVerificationRequestSchema: GenMessage<StartBackupVerificationRequest> = /*@__PURE__*/
+  messageDesc(file_v1_wallet, 14);
+
+/**
+ * @generated from message wallet.v1.StartBackupVerificationResponse
+ */
+export type StartBackupVerificationResponse = Message<"wallet.v1.StartBackupVerificationResponse"> & {
+  /**
+   * 1-based positions of the seed phrase words to ask for, in order.
+   *
+   * @generated from field: repeated uint32 positions = 1;
+   */
+  positions: number[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 2;
+   */
+  expiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message wallet.v1.StartBackupVerificationResponse.
+ * Use `create(StartBackupVerificationResponseSchema)` to create a new message.
+ */
+export const StartBackupVerificationResponseSchema: GenMessage<StartBackupVerificationResponse> = /*@__PURE__*/
+  messageDesc(file_v1_wallet, 15);
+
+/**
+ * @generated from message wallet.v1.VerifyBackupRequest
+ */
+export type VerifyBackupRequest = Message<"wallet.v1.VerifyBackupRequest"> & {
+  /**
+   * @generated from field: int64 wallet_id = 1;
+   */
+  walletId: bigint;
+
+  /**
+   * The words at the challenged positions, in order.
+   *
+   * @generated from field: repeated string words = 2;
+   */
+  words: string[];
+};
+
+/**
+ * Describes the message wallet.v1.VerifyBackupRequest.
+ * Use `create(VerifyBackupRequestSchema)` to create a new message.
+ */
+export const VerifyBackupRequestSchema: GenMessage<VerifyBackupRequest> = /*@__PURE__*/
+  messageDesc(file_v1_wallet, 16);
+
+/**
+ * @generated from message wallet.v1.VerifyBackupResponse
+ */
+export type VerifyBackupResponse = Message<"wallet.v1.VerifyBackupResponse"> & {
+  /**
+   * @generated from field: google.protobuf.Timestamp backup_verified_at = 1;
+   */
+  backupVerifiedAt?: Timestamp;
+};
+
+/**
+ * Describes the message wallet.v1.VerifyBackupResponse.
+ * Use `create(VerifyBackupResponseSchema)` to create a new message.
+ */
+export const VerifyBackupResponseSchema: GenMessage<VerifyBackupResponse> = /*@__PURE__*/
+  messageDesc(file_v1_wallet, 17);
+
 /**
  * @generated from message wallet.v1.UpdateWalletRequest
  */
@@ -227,7 +536,7 @@ export type UpdateWalletRequest = Message<"wallet.v1.UpdateWalletRequest"> & {
  * Use `create(UpdateWalletRequestSchema)` to create a new message.
  */
 export const UpdateWalletRequestSchema: GenMessage<UpdateWalletRequest> = /*@__PURE__*/
-  messageDesc(file_v1_wallet, 8);
+  messageDesc(file_v1_wallet, 18);
 
 /**
  * @generated from message wallet.v1.UpdateWalletResponse
@@ -244,7 +553,7 @@ export type UpdateWalletResponse = Message<"wallet.v1.UpdateWalletResponse"> & {
  * Use `create(UpdateWalletResponseSchema)` to create a new message.
  */
 export const UpdateWalletResponseSchema: GenMessage<UpdateWalletResponse> = /*@__PURE__*/
-  messageDesc(file_v1_wallet, 9);
+  messageDesc(file_v1_wallet, 19);
 
 /**
  * @generated from message wallet.v1.DeleteWalletRequest
@@ -266,7 +575,7 @@ export type DeleteWalletRequest = Message<"wallet.v1.DeleteWalletRequest"> & {
  * Use `create(DeleteWalletRequestSchema)` to create a new message.
  */
 export const DeleteWalletRequestSchema: GenMessage<DeleteWalletRequest> = /*@__PURE__*/
-  messageDesc(file_v1_wallet, 10);
+  messageDesc(file_v1_wallet, 20);
 
 /**
  * @generated from message wallet.v1.DeleteWalletResponse
@@ -279,7 +588,7 @@ export type DeleteWalletResponse = Message<"wallet.v1.DeleteWalletResponse"> & {
  * Use `create(DeleteWalletResponseSchema)` to create a new message.
  */
 export const DeleteWalletResponseSchema: GenMessage<DeleteWalletResponse> = /*@__PURE__*/
-  messageDesc(file_v1_wallet, 11);
+  messageDesc(file_v1_wallet, 21);
 
 /**
  * @generated from message wallet.v1.UnlockWalletsRequest
@@ -296,12 +605,45 @@ export type UnlockWalletsRequest = Message<"wallet.v1.UnlockWalletsRequest"> & {
  * Use `create(UnlockWalletsRequestSchema)` to create a new message.
  */
 export const UnlockWalletsRequestSchema: GenMessage<UnlockWalletsRequest> = /*@__PURE__*/
-  messageDesc(file_v1_wallet, 12);
+  messageDesc(file_v1_wallet, 22);
+
+/**
+ * @generated from message wallet.v1.WalletUnlockResult
+ */
+export type WalletUnlockResult = Message<"wallet.v1.WalletUnlockResult"> & {
+  /**
+   * @generated from field: int64 wallet_id = 1;
+   */
+  walletId: bigint;
+
+  /**
+   * @generated from field: wallet.v1.UnlockStatus status = 2;
+   */
+  status: UnlockStatus;
+
+  /**
+   * Set for locked out wallets.
+   *
+   * @generated from field: optional google.protobuf.Timestamp retry_after = 3;
+   */
+  retryAfter?: Timestamp;
+};
+
+/**
+ * Describes the message wallet.v1.WalletUnlockResult.
+ * Use `create(WalletUnlockResultSchema)` to create a new message.
+ */
+export const WalletUnlockResultSchema: GenMessage<WalletUnlockResult> = /*@__PURE__*/
+  messageDesc(file_v1_wallet, 23);
 
 /**
  * @generated from message wallet.v1.UnlockWalletsResponse
  */
 export type UnlockWalletsResponse = Message<"wallet.v1.UnlockWalletsResponse"> & {
+  /**
+   * @generated from field: repeated wallet.v1.WalletUnlockResult results = 1;
+   */
+  results: WalletUnlockResult[];
 };
 
 /**
@@ -309,43 +651,580 @@ export type UnlockWalletsResponse = Message<"wallet.v1.UnlockWalletsResponse"> &
  * Use `create(UnlockWalletsResponseSchema)` to create a new message.
  */
 export const UnlockWalletsResponseSchema: GenMessage<UnlockWalletsResponse> = /*@__PURE__*/
-  messageDesc(file_v1_wallet, 13);
+  messageDesc(file_v1_wallet, 24);
 
 /**
- * The primary service interface for managing the user's wallet portfolio.
- *
- * @generated from service wallet.v1.WalletService
+ * @generated from message wallet.v1.LockWalletRequest
  */
-export const WalletService: GenService<{
-  /**
-   * Retrieves the detailed information for a single wallet.
-   *
-   * @generated from rpc wallet.v1.WalletService.GetWallet
-   */
-  getWallet: {
-    methodKind: "unary";
-    input: typeof GetWalletRequestSchema;
-    output: typeof GetWalletResponseSchema;
-  },
-  /**
-   * Retrieves a list of all wallets belonging to the user.
-   *
-   * @generated from rpc wallet.v1.WalletService.GetWallets
-   */
-  getWallets: {
-    methodKind: "unary";
-    input: typeof GetWalletsRequestSchema;
-    output: typeof GetWalletsResponseSchema;
-  },
+export type LockWalletRequest = Message<"wallet.v1.LockWalletRequest"> & {
   /**
-   * Creates a new cryptographic wallet and saves its metadata.
-   *
-   * @generated from rpc wallet.v1.WalletService.CreateWallet
+   * @generated from field: int64 wallet_id = 1;
    */
-  createWallet: {
-    methodKind: "unary";
-    input: typeof CreateWalletRequestSchema;
-    output: typeof CreateWalletResponseSchema;
+  walletId: bigint;
+};
+
+/**
+ * Describes the message wallet.v1.LockWalletRequest.
+ * Use `create(LockWalletRequestSchema)` to create a new message.
+ */
+export const LockWalletRequestSchema: GenMessage<LockWalletRequest> = /*@__PURE__*/
+  messageDesc(file_v1_wallet, 25);
+
+/**
+ * @generated from message wallet.v1.LockWalletResponse
+ */
+export type LockWalletResponse = Message<"wallet.v1.LockWalletResponse"> & {
+};
+
+/**
+ * Describes the message wallet.v1.LockWalletResponse.
+ * Use `create(LockWalletResponseSchema)` to create a new message.
+ */
+export const LockWalletResponseSchema: GenMessage<LockWalletResponse> = /*@__PURE__*/
+  messageDesc(file_v1_wallet, 26);
+
+/**
+ * @generated from message wallet.v1.LockAllRequest
+ */
+export type LockAllRequest = Message<"wallet.v1.LockAllRequest"> & {
+};
+
+/**
+ * Describes the message wallet.v1.LockAllRequest.
+ * Use `create(LockAllRequestSchema)` to create a new message.
+ */
+export const LockAllRequestSchema: GenMessage<LockAllRequest> = /*@__PURE__*/
+  messageDesc(file_v1_wallet, 27);
+
+/**
+ * @generated from message wallet.v1.LockAllResponse
+ */
+export type LockAllResponse = Message<"wallet.v1.LockAllResponse"> & {
+};
+
+/**
+ * Describes the message wallet.v1.LockAllResponse.
+ * Use `create(LockAllResponseSchema)` to create a new message.
+ */
+export const LockAllResponseSchema: GenMessage<LockAllResponse> = /*@__PURE__*/
+  messageDesc(file_v1_wallet, 28);
+
+/**
+ * @generated from message wallet.v1.GetSessionStatusRequest
+ */
+export type GetSessionStatusRequest = Message<"wallet.v1.GetSessionStatusRequest"> & {
+};
+
+/**
+ * Describes the message wallet.v1.GetSessionStatusRequest.
+ * Use `create(GetSessionStatusRequestSchema)` to create a new message.
+ */
+export const GetSessionStatusRequestSchema: GenMessage<GetSessionStatusRequest> = /*@__PURE__*/
+  messageDesc(file_v1_wallet, 29);
+
+/**
+ * @generated from message wallet.v1.WalletSession
+ */
+export type WalletSession = Message<"wallet.v1.WalletSession"> & {
+  /**
+   * @generated from field: int64 wallet_id = 1;
+   */
+  walletId: bigint;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp unlocked_at = 2;
+   */
+  unlockedAt?: Timestamp;
+
+  /**
+   * The wallet locks at expires_at unless it is used before, which moves
+   * expires_at forward up to deadline.
+   *
+   * @generated from field: google.protobuf.Timestamp expires_at = 3;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp deadline = 4;
+   */
+  deadline?: Timestamp;
+};
+
+/**
+ * Describes the message wallet.v1.WalletSession.
+ * Use `create(WalletSessionSchema)` to create a new message.
+ */
+export const WalletSessionSchema: GenMessage<WalletSession> = /*@__PURE__*/
+  messageDesc(file_v1_wallet, 30);
+
+/**
+ * @generated from message wallet.v1.GetSessionStatusResponse
+ */
+export type GetSessionStatusResponse = Message<"wallet.v1.GetSessionStatusResponse"> & {
+  /**
+   * Only unlocked wallets are listed.
+   *
+   * @generated from field: repeated wallet.v1.WalletSession sessions = 1;
+   */
+  sessions: WalletSession[];
+};
+
+/**
+ * Describes the message wallet.v1.GetSessionStatusResponse.
+ * Use `create(GetSessionStatusResponseSchema)` to create a new message.
+ */
+export const GetSessionStatusResponseSchema: GenMessage<GetSessionStatusResponse> = /*@__PURE__*/
+  messageDesc(file_v1_wallet, 31);
+
+/**
+ * @generated from message wallet.v1.ImportKeyRequest
+ */
+export type ImportKeyRequest = Message<"wallet.v1.ImportKeyRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * A raw hex secp256k1 key or the hex KeyInfo of `lotus wallet export`,
+   * holding a secp256k1 or BLS key.
+   *
+   * @generated from field: string private_key = 2;
+   */
+  privateKey: string;
+
+  /**
+   * @generated from field: string password = 3;
+   */
+  password: string;
+
+  /**
+   * @generated from field: string confirm_password = 4;
+   */
+  confirmPassword: string;
+};
+
+/**
+ * Describes the message wallet.v1.ImportKeyRequest.
+ * Use `create(ImportKeyRequestSchema)` to create a new message.
+ */
+export const ImportKeyRequestSchema: GenMessage<ImportKeyRequest> = /*@__PURE__*/
+  messageDesc(file_v1_wallet, 32);
+
+/**
+ * @generated from message wallet.v1.ImportKeyResponse
+ */
+export type ImportKeyResponse = Message<"wallet.v1.ImportKeyResponse"> & {
+  /**
+   * @generated from field: int64 wallet_id = 1;
+   */
+  walletId: bigint;
+
+  /**
+   * @generated from field: map<string, string> addresses = 2;
+   */
+  addresses: { [key: string]: string };
+};
+
+/**
+ * Describes the message wallet.v1.ImportKeyResponse.
+ * Use `create(ImportKeyResponseSchema)` to create a new message.
+ */
+export const ImportKeyResponseSchema: GenMessage<ImportKeyResponse> = /*@__PURE__*/
+  messageDesc(file_v1_wallet, 33);
+
+/**
+ * @generated from message wallet.v1.ImportKeystoreRequest
+ */
+export type ImportKeystoreRequest = Message<"wallet.v1.ImportKeystoreRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Ethereum V3 keystore JSON, as written by geth or MetaMask.
+   *
+   * @generated from field: string keystore_json = 2;
+   */
+  keystoreJson: string;
+
+  /**
+   * @generated from field: string keystore_password = 3;
+   */
+  keystorePassword: string;
+
+  /**
+   * @generated from field: string password = 4;
+   */
+  password: string;
+
+  /**
+   * @generated from field: string confirm_password = 5;
+   */
+  confirmPassword: string;
+};
+
+/**
+ * Describes the message wallet.v1.ImportKeystoreRequest.
+ * Use `create(ImportKeystoreRequestSchema)` to create a new message.
+ */
+export const ImportKeystoreRequestSchema: GenMessage<ImportKeystoreRequest> = /*@__PURE__*/
+  messageDesc(file_v1_wallet, 34);
+
+/**
+ * @generated from message wallet.v1.ImportKeystoreResponse
+ */
+export type ImportKeystoreResponse = Message<"wallet.v1.ImportKeystoreResponse"> & {
+  /**
+   * @generated from field: int64 wallet_id = 1;
+   */
+  walletId: bigint;
+
+  /**
+   * @generated from field: map<string, string> addresses = 2;
+   */
+  addresses: { [key: string]: string };
+};
+
+/**
+ * Describes the message wallet.v1.ImportKeystoreResponse.
+ * Use `create(ImportKeystoreResponseSchema)` to create a new message.
+ */
+export const ImportKeystoreResponseSchema: GenMessage<ImportKeystoreResponse> = /*@__PURE__*/
+  messageDesc(file_v1_wallet, 35);
+
+/**
+ * @generated from message wallet.v1.ChangePasswordRequest
+ */
+export type ChangePasswordRequest = Message<"wallet.v1.ChangePasswordRequest"> & {
+  /**
+   * Ignored when all_wallets is set.
+   *
+   * @generated from field: int64 wallet_id = 1;
+   */
+  walletId: bigint;
+
+  /**
+   * @generated from field: string old_password = 2;
+   */
+  oldPassword: string;
+
+  /**
+   * @generated from field: string new_password = 3;
+   */
+  newPassword: string;
+
+  /**
+   * @generated from field: string confirm_password = 4;
+   */
+  confirmPassword: string;
+
+  /**
+   * Changes the password of every wallet that uses old_password.
+   *
+   * @generated from field: bool all_wallets = 5;
+   */
+  allWallets: boolean;
+};
+
+/**
+ * Describes the message wallet.v1.ChangePasswordRequest.
+ * Use `create(ChangePasswordRequestSchema)` to create a new message.
+ */
+export const ChangePasswordRequestSchema: GenMessage<ChangePasswordRequest> = /*@__PURE__*/
+  messageDesc(file_v1_wallet, 36);
+
+/**
+ * @generated from message wallet.v1.ChangePasswordResponse
+ */
+export type ChangePasswordResponse = Message<"wallet.v1.ChangePasswordResponse"> & {
+  /**
+   * @generated from field: repeated int64 wallet_ids = 1;
+   */
+  walletIds: bigint[];
+};
+
+/**
+ * Describes the message wallet.v1.ChangePasswordResponse.
+ * Use `create(ChangePasswordResponseSchema)` to create a new message.
+ */
+export const ChangePasswordResponseSchema: GenMessage<ChangePasswordResponse> = /*@__PURE__*/
+  messageDesc(file_v1_wallet, 37);
+
+/**
+ * @generated from message wallet.v1.ExportKeyRequest
+ */
+export type ExportKeyRequest = Message<"wallet.v1.ExportKeyRequest"> & {
+  /**
+   * @generated from field: int64 wallet_id = 1;
+   */
+  walletId: bigint;
+
+  /**
+   * @generated from field: uint32 account_index = 2;
+   */
+  accountIndex: number;
+
+  /**
+   * @generated from field: string password = 3;
+   */
+  password: string;
+
+  /**
+   * @generated from field: wallet.v1.KeyExportFormat format = 4;
+   */
+  format: KeyExportFormat;
+
+  /**
+   * @generated from field: string export_password = 5;
+   */
+  exportPassword: string;
+};
+
+/**
+ * Describes the message wallet.v1.ExportKeyRequest.
+ * Use `create(ExportKeyRequestSchema)` to create a new message.
+ */
+export const ExportKeyRequestSchema: GenMessage<ExportKeyRequest> = /*@__PURE__*/
+  messageDesc(file_v1_wallet, 38);
+
+/**
+ * @generated from message wallet.v1.ExportKeyResponse
+ */
+export type ExportKeyResponse = Message<"wallet.v1.ExportKeyResponse"> & {
+  /**
+   * @generated from field: string key = 1;
+   */
+  key: string;
+};
+
+/**
+ * Describes the message wallet.v1.ExportKeyResponse.
+ * Use `create(ExportKeyResponseSchema)` to create a new message.
+ */
+export const ExportKeyResponseSchema: GenMessage<ExportKeyResponse> = /*@__PURE__*/
+  messageDesc(file_v1_wallet, 39);
+
+/**
+ * @generated from message wallet.v1.ConvertAddressRequest
+ */
+export type ConvertAddressRequest = Message<"wallet.v1.ConvertAddressRequest"> & {
+  /**
+   * Any f0, f1, f2, f3, f410, 0x or masked 0xff address.
+   *
+   * @generated from field: string address = 1;
+   */
+  address: string;
+};
+
+/**
+ * Describes the message wallet.v1.ConvertAddressRequest.
+ * Use `create(ConvertAddressRequestSchema)` to create a new message.
+ */
+export const ConvertAddressRequestSchema: GenMessage<ConvertAddressRequest> = /*@__PURE__*/
+  messageDesc(file_v1_wallet, 40);
+
+/**
+ * Forms that do not exist for the actor, or need an actor that is not yet on
+ * chain, are empty.
+ *
+ * @generated from message wallet.v1.ConvertAddressResponse
+ */
+export type ConvertAddressResponse = Message<"wallet.v1.ConvertAddressResponse"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string robust = 2;
+   */
+  robust: string;
+
+  /**
+   * @generated from field: string delegated = 3;
+   */
+  delegated: string;
+
+  /**
+   * @generated from field: string eth = 4;
+   */
+  eth: string;
+
+  /**
+   * @generated from field: string masked_id = 5;
+   */
+  maskedId: string;
+};
+
+/**
+ * Describes the message wallet.v1.ConvertAddressResponse.
+ * Use `create(ConvertAddressResponseSchema)` to create a new message.
+ */
+export const ConvertAddressResponseSchema: GenMessage<ConvertAddressResponse> = /*@__PURE__*/
+  messageDesc(file_v1_wallet, 41);
+
+/**
+ * BIP39 wordlist of a seed phrase.
+ *
+ * @generated from enum wallet.v1.MnemonicLanguage
+ */
+export enum MnemonicLanguage {
+  /**
+   * English when creating a wallet, detected when recovering one.
+   *
+   * @generated from enum value: MNEMONIC_LANGUAGE_UNSPECIFIED = 0;
+   */
+  MNEMONIC_LANGUAGE_UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: MNEMONIC_LANGUAGE_ENGLISH = 1;
+   */
+  MNEMONIC_LANGUAGE_ENGLISH = 1,
+
+  /**
+   * @generated from enum value: MNEMONIC_LANGUAGE_CHINESE_SIMPLIFIED = 2;
+   */
+  MNEMONIC_LANGUAGE_CHINESE_SIMPLIFIED = 2,
+
+  /**
+   * @generated from enum value: MNEMONIC_LANGUAGE_CHINESE_TRADITIONAL = 3;
+   */
+  MNEMONIC_LANGUAGE_CHINESE_TRADITIONAL = 3,
+
+  /**
+   * @generated from enum value: MNEMONIC_LANGUAGE_CZECH = 4;
+   */
+  MNEMONIC_LANGUAGE_CZECH = 4,
+
+  /**
+   * @generated from enum value: MNEMONIC_LANGUAGE_FRENCH = 5;
+   */
+  MNEMONIC_LANGUAGE_FRENCH = 5,
+
+  /**
+   * @generated from enum value: MNEMONIC_LANGUAGE_ITALIAN = 6;
+   */
+  MNEMONIC_LANGUAGE_ITALIAN = 6,
+
+  /**
+   * @generated from enum value: MNEMONIC_LANGUAGE_JAPANESE = 7;
+   */
+  MNEMONIC_LANGUAGE_JAPANESE = 7,
+
+  /**
+   * @generated from enum value: MNEMONIC_LANGUAGE_KOREAN = 8;
+   */
+  MNEMONIC_LANGUAGE_KOREAN = 8,
+
+  /**
+   * @generated from enum value: MNEMONIC_LANGUAGE_SPANISH = 9;
+   */
+  MNEMONIC_LANGUAGE_SPANISH = 9,
+}
+
+/**
+ * Describes the enum wallet.v1.MnemonicLanguage.
+ */
+export const MnemonicLanguageSchema: GenEnum<MnemonicLanguage> = /*@__PURE__*/
+  enumDesc(file_v1_wallet, 0);
+
+/**
+ * @generated from enum wallet.v1.UnlockStatus
+ */
+export enum UnlockStatus {
+  /**
+   * @generated from enum value: UNLOCK_STATUS_UNLOCKED = 0;
+   */
+  UNLOCK_STATUS_UNLOCKED = 0,
+
+  /**
+   * @generated from enum value: UNLOCK_STATUS_WRONG_PASSWORD = 1;
+   */
+  UNLOCK_STATUS_WRONG_PASSWORD = 1,
+
+  /**
+   * The password was not the problem, the stored key could not be decrypted.
+   *
+   * @generated from enum value: UNLOCK_STATUS_CORRUPTED = 2;
+   */
+  UNLOCK_STATUS_CORRUPTED = 2,
+
+  /**
+   * Not tried, the wallet is backing off after too many wrong passwords.
+   *
+   * @generated from enum value: UNLOCK_STATUS_LOCKED_OUT = 3;
+   */
+  UNLOCK_STATUS_LOCKED_OUT = 3,
+}
+
+/**
+ * Describes the enum wallet.v1.UnlockStatus.
+ */
+export const UnlockStatusSchema: GenEnum<UnlockStatus> = /*@__PURE__*/
+  enumDesc(file_v1_wallet, 1);
+
+/**
+ * @generated from enum wallet.v1.KeyExportFormat
+ */
+export enum KeyExportFormat {
+  /**
+   * Hex KeyInfo for `lotus wallet import`.
+   *
+   * @generated from enum value: KEY_EXPORT_FORMAT_KEY_INFO = 0;
+   */
+  KEY_EXPORT_FORMAT_KEY_INFO = 0,
+
+  /**
+   * Ethereum V3 keystore JSON, encrypted under export_password.
+   *
+   * @generated from enum value: KEY_EXPORT_FORMAT_KEYSTORE = 1;
+   */
+  KEY_EXPORT_FORMAT_KEYSTORE = 1,
+}
+
+/**
+ * Describes the enum wallet.v1.KeyExportFormat.
+ */
+export const KeyExportFormatSchema: GenEnum<KeyExportFormat> = /*@__PURE__*/
+  enumDesc(file_v1_wallet, 2);
+
+/**
+ * The primary service interface for managing the user's wallet portfolio.
+ *
+ * @generated from service wallet.v1.WalletService
+ */
+export const WalletService: GenService<{
+  /**
+   * Retrieves the detailed information for a single wallet.
+   *
+   * @generated from rpc wallet.v1.WalletService.GetWallet
+   */
+  getWallet: {
+    methodKind: "unary";
+    input: typeof GetWalletRequestSchema;
+    output: typeof GetWalletResponseSchema;
+  },
+  /**
+   * Retrieves a list of all wallets belonging to the user.
+   *
+   * @generated from rpc wallet.v1.WalletService.GetWallets
+   */
+  getWallets: {
+    methodKind: "unary";
+    input: typeof GetWalletsRequestSchema;
+    output: typeof GetWalletsResponseSchema;
+  },
+  /**
+   * Creates a new cryptographic wallet and saves its metadata.
+   *
+   * @generated from rpc wallet.v1.WalletService.CreateWallet
+   */
+  createWallet: {
+    methodKind: "unary";
+    input: typeof CreateWalletRequestSchema;
+    output: typeof CreateWalletResponseSchema;
   },
   /**
    * @generated from rpc wallet.v1.WalletService.RecoverWallet
@@ -355,6 +1234,59 @@ export const WalletService: GenService<{
     input: typeof RecoverWalletRequestSchema;
     output: typeof RecoverWalletResponseSchema;
   },
+  /**
+   * Splits the seed of a wallet into SLIP-39 shares, threshold of which
+   * recover it. Requires the wallet password and is audited.
+   *
+   * @generated from rpc wallet.v1.WalletService.CreateShareBackup
+   */
+  createShareBackup: {
+    methodKind: "unary";
+    input: typeof CreateShareBackupRequestSchema;
+    output: typeof CreateShareBackupResponseSchema;
+  },
+  /**
+   * Recovers a wallet from SLIP-39 shares instead of a seed phrase.
+   *
+   * @generated from rpc wallet.v1.WalletService.RecoverWalletFromShares
+   */
+  recoverWalletFromShares: {
+    methodKind: "unary";
+    input: typeof RecoverWalletFromSharesRequestSchema;
+    output: typeof RecoverWalletFromSharesResponseSchema;
+  },
+  /**
+   * Returns the seed phrase of a wallet after checking its password. Reveals
+   * are audited.
+   *
+   * @generated from rpc wallet.v1.WalletService.RevealSeedPhrase
+   */
+  revealSeedPhrase: {
+    methodKind: "unary";
+    input: typeof RevealSeedPhraseRequestSchema;
+    output: typeof RevealSeedPhraseResponseSchema;
+  },
+  /**
+   * Picks random seed phrase positions for the user to fill in.
+   *
+   * @generated from rpc wallet.v1.WalletService.StartBackupVerification
+   */
+  startBackupVerification: {
+    methodKind: "unary";
+    input: typeof StartBackupVerificationRequestSchema;
+    output: typeof StartBackupVerificationResponseSchema;
+  },
+  /**
+   * Checks the words of the pending challenge and marks the backup as
+   * verified. A wrong answer needs a new challenge.
+   *
+   * @generated from rpc wallet.v1.WalletService.VerifyBackup
+   */
+  verifyBackup: {
+    methodKind: "unary";
+    input: typeof VerifyBackupRequestSchema;
+    output: typeof VerifyBackupResponseSchema;
+  },
   /**
    * Updates mutable metadata associated with a wallet (name, default status).
    *
@@ -376,6 +1308,9 @@ export const WalletService: GenService<{
     output: typeof DeleteWalletResponseSchema;
   },
   /**
+   * Unlocks every wallet that uses the given password and reports the
+   * outcome per wallet.
+   *
    * @generated from rpc wallet.v1.WalletService.UnlockWallets
    */
   unlockWallets: {
@@ -383,6 +1318,88 @@ export const WalletService: GenService<{
     input: typeof UnlockWalletsRequestSchema;
     output: typeof UnlockWalletsResponseSchema;
   },
+  /**
+   * Locks a single wallet.
+   *
+   * @generated from rpc wallet.v1.WalletService.LockWallet
+   */
+  lockWallet: {
+    methodKind: "unary";
+    input: typeof LockWalletRequestSchema;
+    output: typeof LockWalletResponseSchema;
+  },
+  /**
+   * Locks every wallet.
+   *
+   * @generated from rpc wallet.v1.WalletService.LockAll
+   */
+  lockAll: {
+    methodKind: "unary";
+    input: typeof LockAllRequestSchema;
+    output: typeof LockAllResponseSchema;
+  },
+  /**
+   * Lists the unlocked wallets and when each one locks.
+   *
+   * @generated from rpc wallet.v1.WalletService.GetSessionStatus
+   */
+  getSessionStatus: {
+    methodKind: "unary";
+    input: typeof GetSessionStatusRequestSchema;
+    output: typeof GetSessionStatusResponseSchema;
+  },
+  /**
+   * Creates a wallet from a single private key. It has no seed phrase.
+   *
+   * @generated from rpc wallet.v1.WalletService.ImportKey
+   */
+  importKey: {
+    methodKind: "unary";
+    input: typeof ImportKeyRequestSchema;
+    output: typeof ImportKeyResponseSchema;
+  },
+  /**
+   * Creates a wallet from an Ethereum V3 keystore file.
+   *
+   * @generated from rpc wallet.v1.WalletService.ImportKeystore
+   */
+  importKeystore: {
+    methodKind: "unary";
+    input: typeof ImportKeystoreRequestSchema;
+    output: typeof ImportKeystoreResponseSchema;
+  },
+  /**
+   * Re-encrypts one or all wallets under a new password.
+   *
+   * @generated from rpc wallet.v1.WalletService.ChangePassword
+   */
+  changePassword: {
+    methodKind: "unary";
+    input: typeof ChangePasswordRequestSchema;
+    output: typeof ChangePasswordResponseSchema;
+  },
+  /**
+   * Exports the private key of a wallet account. Attempts are audited and
+   * rate limited.
+   *
+   * @generated from rpc wallet.v1.WalletService.ExportKey
+   */
+  exportKey: {
+    methodKind: "unary";
+    input: typeof ExportKeyRequestSchema;
+    output: typeof ExportKeyResponseSchema;
+  },
+  /**
+   * Converts an address to all its equivalent forms, looking up chain state
+   * where needed.
+   *
+   * @generated from rpc wallet.v1.WalletService.ConvertAddress
+   */
+  convertAddress: {
+    methodKind: "unary";
+    input: typeof ConvertAddressRequestSchema;
+    output: typeof ConvertAddressResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_v1_wallet, 0);
 